@@ -299,12 +299,22 @@ Return the Redis secret name
   {{- end -}}
 {{- end -}}
 
+{{/*
+Return true if the flux plugin uses Redis as the backend of its cache
+*/}}
+{{- define "kubeapps.redis.enabled" -}}
+  {{- if and .Values.packaging.flux.enabled (eq (.Values.kubeappsapis.pluginConfig.flux.packages.v1alpha1.cache.backend | default "redis") "redis") -}}
+      {{- true -}}
+  {{- end -}}
+{{- end -}}
+
 {{/*
 Compile all warnings into a single message, and call fail.
 */}}
 {{- define "kubeapps.validateValues" -}}
 {{- $messages := list -}}
 {{- $messages := append $messages (include "kubeapps.validateValues.ingress.tls" .) -}}
+{{- $messages := append $messages (include "kubeapps.validateValues.redis" .) -}}
 {{- $messages := without $messages "" -}}
 {{- $message := join "\n" $messages -}}
 
@@ -313,6 +323,31 @@ Compile all warnings into a single message, and call fail.
 {{- end -}}
 {{- end -}}
 
+{{/*
+Validate values of Kubeapps - Redis is only installed when used as the cache
+backend of the flux plugin. The Redis subchart is installed when flux is
+enabled unless redis.enabled is set, since a chart dependency cannot depend
+on the cache backend.
+*/}}
+{{- define "kubeapps.validateValues.redis" -}}
+{{- if .Values.packaging.flux.enabled }}
+{{- $redisInstalled := true }}
+{{- if hasKey .Values.redis "enabled" }}
+{{- $redisInstalled = .Values.redis.enabled }}
+{{- end }}
+{{- if and (include "kubeapps.redis.enabled" .) (not $redisInstalled) }}
+kubeapps: redis.enabled
+    The flux plugin uses Redis(R) as the backend of its cache, so Redis(R) must be installed.
+    Please set `redis.enabled=true` or use the memory backend by setting
+    `kubeappsapis.pluginConfig.flux.packages.v1alpha1.cache.backend=memory`.
+{{- else if and (not (include "kubeapps.redis.enabled" .)) $redisInstalled }}
+kubeapps: redis.enabled
+    The flux plugin does not use Redis(R) as the backend of its cache.
+    Please set `redis.enabled=false` so that Redis(R) is not installed.
+{{- end -}}
+{{- end -}}
+{{- end -}}
+
 {{/*
 Validate values of Kubeapps - TLS configuration for Ingress
 */}}
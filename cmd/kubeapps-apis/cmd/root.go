@@ -74,6 +74,8 @@ func setFlags(c *cobra.Command) {
 	c.Flags().BoolVar(&serveOpts.UnsafeLocalDevKubeconfig, "unsafe-local-dev-kubeconfig", false, "if true, it will use the local kubeconfig at the KUBECONFIG env var instead of using the inCluster configuration.")
 	c.Flags().Float32Var(&serveOpts.QPS, "kube-api-qps", 10.0, "set Kubernetes API client QPS limit")
 	c.Flags().IntVar(&serveOpts.Burst, "kube-api-burst", 15, "set Kubernetes API client Burst limit")
+	c.Flags().IntVar(&serveOpts.PluginTimeoutSeconds, "plugin-timeout-seconds", 30, "The maximum time, in seconds, that each plugin is given when aggregating results from several plugins. Plugins which do not respond in time are reported as errors alongside the results of the other plugins. 0 means no timeout.")
+	c.Flags().StringVar(&serveOpts.OperationsNamespace, "operations-namespace", "", "The namespace in which the state of asynchronous operations is stored, so that it is shared between replicas and survives restarts. If empty, the state is only kept in memory.")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -31,6 +31,8 @@ func TestParseFlagsCorrect(t *testing.T) {
 				"--plugin-config-path", "foo05",
 				"--kube-api-qps", "1.0",
 				"--kube-api-burst", "1",
+				"--plugin-timeout-seconds", "10",
+				"--operations-namespace", "kubeapps",
 			},
 			core.ServeOptions{
 				Port:                     901,
@@ -42,6 +44,8 @@ func TestParseFlagsCorrect(t *testing.T) {
 				PluginConfigPath:         "foo05",
 				QPS:                      1.0,
 				Burst:                    1,
+				PluginTimeoutSeconds:     10,
+				OperationsNamespace:      "kubeapps",
 			},
 		},
 	}
@@ -0,0 +1,277 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	// DefaultOperationHeartbeatInterval is how often a running operation is
+	// updated, both to record the progress of the installed package and to
+	// show that the server running it is still alive.
+	DefaultOperationHeartbeatInterval = 10 * time.Second
+	// operationStaleHeartbeats is the number of missed heartbeats after which
+	// a running operation is considered to have been interrupted.
+	operationStaleHeartbeats = 6
+	// operationRetention is how long finished operations are kept.
+	operationRetention = 24 * time.Hour
+	// DefaultOperationTimeout is the maximum time given to an operation to
+	// complete, after which it is cancelled and fails.
+	DefaultOperationTimeout = time.Hour
+)
+
+// operationFunc runs the change of an operation, returning once it completes.
+type operationFunc func(ctx context.Context) error
+
+// operationProgressFunc returns a description of the current status of the
+// installed package of an operation, used to report its progress.
+type operationProgressFunc func(ctx context.Context) (string, error)
+
+// operationAccessFunc returns an error unless the caller, identified by the
+// credentials of the request, has access to the installed package of an
+// operation.
+type operationAccessFunc func(ctx context.Context, operation *packages.Operation) error
+
+// operationsServer implements the API defined in proto/kubeappsapis/core/packages/v1alpha1/operations.proto
+// and runs the operations started by async requests to the packages server.
+type operationsServer struct {
+	packages.UnimplementedOperationsServiceServer
+
+	store OperationStore
+
+	// heartbeatInterval is how often running operations are updated, which
+	// is also how often watched operations are checked for changes.
+	heartbeatInterval time.Duration
+
+	// timeout is the maximum time given to an operation to complete.
+	timeout time.Duration
+
+	// checkAccess checks that the caller has access to an operation before it
+	// is returned. It is set by the packages server, which runs the
+	// operations, and operations are not returned to anyone until then.
+	checkAccess operationAccessFunc
+}
+
+func NewOperationsServer(store OperationStore, heartbeatInterval time.Duration) *operationsServer {
+	return &operationsServer{
+		store:             store,
+		heartbeatInterval: heartbeatInterval,
+		timeout:           DefaultOperationTimeout,
+	}
+}
+
+// GetOperation returns the current state of an operation.
+func (s *operationsServer) GetOperation(ctx context.Context, request *packages.GetOperationRequest) (*packages.GetOperationResponse, error) {
+	log.InfoS("+core GetOperation", "id", request.GetOperationId())
+
+	operation, err := s.getOperation(ctx, request.GetOperationId(), true)
+	if err != nil {
+		return nil, err
+	}
+	return &packages.GetOperationResponse{
+		Operation: operation,
+	}, nil
+}
+
+// WatchOperation streams the state of an operation each time it changes,
+// until the operation has finished or the request is cancelled.
+func (s *operationsServer) WatchOperation(request *packages.WatchOperationRequest, stream packages.OperationsService_WatchOperationServer) error {
+	log.InfoS("+core WatchOperation", "id", request.GetOperationId())
+
+	ticker := time.NewTicker(s.watchInterval())
+	defer ticker.Stop()
+
+	var sent *packages.Operation
+	for {
+		// The access of the caller is only checked before the first update.
+		operation, err := s.getOperation(stream.Context(), request.GetOperationId(), sent == nil)
+		if err != nil {
+			return err
+		}
+		if !proto.Equal(operation, sent) {
+			if err := stream.Send(&packages.WatchOperationResponse{Operation: operation}); err != nil {
+				return err
+			}
+			sent = operation
+		}
+		if operation.GetState() != packages.Operation_OPERATION_STATE_RUNNING {
+			return nil
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// getOperation returns the operation with the given id, marking it as failed
+// if it is running but has not been updated for too long, as happens when the
+// server running it is restarted. When authorize is set, an error is returned
+// unless the caller has access to the operation.
+func (s *operationsServer) getOperation(ctx context.Context, id string, authorize bool) (*packages.Operation, error) {
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to get the operation (missing OperationId)")
+	}
+	operation, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if authorize {
+		if s.checkAccess == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "Unable to check the access to the operation %q", id)
+		}
+		if err := s.checkAccess(ctx, operation); err != nil {
+			return nil, err
+		}
+	}
+	if operation.GetState() == packages.Operation_OPERATION_STATE_RUNNING && s.isStale(operation, time.Now()) {
+		s.finishOperation(operation, status.Errorf(codes.Aborted, "The operation was interrupted, possibly by a restart of the server running it"))
+		if err := s.store.Save(ctx, operation); err != nil {
+			return nil, err
+		}
+	}
+	return operation, nil
+}
+
+// startOperation stores a new running operation and runs it in the
+// background, returning without waiting for it to complete. The operation is
+// run with the credentials of the request, but is not cancelled when the
+// request ends, only when it exceeds the timeout of the operations.
+func (s *operationsServer) startOperation(ctx context.Context, operationType packages.Operation_OperationType, installedPackageRef *packages.InstalledPackageReference, run operationFunc, progress operationProgressFunc) (*packages.Operation, error) {
+	s.pruneOperations(ctx)
+
+	now := timestamppb.Now()
+	operation := &packages.Operation{
+		Id:                  string(uuid.NewUUID()),
+		Type:                operationType,
+		State:               packages.Operation_OPERATION_STATE_RUNNING,
+		InstalledPackageRef: installedPackageRef,
+		Events: []*packages.OperationEvent{
+			{Time: now, Message: "The operation has started"},
+		},
+		Created: now,
+		Updated: now,
+		Context: proto.Clone(installedPackageRef.GetContext()).(*packages.Context),
+	}
+	if err := s.store.Save(ctx, operation); err != nil {
+		return nil, err
+	}
+
+	runCtx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		runCtx = metadata.NewIncomingContext(runCtx, md.Copy())
+	}
+	go func() {
+		defer cancel()
+		s.runOperation(runCtx, proto.Clone(operation).(*packages.Operation), run, progress)
+	}()
+
+	return operation, nil
+}
+
+// runOperation runs the operation until it completes or its context is done,
+// recording its progress at each heartbeat and storing the final result. The
+// operation is stored with a context of its own, so that the result is stored
+// even when the operation has timed out.
+func (s *operationsServer) runOperation(ctx context.Context, operation *packages.Operation, run operationFunc, progress operationProgressFunc) {
+	done := make(chan error, 1)
+	go func() {
+		done <- run(ctx)
+	}()
+
+	ticker := time.NewTicker(s.heartbeatInterval)
+	defer ticker.Stop()
+
+	storeCtx := context.Background()
+	lastProgress := ""
+	for {
+		select {
+		case err := <-done:
+			s.finishOperation(operation, err)
+			if err := s.store.Save(storeCtx, operation); err != nil {
+				log.Errorf("Unable to store the result of the operation %q: %v", operation.GetId(), err)
+			}
+			return
+		case <-ctx.Done():
+			s.finishOperation(operation, status.Errorf(codes.DeadlineExceeded, "The operation did not complete within %s", s.timeout))
+			if err := s.store.Save(storeCtx, operation); err != nil {
+				log.Errorf("Unable to store the result of the operation %q: %v", operation.GetId(), err)
+			}
+			return
+		case <-ticker.C:
+			now := timestamppb.Now()
+			if progress != nil {
+				if message, err := progress(ctx); err != nil {
+					log.Warningf("Unable to get the progress of the operation %q: %v", operation.GetId(), err)
+				} else if message != "" && message != lastProgress {
+					operation.Events = append(operation.Events, &packages.OperationEvent{Time: now, Message: message})
+					lastProgress = message
+				}
+			}
+			operation.Updated = now
+			if err := s.store.Save(storeCtx, operation); err != nil {
+				log.Errorf("Unable to store the progress of the operation %q: %v", operation.GetId(), err)
+			}
+		}
+	}
+}
+
+// finishOperation sets the final state of the operation from its result.
+func (s *operationsServer) finishOperation(operation *packages.Operation, err error) {
+	now := timestamppb.Now()
+	if err != nil {
+		st := status.Convert(err)
+		operation.State = packages.Operation_OPERATION_STATE_FAILED
+		operation.ErrorCode = int32(st.Code())
+		operation.ErrorMessage = st.Message()
+		operation.Events = append(operation.Events, &packages.OperationEvent{Time: now, Message: fmt.Sprintf("The operation has failed: %s", st.Message())})
+	} else {
+		operation.State = packages.Operation_OPERATION_STATE_SUCCEEDED
+		operation.Events = append(operation.Events, &packages.OperationEvent{Time: now, Message: "The operation has succeeded"})
+	}
+	operation.Updated = now
+}
+
+// pruneOperations deletes the operations which finished, or stopped being
+// updated, longer than the retention period ago. Errors are only logged since
+// they do not prevent new operations from running.
+func (s *operationsServer) pruneOperations(ctx context.Context) {
+	operations, err := s.store.List(ctx)
+	if err != nil {
+		log.Warningf("Unable to list the operations to prune: %v", err)
+		return
+	}
+	cutoff := time.Now().Add(-operationRetention)
+	for _, operation := range operations {
+		if operation.GetUpdated().AsTime().Before(cutoff) {
+			if err := s.store.Delete(ctx, operation.GetId()); err != nil {
+				log.Warningf("Unable to prune the operation %q: %v", operation.GetId(), err)
+			}
+		}
+	}
+}
+
+func (s *operationsServer) isStale(operation *packages.Operation, now time.Time) bool {
+	return now.Sub(operation.GetUpdated().AsTime()) > operationStaleHeartbeats*s.heartbeatInterval
+}
+
+// watchInterval is how often a watched operation is checked for changes,
+// which are at most as frequent as the heartbeats.
+func (s *operationsServer) watchInterval() time.Duration {
+	return s.heartbeatInterval / 2
+}
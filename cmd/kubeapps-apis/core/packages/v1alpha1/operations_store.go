@@ -0,0 +1,184 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"sync"
+
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// operationConfigMapPrefix is the prefix of the name of the ConfigMaps
+	// storing the operations.
+	operationConfigMapPrefix = "kubeapps-operation-"
+	// operationLabel identifies the ConfigMaps storing operations.
+	operationLabel = "apis.kubeapps.dev/operation"
+	// operationDataKey is the key of the ConfigMap data holding the operation.
+	operationDataKey = "operation"
+)
+
+// OperationStore persists the operations run by the core, so that they can
+// be retrieved by any replica of the server and after a restart.
+type OperationStore interface {
+	// Get returns the operation with the given id, or a NotFound error.
+	Get(ctx context.Context, id string) (*packages.Operation, error)
+	// Save creates or replaces the given operation.
+	Save(ctx context.Context, operation *packages.Operation) error
+	// List returns all the stored operations.
+	List(ctx context.Context) ([]*packages.Operation, error)
+	// Delete removes the operation with the given id, if it exists.
+	Delete(ctx context.Context, id string) error
+}
+
+// memoryOperationStore keeps the operations in memory only, so they are lost
+// when the server is restarted.
+type memoryOperationStore struct {
+	mu         sync.RWMutex
+	operations map[string]*packages.Operation
+}
+
+// NewMemoryOperationStore returns an OperationStore which does not survive a
+// restart of the server and is not shared between replicas.
+func NewMemoryOperationStore() OperationStore {
+	return &memoryOperationStore{
+		operations: map[string]*packages.Operation{},
+	}
+}
+
+func (s *memoryOperationStore) Get(ctx context.Context, id string) (*packages.Operation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	operation, ok := s.operations[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Unable to find the operation %q", id)
+	}
+	return proto.Clone(operation).(*packages.Operation), nil
+}
+
+func (s *memoryOperationStore) Save(ctx context.Context, operation *packages.Operation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.operations[operation.GetId()] = proto.Clone(operation).(*packages.Operation)
+	return nil
+}
+
+func (s *memoryOperationStore) List(ctx context.Context) ([]*packages.Operation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	operations := make([]*packages.Operation, 0, len(s.operations))
+	for _, operation := range s.operations {
+		operations = append(operations, proto.Clone(operation).(*packages.Operation))
+	}
+	return operations, nil
+}
+
+func (s *memoryOperationStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.operations, id)
+	return nil
+}
+
+// configMapOperationStore stores each operation in a ConfigMap, using the
+// credentials of the kubeapps-apis service account rather than those of the
+// user, since operations must be readable after the request has ended.
+type configMapOperationStore struct {
+	configMaps typedcorev1.ConfigMapInterface
+}
+
+// NewConfigMapOperationStore returns an OperationStore keeping each operation
+// in a ConfigMap of the given namespace.
+func NewConfigMapOperationStore(configMaps typedcorev1.ConfigMapsGetter, namespace string) OperationStore {
+	return &configMapOperationStore{
+		configMaps: configMaps.ConfigMaps(namespace),
+	}
+}
+
+func (s *configMapOperationStore) Get(ctx context.Context, id string) (*packages.Operation, error) {
+	cm, err := s.configMaps.Get(ctx, operationConfigMapPrefix+id, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find the operation %q", id)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get the operation %q: %v", id, err)
+	}
+	return operationFromConfigMap(cm)
+}
+
+func (s *configMapOperationStore) Save(ctx context.Context, operation *packages.Operation) error {
+	data, err := protojson.Marshal(operation)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to marshal the operation %q: %v", operation.GetId(), err)
+	}
+	cm := &k8scorev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: operationConfigMapPrefix + operation.GetId(),
+			Labels: map[string]string{
+				operationLabel: "true",
+			},
+		},
+		Data: map[string]string{
+			operationDataKey: string(data),
+		},
+	}
+	// Only the server running an operation updates it, so the update is
+	// unconditional.
+	_, err = s.configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	if errors.IsNotFound(err) {
+		_, err = s.configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to save the operation %q: %v", operation.GetId(), err)
+	}
+	return nil
+}
+
+func (s *configMapOperationStore) List(ctx context.Context) ([]*packages.Operation, error) {
+	cms, err := s.configMaps.List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{operationLabel: "true"}).String(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to list the operations: %v", err)
+	}
+	operations := make([]*packages.Operation, 0, len(cms.Items))
+	for i := range cms.Items {
+		operation, err := operationFromConfigMap(&cms.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, operation)
+	}
+	return operations, nil
+}
+
+func (s *configMapOperationStore) Delete(ctx context.Context, id string) error {
+	err := s.configMaps.Delete(ctx, operationConfigMapPrefix+id, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return status.Errorf(codes.Internal, "Unable to delete the operation %q: %v", id, err)
+	}
+	return nil
+}
+
+func operationFromConfigMap(cm *k8scorev1.ConfigMap) (*packages.Operation, error) {
+	data, ok := cm.Data[operationDataKey]
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "Unable to find the operation in the ConfigMap %q", cm.Name)
+	}
+	operation := &packages.Operation{}
+	if err := protojson.Unmarshal([]byte(data), operation); err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to unmarshal the operation in the ConfigMap %q: %v", cm.Name, err)
+	}
+	return operation, nil
+}
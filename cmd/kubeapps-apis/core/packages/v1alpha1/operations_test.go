@@ -0,0 +1,663 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const testHeartbeatInterval = 10 * time.Millisecond
+
+// allowAccess is an operationAccessFunc allowing access to every operation.
+func allowAccess(ctx context.Context, operation *corev1.Operation) error {
+	return nil
+}
+
+// denyAccess is an operationAccessFunc denying access to every operation.
+func denyAccess(ctx context.Context, operation *corev1.Operation) error {
+	return status.Errorf(codes.PermissionDenied, "denied")
+}
+
+// waitForOperation returns the operation once it is no longer running.
+func waitForOperation(t *testing.T, s *operationsServer, id string) *corev1.Operation {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		operation, err := s.getOperation(context.Background(), id, false)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if operation.GetState() != corev1.Operation_OPERATION_STATE_RUNNING {
+			return operation
+		}
+		time.Sleep(testHeartbeatInterval)
+	}
+	t.Fatalf("timed out waiting for the operation %q to finish", id)
+	return nil
+}
+
+func eventMessages(operation *corev1.Operation) []string {
+	messages := []string{}
+	for _, event := range operation.GetEvents() {
+		messages = append(messages, event.GetMessage())
+	}
+	return messages
+}
+
+func TestStartOperation(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		run                  operationFunc
+		expectedState        corev1.Operation_OperationState
+		expectedErrorCode    codes.Code
+		expectedErrorMessage string
+		expectedLastEvent    string
+	}{
+		{
+			name:              "records a successful operation",
+			run:               func(ctx context.Context) error { return nil },
+			expectedState:     corev1.Operation_OPERATION_STATE_SUCCEEDED,
+			expectedLastEvent: "The operation has succeeded",
+		},
+		{
+			name: "records the error of a failed operation",
+			run: func(ctx context.Context) error {
+				return status.Errorf(codes.PermissionDenied, "not allowed")
+			},
+			expectedState:        corev1.Operation_OPERATION_STATE_FAILED,
+			expectedErrorCode:    codes.PermissionDenied,
+			expectedErrorMessage: "not allowed",
+			expectedLastEvent:    "The operation has failed: not allowed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewOperationsServer(NewMemoryOperationStore(), testHeartbeatInterval)
+			ref := &corev1.InstalledPackageReference{
+				Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+				Identifier: "my-pkg",
+			}
+
+			operation, err := s.startOperation(context.Background(), corev1.Operation_OPERATION_TYPE_INSTALL, ref, tc.run, nil)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := operation.GetState(), corev1.Operation_OPERATION_STATE_RUNNING; got != want {
+				t.Errorf("got: %s, want: %s", got, want)
+			}
+
+			finished := waitForOperation(t, s, operation.GetId())
+			if got, want := finished.GetState(), tc.expectedState; got != want {
+				t.Errorf("got: %s, want: %s", got, want)
+			}
+			if got, want := codes.Code(finished.GetErrorCode()), tc.expectedErrorCode; got != want {
+				t.Errorf("got: %s, want: %s", got, want)
+			}
+			if got, want := finished.GetErrorMessage(), tc.expectedErrorMessage; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if !proto.Equal(finished.GetInstalledPackageRef(), ref) {
+				t.Errorf("got: %v, want: %v", finished.GetInstalledPackageRef(), ref)
+			}
+			if !proto.Equal(finished.GetContext(), ref.Context) {
+				t.Errorf("got: %v, want: %v", finished.GetContext(), ref.Context)
+			}
+			messages := eventMessages(finished)
+			if got, want := messages[len(messages)-1], tc.expectedLastEvent; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestStartOperationRecordsProgress(t *testing.T) {
+	s := NewOperationsServer(NewMemoryOperationStore(), testHeartbeatInterval)
+	release := make(chan struct{})
+	run := func(ctx context.Context) error {
+		<-release
+		return nil
+	}
+	progress := func(ctx context.Context) (string, error) {
+		return "The installed package status is \"Deploying\"", nil
+	}
+
+	operation, err := s.startOperation(context.Background(), corev1.Operation_OPERATION_TYPE_UPDATE, nil, run, progress)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	time.Sleep(5 * testHeartbeatInterval)
+	close(release)
+
+	finished := waitForOperation(t, s, operation.GetId())
+	expected := []string{
+		"The operation has started",
+		"The installed package status is \"Deploying\"",
+		"The operation has succeeded",
+	}
+	if got, want := eventMessages(finished), expected; !cmp.Equal(want, got) {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestStartOperationTimeout(t *testing.T) {
+	s := NewOperationsServer(NewMemoryOperationStore(), testHeartbeatInterval)
+	s.timeout = 5 * testHeartbeatInterval
+	release := make(chan struct{})
+	defer close(release)
+	// The run ignores the cancellation of its context.
+	run := func(ctx context.Context) error {
+		<-release
+		return nil
+	}
+
+	operation, err := s.startOperation(context.Background(), corev1.Operation_OPERATION_TYPE_INSTALL, nil, run, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	finished := waitForOperation(t, s, operation.GetId())
+	if got, want := finished.GetState(), corev1.Operation_OPERATION_STATE_FAILED; got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+	if got, want := codes.Code(finished.GetErrorCode()), codes.DeadlineExceeded; got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+}
+
+func TestGetOperation(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name              string
+		existing          *corev1.Operation
+		request           *corev1.GetOperationRequest
+		checkAccess       operationAccessFunc
+		statusCode        codes.Code
+		expectedState     corev1.Operation_OperationState
+		expectedErrorCode codes.Code
+	}{
+		{
+			name: "returns a running operation",
+			existing: &corev1.Operation{
+				Id:      "op-1",
+				State:   corev1.Operation_OPERATION_STATE_RUNNING,
+				Updated: timestamppb.New(now),
+			},
+			request:       &corev1.GetOperationRequest{OperationId: "op-1"},
+			checkAccess:   allowAccess,
+			expectedState: corev1.Operation_OPERATION_STATE_RUNNING,
+		},
+		{
+			name: "returns permission denied if the caller has no access to the operation",
+			existing: &corev1.Operation{
+				Id:      "op-1",
+				State:   corev1.Operation_OPERATION_STATE_RUNNING,
+				Updated: timestamppb.New(now),
+			},
+			request:     &corev1.GetOperationRequest{OperationId: "op-1"},
+			checkAccess: denyAccess,
+			statusCode:  codes.PermissionDenied,
+		},
+		{
+			name: "returns permission denied if the access cannot be checked",
+			existing: &corev1.Operation{
+				Id:      "op-1",
+				State:   corev1.Operation_OPERATION_STATE_RUNNING,
+				Updated: timestamppb.New(now),
+			},
+			request:    &corev1.GetOperationRequest{OperationId: "op-1"},
+			statusCode: codes.PermissionDenied,
+		},
+		{
+			name: "marks a running operation which is no longer updated as aborted",
+			existing: &corev1.Operation{
+				Id:      "op-1",
+				State:   corev1.Operation_OPERATION_STATE_RUNNING,
+				Updated: timestamppb.New(now.Add(-time.Minute)),
+			},
+			request:           &corev1.GetOperationRequest{OperationId: "op-1"},
+			checkAccess:       allowAccess,
+			expectedState:     corev1.Operation_OPERATION_STATE_FAILED,
+			expectedErrorCode: codes.Aborted,
+		},
+		{
+			name: "returns a finished operation which is no longer updated",
+			existing: &corev1.Operation{
+				Id:      "op-1",
+				State:   corev1.Operation_OPERATION_STATE_SUCCEEDED,
+				Updated: timestamppb.New(now.Add(-time.Minute)),
+			},
+			request:       &corev1.GetOperationRequest{OperationId: "op-1"},
+			checkAccess:   allowAccess,
+			expectedState: corev1.Operation_OPERATION_STATE_SUCCEEDED,
+		},
+		{
+			name:       "returns not found for an unknown operation",
+			request:    &corev1.GetOperationRequest{OperationId: "op-2"},
+			statusCode: codes.NotFound,
+		},
+		{
+			name:       "returns invalid argument if the operation id is missing",
+			request:    &corev1.GetOperationRequest{},
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewMemoryOperationStore()
+			if tc.existing != nil {
+				if err := store.Save(context.Background(), tc.existing); err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+			s := NewOperationsServer(store, testHeartbeatInterval)
+			s.checkAccess = tc.checkAccess
+
+			response, err := s.GetOperation(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+			if got, want := response.GetOperation().GetState(), tc.expectedState; got != want {
+				t.Errorf("got: %s, want: %s", got, want)
+			}
+			if got, want := codes.Code(response.GetOperation().GetErrorCode()), tc.expectedErrorCode; got != want {
+				t.Errorf("got: %s, want: %s", got, want)
+			}
+
+			// The aborted state is stored, not only returned.
+			stored, err := store.Get(context.Background(), tc.request.GetOperationId())
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := stored.GetState(), tc.expectedState; got != want {
+				t.Errorf("got: %s, want: %s", got, want)
+			}
+		})
+	}
+}
+
+// fakeWatchOperationServer records the operations sent on the stream.
+type fakeWatchOperationServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*corev1.WatchOperationResponse
+}
+
+func (s *fakeWatchOperationServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchOperationServer) Send(response *corev1.WatchOperationResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func TestWatchOperation(t *testing.T) {
+	s := NewOperationsServer(NewMemoryOperationStore(), testHeartbeatInterval)
+	s.checkAccess = allowAccess
+	release := make(chan struct{})
+	operation, err := s.startOperation(context.Background(), corev1.Operation_OPERATION_TYPE_DELETE, nil, func(ctx context.Context) error {
+		<-release
+		return nil
+	}, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream := &fakeWatchOperationServer{ctx: ctx}
+	go func() {
+		time.Sleep(5 * testHeartbeatInterval)
+		close(release)
+	}()
+
+	if err := s.WatchOperation(&corev1.WatchOperationRequest{OperationId: operation.GetId()}, stream); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if len(stream.responses) < 2 {
+		t.Fatalf("got: %d responses, want: at least 2", len(stream.responses))
+	}
+	if got, want := stream.responses[0].GetOperation().GetState(), corev1.Operation_OPERATION_STATE_RUNNING; got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+	if got, want := stream.responses[len(stream.responses)-1].GetOperation().GetState(), corev1.Operation_OPERATION_STATE_SUCCEEDED; got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+	for i := 1; i < len(stream.responses); i++ {
+		if proto.Equal(stream.responses[i-1], stream.responses[i]) {
+			t.Errorf("response %d was sent although the operation did not change", i)
+		}
+	}
+}
+
+func TestWatchOperationWithoutAccess(t *testing.T) {
+	s := NewOperationsServer(NewMemoryOperationStore(), testHeartbeatInterval)
+	s.checkAccess = denyAccess
+	operation, err := s.startOperation(context.Background(), corev1.Operation_OPERATION_TYPE_DELETE, nil, func(ctx context.Context) error {
+		return nil
+	}, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	stream := &fakeWatchOperationServer{ctx: context.Background()}
+
+	err = s.WatchOperation(&corev1.WatchOperationRequest{OperationId: operation.GetId()}, stream)
+
+	if got, want := status.Code(err), codes.PermissionDenied; got != want {
+		t.Errorf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+	if len(stream.responses) > 0 {
+		t.Errorf("got: %d responses, want: none", len(stream.responses))
+	}
+}
+
+func TestCheckOperationAccess(t *testing.T) {
+	operation := &corev1.Operation{
+		Id: "op-1",
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+			Identifier: "installed-pkg-1",
+			Plugin:     mockedPackagingPlugin1.plugin,
+		},
+		Context: &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+	}
+	testCases := []struct {
+		name       string
+		plugin     pkgPluginWithServer
+		operation  *corev1.Operation
+		statusCode codes.Code
+	}{
+		{
+			name:      "allows access when the caller can get the installed package",
+			plugin:    mockedPackagingPlugin1,
+			operation: operation,
+		},
+		{
+			name:      "allows access when the installed package does not exist",
+			plugin:    makeOnlyStatusTestPackagingPlugin("mock1", codes.NotFound),
+			operation: operation,
+		},
+		{
+			name:       "denies access when the caller is not allowed to get the installed package",
+			plugin:     makeOnlyStatusTestPackagingPlugin("mock1", codes.PermissionDenied),
+			operation:  operation,
+			statusCode: codes.PermissionDenied,
+		},
+		{
+			name:       "denies access when the installed package cannot be checked",
+			plugin:     makeOnlyStatusTestPackagingPlugin("mock1", codes.Internal),
+			operation:  operation,
+			statusCode: codes.PermissionDenied,
+		},
+		{
+			name:       "returns unauthenticated when the caller is not authenticated",
+			plugin:     makeOnlyStatusTestPackagingPlugin("mock1", codes.Unauthenticated),
+			operation:  operation,
+			statusCode: codes.Unauthenticated,
+		},
+		{
+			name:   "denies access when the plugin of the operation is unknown",
+			plugin: mockedPackagingPlugin1,
+			operation: &corev1.Operation{
+				Id: "op-1",
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "installed-pkg-1",
+					Plugin:     mockedPackagingPlugin2.plugin,
+				},
+			},
+			statusCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{tc.plugin},
+			}
+
+			err := server.checkOperationAccess(context.Background(), tc.operation)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Errorf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+		})
+	}
+}
+
+func TestPruneOperations(t *testing.T) {
+	store := NewMemoryOperationStore()
+	for _, operation := range []*corev1.Operation{
+		{Id: "old", State: corev1.Operation_OPERATION_STATE_SUCCEEDED, Updated: timestamppb.New(time.Now().Add(-2 * operationRetention))},
+		{Id: "recent", State: corev1.Operation_OPERATION_STATE_FAILED, Updated: timestamppb.Now()},
+	} {
+		if err := store.Save(context.Background(), operation); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	s := NewOperationsServer(store, testHeartbeatInterval)
+
+	s.pruneOperations(context.Background())
+
+	if _, err := store.Get(context.Background(), "old"); status.Code(err) != codes.NotFound {
+		t.Errorf("got: %+v, want: %+v", status.Code(err), codes.NotFound)
+	}
+	if _, err := store.Get(context.Background(), "recent"); err != nil {
+		t.Errorf("%+v", err)
+	}
+}
+
+// fakeConfigMaps keeps ConfigMaps in memory, implementing only the methods
+// used by the ConfigMap operation store.
+type fakeConfigMaps struct {
+	typedcorev1.ConfigMapInterface
+	mu         sync.Mutex
+	configMaps map[string]*k8scorev1.ConfigMap
+}
+
+func (f *fakeConfigMaps) ConfigMaps(namespace string) typedcorev1.ConfigMapInterface {
+	return f
+}
+
+func (f *fakeConfigMaps) notFound(name string) error {
+	return errors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, name)
+}
+
+func (f *fakeConfigMaps) Get(ctx context.Context, name string, opts metav1.GetOptions) (*k8scorev1.ConfigMap, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cm, ok := f.configMaps[name]
+	if !ok {
+		return nil, f.notFound(name)
+	}
+	return cm.DeepCopy(), nil
+}
+
+func (f *fakeConfigMaps) Create(ctx context.Context, cm *k8scorev1.ConfigMap, opts metav1.CreateOptions) (*k8scorev1.ConfigMap, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.configMaps[cm.Name]; ok {
+		return nil, errors.NewAlreadyExists(schema.GroupResource{Resource: "configmaps"}, cm.Name)
+	}
+	f.configMaps[cm.Name] = cm.DeepCopy()
+	return cm, nil
+}
+
+func (f *fakeConfigMaps) Update(ctx context.Context, cm *k8scorev1.ConfigMap, opts metav1.UpdateOptions) (*k8scorev1.ConfigMap, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.configMaps[cm.Name]; !ok {
+		return nil, f.notFound(cm.Name)
+	}
+	f.configMaps[cm.Name] = cm.DeepCopy()
+	return cm, nil
+}
+
+func (f *fakeConfigMaps) List(ctx context.Context, opts metav1.ListOptions) (*k8scorev1.ConfigMapList, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	selector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	list := &k8scorev1.ConfigMapList{}
+	for _, cm := range f.configMaps {
+		if selector.Matches(labels.Set(cm.Labels)) {
+			list.Items = append(list.Items, *cm.DeepCopy())
+		}
+	}
+	return list, nil
+}
+
+func (f *fakeConfigMaps) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.configMaps[name]; !ok {
+		return f.notFound(name)
+	}
+	delete(f.configMaps, name)
+	return nil
+}
+
+func TestConfigMapOperationStore(t *testing.T) {
+	ctx := context.Background()
+	configMaps := &fakeConfigMaps{configMaps: map[string]*k8scorev1.ConfigMap{
+		"unrelated": {ObjectMeta: metav1.ObjectMeta{Name: "unrelated"}},
+	}}
+	store := NewConfigMapOperationStore(configMaps, "kubeapps")
+	operation := &corev1.Operation{
+		Id:    "op-1",
+		Type:  corev1.Operation_OPERATION_TYPE_INSTALL,
+		State: corev1.Operation_OPERATION_STATE_RUNNING,
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+			Identifier: "my-pkg",
+			Plugin:     &plugins.Plugin{Name: "mock1", Version: "v1alpha1"},
+		},
+		Updated: timestamppb.Now(),
+	}
+
+	if _, err := store.Get(ctx, "op-1"); status.Code(err) != codes.NotFound {
+		t.Fatalf("got: %+v, want: %+v", status.Code(err), codes.NotFound)
+	}
+
+	// Saving creates the operation, then replaces it.
+	if err := store.Save(ctx, operation); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	operation.State = corev1.Operation_OPERATION_STATE_SUCCEEDED
+	if err := store.Save(ctx, operation); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if _, ok := configMaps.configMaps[operationConfigMapPrefix+"op-1"]; !ok {
+		t.Fatalf("expected the ConfigMap %q to exist", operationConfigMapPrefix+"op-1")
+	}
+
+	got, err := store.Get(ctx, "op-1")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !proto.Equal(got, operation) {
+		t.Errorf("got: %v, want: %v", got, operation)
+	}
+
+	operations, err := store.List(ctx)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(operations) != 1 || !proto.Equal(operations[0], operation) {
+		t.Errorf("got: %v, want: [%v]", operations, operation)
+	}
+
+	if err := store.Delete(ctx, "op-1"); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := store.Delete(ctx, "op-1"); err != nil {
+		t.Errorf("deleting a missing operation should succeed: %+v", err)
+	}
+	if _, err := store.Get(ctx, "op-1"); status.Code(err) != codes.NotFound {
+		t.Errorf("got: %+v, want: %+v", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestCreateInstalledPackageAsync(t *testing.T) {
+	request := &corev1.CreateInstalledPackageRequest{
+		AvailablePackageRef: &corev1.AvailablePackageReference{
+			Identifier: "available-pkg-1",
+			Plugin:     mockedPackagingPlugin1.plugin,
+		},
+		TargetContext: &corev1.Context{
+			Cluster:   "default",
+			Namespace: "my-ns",
+		},
+		Name:  "installed-pkg-1",
+		Async: true,
+	}
+
+	t.Run("returns a running operation which then succeeds", func(t *testing.T) {
+		operations := NewOperationsServer(NewMemoryOperationStore(), testHeartbeatInterval)
+		server := &packagesServer{
+			pluginsWithServers: []pkgPluginWithServer{mockedPackagingPlugin1},
+			operations:         operations,
+		}
+
+		response, err := server.CreateInstalledPackage(context.Background(), request)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+
+		expectedRef := &corev1.InstalledPackageReference{
+			Context:    request.TargetContext,
+			Identifier: "installed-pkg-1",
+			Plugin:     mockedPackagingPlugin1.plugin,
+		}
+		if !proto.Equal(response.GetInstalledPackageRef(), expectedRef) {
+			t.Errorf("got: %v, want: %v", response.GetInstalledPackageRef(), expectedRef)
+		}
+		if got, want := response.GetOperation().GetType(), corev1.Operation_OPERATION_TYPE_INSTALL; got != want {
+			t.Errorf("got: %s, want: %s", got, want)
+		}
+
+		finished := waitForOperation(t, operations, response.GetOperation().GetId())
+		if got, want := finished.GetState(), corev1.Operation_OPERATION_STATE_SUCCEEDED; got != want {
+			t.Errorf("got: %s, want: %s", got, want)
+		}
+	})
+
+	t.Run("returns failed precondition if operations are not enabled", func(t *testing.T) {
+		server := &packagesServer{
+			pluginsWithServers: []pkgPluginWithServer{mockedPackagingPlugin1},
+		}
+
+		_, err := server.CreateInstalledPackage(context.Background(), request)
+
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("got: %+v, want: %+v, err: %+v", got, want, err)
+		}
+	})
+}
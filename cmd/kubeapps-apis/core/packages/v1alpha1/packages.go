@@ -7,11 +7,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/ahmetb/go-linq/v3"
 	pluginsv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core/plugins/v1alpha1"
 	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgfilter"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -31,9 +33,17 @@ type packagesServer struct {
 	// pluginsWithServers is a slice of all registered pluginsWithServers which satisfy the core.packages.v1alpha1
 	// interface.
 	pluginsWithServers []pkgPluginWithServer
+
+	// pluginTimeout is the maximum time each plugin is given to respond when
+	// aggregating results from several plugins. Zero means no timeout.
+	pluginTimeout time.Duration
+
+	// operations runs the changes requested asynchronously. Async requests
+	// are rejected when nil.
+	operations *operationsServer
 }
 
-func NewPackagesServer(pkgingPlugins []pluginsv1alpha1.PluginWithServer) (*packagesServer, error) {
+func NewPackagesServer(pkgingPlugins []pluginsv1alpha1.PluginWithServer, pluginTimeout time.Duration, operations *operationsServer) (*packagesServer, error) {
 	// Verify that each plugin is indeed a packaging plugin while
 	// casting.
 	pluginsWithServer := make([]pkgPluginWithServer, len(pkgingPlugins))
@@ -48,9 +58,15 @@ func NewPackagesServer(pkgingPlugins []pluginsv1alpha1.PluginWithServer) (*packa
 		}
 		log.Infof("Registered %v for core.packaging.v1alpha1 packages aggregation.", p.Plugin)
 	}
-	return &packagesServer{
+	s := &packagesServer{
 		pluginsWithServers: pluginsWithServer,
-	}, nil
+		pluginTimeout:      pluginTimeout,
+		operations:         operations,
+	}
+	if operations != nil {
+		operations.checkAccess = s.checkOperationAccess
+	}
+	return s, nil
 }
 
 // GetAvailablePackages returns the packages based on the request.
@@ -59,17 +75,20 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 
 	pageSize := request.GetPaginationOptions().GetPageSize()
 
-	summariesWithOffsets, err := fanInAvailablePackageSummaries(ctx, s.pluginsWithServers, request)
+	summariesWithOffsets, err := fanInAvailablePackageSummaries(ctx, s.pluginsWithServers, s.pluginTimeout, request)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to request results from registered plugins: %v", err)
 	}
 
 	pkgs := []*packages.AvailablePackageSummary{}
 	categories := []string{}
+	var pluginErrors []*packages.PluginError
 	var pkgWithOffsets availableSummaryWithOffsets
 	for pkgWithOffsets = range summariesWithOffsets {
-		if pkgWithOffsets.err != nil {
-			return nil, pkgWithOffsets.err
+		if pkgWithOffsets.pluginError != nil {
+			log.Warningf("Plugin %q failed to return available package summaries: %s", pkgWithOffsets.pluginError.GetPlugin().GetName(), pkgWithOffsets.pluginError.GetMessage())
+			pluginErrors = append(pluginErrors, pkgWithOffsets.pluginError)
+			continue
 		}
 		pkgs = append(pkgs, pkgWithOffsets.availablePackageSummary)
 		categories = append(categories, pkgWithOffsets.categories...)
@@ -92,6 +111,10 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 		}
 	}
 
+	if err := allPluginsFailed(len(s.pluginsWithServers), len(pkgs), pluginErrors); err != nil {
+		return nil, err
+	}
+
 	// Delete duplicate categories and sort by name
 	From(categories).Distinct().OrderBy(func(i interface{}) interface{} { return i }).ToSlice(&categories)
 
@@ -99,6 +122,7 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 		AvailablePackageSummaries: pkgs,
 		Categories:                categories,
 		NextPageToken:             nextPageToken,
+		PluginErrors:              pluginErrors,
 	}, nil
 }
 
@@ -139,15 +163,26 @@ func (s packagesServer) GetInstalledPackageSummaries(ctx context.Context, reques
 
 	pageSize := request.GetPaginationOptions().GetPageSize()
 
-	summariesWithOffsets, err := fanInInstalledPackageSummaries(ctx, s.pluginsWithServers, request)
+	// Only request results from the plugins matching the filter options.
+	pkgPlugins := []pkgPluginWithServer{}
+	for _, p := range s.pluginsWithServers {
+		if pkgfilter.MatchesPluginName(request.GetFilterOptions(), p.plugin.GetName()) {
+			pkgPlugins = append(pkgPlugins, p)
+		}
+	}
+
+	summariesWithOffsets, err := fanInInstalledPackageSummaries(ctx, pkgPlugins, s.pluginTimeout, request)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to request results from registered plugins: %v", err)
 	}
 	pkgs := []*packages.InstalledPackageSummary{}
+	var pluginErrors []*packages.PluginError
 	var pkgWithOffsets installedSummaryWithOffsets
 	for pkgWithOffsets = range summariesWithOffsets {
-		if pkgWithOffsets.err != nil {
-			return nil, pkgWithOffsets.err
+		if pkgWithOffsets.pluginError != nil {
+			log.Warningf("Plugin %q failed to return installed package summaries: %s", pkgWithOffsets.pluginError.GetPlugin().GetName(), pkgWithOffsets.pluginError.GetMessage())
+			pluginErrors = append(pluginErrors, pkgWithOffsets.pluginError)
+			continue
 		}
 		pkgs = append(pkgs, pkgWithOffsets.installedPackageSummary)
 		if pageSize > 0 && len(pkgs) >= int(pageSize) {
@@ -169,9 +204,14 @@ func (s packagesServer) GetInstalledPackageSummaries(ctx context.Context, reques
 		}
 	}
 
+	if err := allPluginsFailed(len(pkgPlugins), len(pkgs), pluginErrors); err != nil {
+		return nil, err
+	}
+
 	return &packages.GetInstalledPackageSummariesResponse{
 		InstalledPackageSummaries: pkgs,
 		NextPageToken:             nextPageToken,
+		PluginErrors:              pluginErrors,
 	}, nil
 }
 
@@ -277,6 +317,30 @@ func (s packagesServer) CreateInstalledPackage(ctx context.Context, request *pac
 		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.AvailablePackageRef.Plugin)
 	}
 
+	if request.GetAsync() {
+		installedPackageRef := &packages.InstalledPackageReference{
+			Context:    request.GetTargetContext(),
+			Identifier: request.GetName(),
+			Plugin:     request.GetAvailablePackageRef().GetPlugin(),
+		}
+		operation, err := s.startOperation(ctx, packages.Operation_OPERATION_TYPE_INSTALL, installedPackageRef, func(ctx context.Context) error {
+			_, err := s.createInstalledPackage(ctx, pluginWithServer, request)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &packages.CreateInstalledPackageResponse{
+			InstalledPackageRef: installedPackageRef,
+			Operation:           operation,
+		}, nil
+	}
+
+	return s.createInstalledPackage(ctx, pluginWithServer, request)
+}
+
+// createInstalledPackage creates the installed package using the given plugin.
+func (s packagesServer) createInstalledPackage(ctx context.Context, pluginWithServer *pkgPluginWithServer, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.CreateInstalledPackage(ctx, request)
 	if err != nil {
@@ -305,6 +369,25 @@ func (s packagesServer) UpdateInstalledPackage(ctx context.Context, request *pac
 		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
 	}
 
+	if request.GetAsync() {
+		operation, err := s.startOperation(ctx, packages.Operation_OPERATION_TYPE_UPDATE, request.InstalledPackageRef, func(ctx context.Context) error {
+			_, err := s.updateInstalledPackage(ctx, pluginWithServer, request)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &packages.UpdateInstalledPackageResponse{
+			InstalledPackageRef: request.InstalledPackageRef,
+			Operation:           operation,
+		}, nil
+	}
+
+	return s.updateInstalledPackage(ctx, pluginWithServer, request)
+}
+
+// updateInstalledPackage updates the installed package using the given plugin.
+func (s packagesServer) updateInstalledPackage(ctx context.Context, pluginWithServer *pkgPluginWithServer, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.UpdateInstalledPackage(ctx, request)
 	if err != nil {
@@ -333,6 +416,24 @@ func (s packagesServer) DeleteInstalledPackage(ctx context.Context, request *pac
 		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
 	}
 
+	if request.GetAsync() {
+		operation, err := s.startOperation(ctx, packages.Operation_OPERATION_TYPE_DELETE, request.InstalledPackageRef, func(ctx context.Context) error {
+			_, err := s.deleteInstalledPackage(ctx, pluginWithServer, request)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &packages.DeleteInstalledPackageResponse{
+			Operation: operation,
+		}, nil
+	}
+
+	return s.deleteInstalledPackage(ctx, pluginWithServer, request)
+}
+
+// deleteInstalledPackage deletes the installed package using the given plugin.
+func (s packagesServer) deleteInstalledPackage(ctx context.Context, pluginWithServer *pkgPluginWithServer, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
 	// Get the response from the requested plugin
 	response, err := pluginWithServer.server.DeleteInstalledPackage(ctx, request)
 	if err != nil {
@@ -342,6 +443,144 @@ func (s packagesServer) DeleteInstalledPackage(ctx context.Context, request *pac
 	return response, nil
 }
 
+// GetInstalledPackageRevisions returns the revisions of an installed package using configured plugins.
+func (s packagesServer) GetInstalledPackageRevisions(ctx context.Context, request *packages.GetInstalledPackageRevisionsRequest) (*packages.GetInstalledPackageRevisionsResponse, error) {
+	log.InfoS("+core GetInstalledPackageRevisions", "cluster", request.GetInstalledPackageRef().GetContext().GetCluster(), "namespace", request.GetInstalledPackageRef().GetContext().GetNamespace())
+
+	if request.GetInstalledPackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetInstalledPackageRevisions(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to get the revisions for the installed package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// RollbackInstalledPackage rolls back an installed package to a previous revision using configured plugins.
+func (s packagesServer) RollbackInstalledPackage(ctx context.Context, request *packages.RollbackInstalledPackageRequest) (*packages.RollbackInstalledPackageResponse, error) {
+	log.InfoS("+core RollbackInstalledPackage", "cluster", request.GetInstalledPackageRef().GetContext().GetCluster(), "namespace", request.GetInstalledPackageRef().GetContext().GetNamespace())
+
+	if request.GetInstalledPackageRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.RollbackInstalledPackage(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to rollback the installed package %q using the plugin %q: %v", request.InstalledPackageRef.Identifier, request.InstalledPackageRef.Plugin.Name, err)
+	}
+
+	// Validate the plugin response
+	if response.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.Internal, "Invalid RollbackInstalledPackage response from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// WatchInstalledPackageSummaries streams changes to the installed packages
+// from all configured plugins until the request is cancelled. The error of a
+// plugin whose watch fails is sent to the client as a plugin error.
+func (s packagesServer) WatchInstalledPackageSummaries(request *packages.WatchInstalledPackageSummariesRequest, stream packages.PackagesService_WatchInstalledPackageSummariesServer) error {
+	log.InfoS("+core WatchInstalledPackageSummaries", "cluster", request.GetContext().GetCluster(), "namespace", request.GetContext().GetNamespace())
+
+	pluginErrors := fanInWatchInstalledPackageSummaries(s.pluginsWithServers, request, stream)
+	for _, pluginError := range pluginErrors {
+		log.Warningf("Plugin %q failed to watch installed package summaries: %s", pluginError.GetPlugin().GetName(), pluginError.GetMessage())
+	}
+
+	return allPluginsFailed(len(s.pluginsWithServers), 0, pluginErrors)
+}
+
+// startOperation starts an operation running the given change of an installed
+// package in the background. Except for deletions, the status of the installed
+// package is used to report the progress of the operation.
+func (s packagesServer) startOperation(ctx context.Context, operationType packages.Operation_OperationType, installedPackageRef *packages.InstalledPackageReference, run operationFunc) (*packages.Operation, error) {
+	if s.operations == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Unable to run the request asynchronously (operations are not enabled)")
+	}
+
+	var progress operationProgressFunc
+	if operationType != packages.Operation_OPERATION_TYPE_DELETE {
+		progress = func(ctx context.Context) (string, error) {
+			response, err := s.GetInstalledPackageDetail(ctx, &packages.GetInstalledPackageDetailRequest{
+				InstalledPackageRef: installedPackageRef,
+			})
+			if err != nil {
+				// The installed package may not exist yet when it is being installed.
+				if status.Code(err) == codes.NotFound {
+					return "", nil
+				}
+				return "", err
+			}
+			pkgStatus := response.GetInstalledPackageDetail().GetStatus()
+			if pkgStatus.GetUserReason() == "" {
+				return "", nil
+			}
+			return fmt.Sprintf("The installed package status is %q", pkgStatus.GetUserReason()), nil
+		}
+	}
+	return s.operations.startOperation(ctx, operationType, installedPackageRef, run, progress)
+}
+
+// checkOperationAccess returns an error unless the caller can get the installed
+// package of the operation with their own credentials, in the cluster and
+// namespace of the request which started it. The installed package not being
+// found is not an error, since it does not exist yet at the start of an install
+// nor any longer after a deletion.
+func (s packagesServer) checkOperationAccess(ctx context.Context, operation *packages.Operation) error {
+	installedPackageRef := &packages.InstalledPackageReference{
+		Context:    operation.GetContext(),
+		Identifier: operation.GetInstalledPackageRef().GetIdentifier(),
+		Plugin:     operation.GetInstalledPackageRef().GetPlugin(),
+	}
+	if installedPackageRef.GetPlugin() == nil {
+		return status.Errorf(codes.PermissionDenied, "Unable to check the access to the operation %q (missing InstalledPackageRef.Plugin)", operation.GetId())
+	}
+	pluginWithServer := s.getPluginWithServer(installedPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return status.Errorf(codes.PermissionDenied, "Unable to check the access to the operation %q (unknown plugin %v)", operation.GetId(), installedPackageRef.Plugin)
+	}
+
+	_, err := pluginWithServer.server.GetInstalledPackageDetail(ctx, &packages.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: installedPackageRef,
+	})
+	switch status.Code(err) {
+	case codes.OK, codes.NotFound:
+		return nil
+	case codes.Unauthenticated:
+		return status.Errorf(codes.Unauthenticated, "Unable to access the operation %q: %v", operation.GetId(), status.Convert(err).Message())
+	default:
+		return status.Errorf(codes.PermissionDenied, "Unable to access the operation %q: %v", operation.GetId(), status.Convert(err).Message())
+	}
+}
+
+// allPluginsFailed returns an error when every plugin failed and there are
+// no results at all, so that errors such as an Unauthenticated status are
+// still returned as such rather than as an empty response.
+func allPluginsFailed(numPlugins, numResults int, pluginErrors []*packages.PluginError) error {
+	if numResults > 0 || len(pluginErrors) == 0 || len(pluginErrors) < numPlugins {
+		return nil
+	}
+	return status.Errorf(codes.Code(pluginErrors[0].GetCode()), "Unable to request results from registered plugins: %s", pluginErrors[0].GetMessage())
+}
+
 // getPluginWithServer returns the *pkgPluginsWithServer from a given packagesServer
 // matching the plugin name
 func (s packagesServer) getPluginWithServer(plugin *v1alpha1.Plugin) *pkgPluginWithServer {
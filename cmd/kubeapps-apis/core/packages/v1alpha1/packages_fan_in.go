@@ -6,9 +6,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgfilter"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog/v2"
 )
 
 const CompleteToken = -1
@@ -31,13 +37,52 @@ func getPluginPageOffsets(po *packages.PaginationOptions, numPlugins int) (map[s
 	return pluginPageOffsets, pluginPageSize, nil
 }
 
+// newPluginError returns the PluginError for an error returned by the plugin.
+func newPluginError(plugin *v1alpha1.Plugin, err error) *packages.PluginError {
+	st := status.Convert(err)
+	return &packages.PluginError{
+		Plugin:  plugin,
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+}
+
+// callWithTimeout calls fn with a context limited by the given timeout (if
+// non-zero). The call is made in a separate go-routine so that a plugin which
+// does not honour the context deadline cannot hold up the aggregated response.
+func callWithTimeout[T any](ctx context.Context, timeout time.Duration, fn func(context.Context) (T, error)) (T, error) {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type result struct {
+		value T
+		err   error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		value, err := fn(ctx)
+		resultCh <- result{value: value, err: err}
+	}()
+
+	select {
+	case r := <-resultCh:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero T
+		return zero, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
 // availableSummaryWithOffsets is the channel type for the results of the combined
 // core results after fanning in from the plugins.
 type availableSummaryWithOffsets struct {
 	availablePackageSummary *packages.AvailablePackageSummary
 	categories              []string
 	nextItemOffsets         map[string]int
-	err                     error
+	pluginError             *packages.PluginError
 }
 
 // fanInAvailablePackageSummaries fans in the results from the separate plugins
@@ -51,11 +96,16 @@ type availableSummaryWithOffsets struct {
 // offsets for each plugin. The next request the begins each plugin where it
 // left off for the last.
 //
+// An error from a plugin does not abort the fan-in. Instead, the error is sent
+// down the return channel with the plugin marked as complete, so that the
+// caller can return the results of the healthy plugins together with the error.
+// Each plugin is given at most pluginTimeout (if non-zero) to respond.
+//
 // Plugins generally do not use snapshots of the actual data, so, similar to the
 // pagination of individual plugins, it will be possible that this returns
 // duplicates or missing data if data is added or removed between paginated
 // requests.
-func fanInAvailablePackageSummaries(ctx context.Context, pkgPlugins []pkgPluginWithServer, request *packages.GetAvailablePackageSummariesRequest) (<-chan availableSummaryWithOffsets, error) {
+func fanInAvailablePackageSummaries(ctx context.Context, pkgPlugins []pkgPluginWithServer, pluginTimeout time.Duration, request *packages.GetAvailablePackageSummariesRequest) (<-chan availableSummaryWithOffsets, error) {
 	summariesCh := make(chan availableSummaryWithOffsets)
 
 	pluginPageOffsets, pluginPageSize, err := getPluginPageOffsets(request.GetPaginationOptions(), len(pkgPlugins))
@@ -76,7 +126,7 @@ func fanInAvailablePackageSummaries(ctx context.Context, pkgPlugins []pkgPluginW
 			},
 		}
 
-		ch, err := sendAvailablePackageSummariesForPlugin(ctx, pluginWithSrv, r)
+		ch, err := sendAvailablePackageSummariesForPlugin(ctx, pluginWithSrv, pluginTimeout, r)
 		if err != nil {
 			return nil, err
 		}
@@ -105,11 +155,14 @@ func fanInAvailablePackageSummaries(ctx context.Context, pkgPlugins []pkgPluginW
 					}
 
 					if nextItems[i] != nil && nextItems[i].err != nil {
+						// The plugin closes its channel after an error, so we
+						// report the error and treat the plugin as exhausted.
+						pluginPageOffsets[pkgPlugins[i].plugin.Name] = CompleteToken
 						summariesCh <- availableSummaryWithOffsets{
-							err: nextItems[i].err,
+							pluginError:     newPluginError(pkgPlugins[i].plugin, nextItems[i].err),
+							nextItemOffsets: pluginPageOffsets,
 						}
-						close(summariesCh)
-						return
+						nextItems[i] = nil
 					}
 				}
 			}
@@ -168,7 +221,7 @@ type availableSummaryWithOffset struct {
 
 // sendAvailablePackageSummariesForPlugin returns a channel and sends the
 // available package summaries returned by the plugin for the given request.
-func sendAvailablePackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPluginWithServer, request *packages.GetAvailablePackageSummariesRequest) (<-chan *availableSummaryWithOffset, error) {
+func sendAvailablePackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPluginWithServer, pluginTimeout time.Duration, request *packages.GetAvailablePackageSummariesRequest) (<-chan *availableSummaryWithOffset, error) {
 	summaryCh := make(chan *availableSummaryWithOffset)
 
 	itemOffset, err := paginate.ItemOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
@@ -188,7 +241,9 @@ func sendAvailablePackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPl
 	// improvement.
 	go func() {
 		for {
-			response, err := pkgPlugin.server.GetAvailablePackageSummaries(ctx, request)
+			response, err := callWithTimeout(ctx, pluginTimeout, func(ctx context.Context) (*packages.GetAvailablePackageSummariesResponse, error) {
+				return pkgPlugin.server.GetAvailablePackageSummaries(ctx, request)
+			})
 			if err != nil {
 				summaryCh <- &availableSummaryWithOffset{err: err}
 				close(summaryCh)
@@ -215,6 +270,8 @@ func sendAvailablePackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPl
 				summaryCh <- &availableSummaryWithOffset{
 					err: fmt.Errorf("inconsistent item offset: got: %q, expected: %d", response.GetNextPageToken(), itemOffset),
 				}
+				close(summaryCh)
+				return
 			}
 			request.PaginationOptions.PageToken = response.GetNextPageToken()
 		}
@@ -232,7 +289,7 @@ func sendAvailablePackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPl
 type installedSummaryWithOffsets struct {
 	installedPackageSummary *packages.InstalledPackageSummary
 	nextItemOffsets         map[string]int
-	err                     error
+	pluginError             *packages.PluginError
 }
 
 // fanInInstalledPackageSummaries fans in the results from the separate plugins
@@ -246,11 +303,16 @@ type installedSummaryWithOffsets struct {
 // offsets for each plugin. The next request the begins each plugin where it
 // left off for the last.
 //
+// An error from a plugin does not abort the fan-in. Instead, the error is sent
+// down the return channel with the plugin marked as complete, so that the
+// caller can return the results of the healthy plugins together with the error.
+// Each plugin is given at most pluginTimeout (if non-zero) to respond.
+//
 // Plugins generally do not use snapshots of the actual data, so, similar to the
 // pagination of individual plugins, it will be possible that this returns
 // duplicates or missing data if data is added or removed between paginated
 // requests.
-func fanInInstalledPackageSummaries(ctx context.Context, pkgPlugins []pkgPluginWithServer, request *packages.GetInstalledPackageSummariesRequest) (<-chan installedSummaryWithOffsets, error) {
+func fanInInstalledPackageSummaries(ctx context.Context, pkgPlugins []pkgPluginWithServer, pluginTimeout time.Duration, request *packages.GetInstalledPackageSummariesRequest) (<-chan installedSummaryWithOffsets, error) {
 	summariesCh := make(chan installedSummaryWithOffsets)
 
 	pluginPageOffsets, pluginPageSize, err := getPluginPageOffsets(request.GetPaginationOptions(), len(pkgPlugins))
@@ -268,9 +330,10 @@ func fanInInstalledPackageSummaries(ctx context.Context, pkgPlugins []pkgPluginW
 				PageSize:  int32(pluginPageSize),
 				PageToken: fmt.Sprintf("%d", pluginPageOffsets[pluginWithSrv.plugin.Name]),
 			},
+			FilterOptions: request.FilterOptions,
 		}
 
-		ch, err := sendInstalledPackageSummariesForPlugin(ctx, pluginWithSrv, r)
+		ch, err := sendInstalledPackageSummariesForPlugin(ctx, pluginWithSrv, pluginTimeout, r)
 		if err != nil {
 			return nil, err
 		}
@@ -299,11 +362,14 @@ func fanInInstalledPackageSummaries(ctx context.Context, pkgPlugins []pkgPluginW
 					}
 
 					if nextItems[i] != nil && nextItems[i].err != nil {
+						// The plugin closes its channel after an error, so we
+						// report the error and treat the plugin as exhausted.
+						pluginPageOffsets[pkgPlugins[i].plugin.Name] = CompleteToken
 						summariesCh <- installedSummaryWithOffsets{
-							err: nextItems[i].err,
+							pluginError:     newPluginError(pkgPlugins[i].plugin, nextItems[i].err),
+							nextItemOffsets: pluginPageOffsets,
 						}
-						close(summariesCh)
-						return
+						nextItems[i] = nil
 					}
 				}
 			}
@@ -360,7 +426,7 @@ type installedSummaryWithOffset struct {
 
 // sendInstalledPackageSummariesForPlugin returns a channel and sends the
 // available package summaries returned by the plugin for the given request.
-func sendInstalledPackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPluginWithServer, request *packages.GetInstalledPackageSummariesRequest) (<-chan *installedSummaryWithOffset, error) {
+func sendInstalledPackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPluginWithServer, pluginTimeout time.Duration, request *packages.GetInstalledPackageSummariesRequest) (<-chan *installedSummaryWithOffset, error) {
 	summaryCh := make(chan *installedSummaryWithOffset)
 
 	itemOffset, err := paginate.ItemOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
@@ -380,7 +446,9 @@ func sendInstalledPackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPl
 	// improvement.
 	go func() {
 		for {
-			response, err := pkgPlugin.server.GetInstalledPackageSummaries(ctx, request)
+			response, err := callWithTimeout(ctx, pluginTimeout, func(ctx context.Context) (*packages.GetInstalledPackageSummariesResponse, error) {
+				return pkgPlugin.server.GetInstalledPackageSummaries(ctx, request)
+			})
 			if err != nil {
 				summaryCh <- &installedSummaryWithOffset{err: err}
 				close(summaryCh)
@@ -388,6 +456,12 @@ func sendInstalledPackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPl
 			}
 			for _, summary := range response.InstalledPackageSummaries {
 				itemOffset = itemOffset + 1
+				// Plugins are expected to apply the filter options themselves
+				// (so that their pagination is correct), but we ensure that
+				// no plugin returns unfiltered results.
+				if !pkgfilter.MatchesInstalledPackageSummary(request.GetFilterOptions(), summary) {
+					continue
+				}
 				summaryCh <- &installedSummaryWithOffset{
 					installedPackageSummary: summary,
 					nextItemOffset:          itemOffset,
@@ -403,6 +477,8 @@ func sendInstalledPackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPl
 				summaryCh <- &installedSummaryWithOffset{
 					err: fmt.Errorf("inconsistent item offset: got: %q, expected: %d", response.GetNextPageToken(), itemOffset),
 				}
+				close(summaryCh)
+				return
 			}
 			request.PaginationOptions.PageToken = response.GetNextPageToken()
 		}
@@ -410,3 +486,61 @@ func sendInstalledPackageSummariesForPlugin(ctx context.Context, pkgPlugin pkgPl
 
 	return summaryCh, nil
 }
+
+// pluginWatchStream is the stream passed to each plugin's
+// WatchInstalledPackageSummaries, so that the events from all plugins are
+// sent, one at a time, on the single stream to the client.
+type pluginWatchStream struct {
+	packages.PackagesService_WatchInstalledPackageSummariesServer
+	ctx context.Context
+	mu  *sync.Mutex
+}
+
+func (s pluginWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s pluginWatchStream) Send(response *packages.WatchInstalledPackageSummariesResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.PackagesService_WatchInstalledPackageSummariesServer.Send(response)
+}
+
+// fanInWatchInstalledPackageSummaries runs the watch of each plugin
+// concurrently, sending all events on the given stream until every plugin
+// watch has ended. A failing plugin does not end the watches of other plugins,
+// but its error is sent to the client so that it knows that no more events
+// will be received from that plugin.
+func fanInWatchInstalledPackageSummaries(pkgPlugins []pkgPluginWithServer, request *packages.WatchInstalledPackageSummariesRequest, stream packages.PackagesService_WatchInstalledPackageSummariesServer) []*packages.PluginError {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	mu := &sync.Mutex{}
+	errCh := make(chan *packages.PluginError, len(pkgPlugins))
+	for _, p := range pkgPlugins {
+		go func(pkgPlugin pkgPluginWithServer) {
+			pluginStream := pluginWatchStream{stream, ctx, mu}
+			err := pkgPlugin.server.WatchInstalledPackageSummaries(request, pluginStream)
+			if err != nil {
+				pluginError := newPluginError(pkgPlugin.plugin, err)
+				// There is no one to tell once the client has gone away.
+				if ctx.Err() == nil {
+					if sendErr := pluginStream.Send(&packages.WatchInstalledPackageSummariesResponse{PluginError: pluginError}); sendErr != nil {
+						log.Errorf("Unable to send the watch error of plugin %q: %v", pkgPlugin.plugin.GetName(), sendErr)
+					}
+				}
+				errCh <- pluginError
+				return
+			}
+			errCh <- nil
+		}(p)
+	}
+
+	var pluginErrors []*packages.PluginError
+	for range pkgPlugins {
+		if pluginError := <-errCh; pluginError != nil {
+			pluginErrors = append(pluginErrors, pluginError)
+		}
+	}
+	return pluginErrors
+}
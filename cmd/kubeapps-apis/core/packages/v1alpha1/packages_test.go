@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -13,6 +14,7 @@ import (
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugin_test"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -25,6 +27,7 @@ var mockedPackagingPlugin1 = makeDefaultTestPackagingPlugin("mock1")
 var mockedPackagingPlugin2 = makeDefaultTestPackagingPlugin("mock2")
 var mockedPackagingPlugin3 = makeDefaultTestPackagingPlugin("mock2")
 var mockedNotFoundPackagingPlugin = makeOnlyStatusTestPackagingPlugin("bad-plugin", codes.NotFound)
+var mockedSlowPackagingPlugin = makeSlowTestPackagingPlugin("slow-plugin", time.Second)
 
 var ignoreUnexportedOpts = cmpopts.IgnoreUnexported(
 	corev1.AvailablePackageDetail{},
@@ -37,14 +40,18 @@ var ignoreUnexportedOpts = cmpopts.IgnoreUnexported(
 	corev1.GetInstalledPackageResourceRefsResponse{},
 	corev1.GetInstalledPackageDetailResponse{},
 	corev1.GetInstalledPackageSummariesResponse{},
+	corev1.GetInstalledPackageRevisionsResponse{},
 	corev1.CreateInstalledPackageResponse{},
+	corev1.RollbackInstalledPackageResponse{},
 	corev1.UpdateInstalledPackageResponse{},
 	corev1.InstalledPackageDetail{},
 	corev1.InstalledPackageReference{},
+	corev1.InstalledPackageRevision{},
 	corev1.InstalledPackageStatus{},
 	corev1.InstalledPackageSummary{},
 	corev1.Maintainer{},
 	corev1.PackageAppVersion{},
+	corev1.PluginError{},
 	corev1.VersionReference{},
 	corev1.ResourceRef{},
 	plugins.Plugin{},
@@ -89,10 +96,17 @@ func makeOnlyStatusTestPackagingPlugin(pluginName string, statusCode codes.Code)
 	}
 }
 
+func makeSlowTestPackagingPlugin(pluginName string, delay time.Duration) pkgPluginWithServer {
+	p := makeDefaultTestPackagingPlugin(pluginName)
+	p.server.(*plugin_test.TestPackagingPluginServer).Delay = delay
+	return p
+}
+
 func TestGetAvailablePackageSummaries(t *testing.T) {
 	testCases := []struct {
 		name              string
 		configuredPlugins []pkgPluginWithServer
+		pluginTimeout     time.Duration
 		statusCode        codes.Code
 		request           *corev1.GetAvailablePackageSummariesRequest
 		expectedResponse  *corev1.GetAvailablePackageSummariesResponse
@@ -266,7 +280,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 			statusCode: codes.OK,
 		},
 		{
-			name: "it should fail when calling the core GetAvailablePackageSummaries operation when the plugin returns a 404 for the api call",
+			name: "it should return partial results when calling the core GetAvailablePackageSummaries operation when a plugin returns a 404 for the api call",
 			configuredPlugins: []pkgPluginWithServer{
 				mockedPackagingPlugin1,
 				mockedNotFoundPackagingPlugin,
@@ -279,8 +293,61 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 			},
 
 			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
-				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{},
-				Categories:                []string{""},
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+				},
+				Categories: []string{"cat-1"},
+				PluginErrors: []*corev1.PluginError{
+					{
+						Plugin:  mockedNotFoundPackagingPlugin.plugin,
+						Code:    int32(codes.NotFound),
+						Message: "Non-OK response",
+					},
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return partial results when calling the core GetAvailablePackageSummaries operation when a plugin does not respond in time",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedSlowPackagingPlugin,
+			},
+			pluginTimeout: 10 * time.Millisecond,
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
+
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*corev1.AvailablePackageSummary{
+					plugin_test.MakeAvailablePackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeAvailablePackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+				},
+				Categories: []string{"cat-1"},
+				PluginErrors: []*corev1.PluginError{
+					{
+						Plugin:  mockedSlowPackagingPlugin.plugin,
+						Code:    int32(codes.DeadlineExceeded),
+						Message: "context deadline exceeded",
+					},
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail when calling the core GetAvailablePackageSummaries operation when all plugins return an error",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
 			},
 			statusCode: codes.NotFound,
 		},
@@ -290,6 +357,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			server := &packagesServer{
 				pluginsWithServers: tc.configuredPlugins,
+				pluginTimeout:      tc.pluginTimeout,
 			}
 			availablePackageSummaries, err := server.GetAvailablePackageSummaries(context.Background(), tc.request)
 
@@ -384,6 +452,7 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 	testCases := []struct {
 		name              string
 		configuredPlugins []pkgPluginWithServer
+		pluginTimeout     time.Duration
 		statusCode        codes.Code
 		request           *corev1.GetInstalledPackageSummariesRequest
 		expectedResponse  *corev1.GetInstalledPackageSummariesResponse
@@ -412,7 +481,7 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 			statusCode: codes.OK,
 		},
 		{
-			name: "it should fail when calling the core GetInstalledPackageSummaries operation when the package is not present in a plugin",
+			name: "it should return partial results when calling the core GetInstalledPackageSummaries operation when a plugin returns an error",
 			configuredPlugins: []pkgPluginWithServer{
 				mockedPackagingPlugin1,
 				mockedNotFoundPackagingPlugin,
@@ -424,9 +493,139 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 				},
 			},
 
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+				},
+				PluginErrors: []*corev1.PluginError{
+					{
+						Plugin:  mockedNotFoundPackagingPlugin.plugin,
+						Code:    int32(codes.NotFound),
+						Message: "Non-OK response",
+					},
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return partial results when calling the core GetInstalledPackageSummaries operation when a plugin does not respond in time",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedSlowPackagingPlugin,
+			},
+			pluginTimeout: 10 * time.Millisecond,
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+				},
+				PluginErrors: []*corev1.PluginError{
+					{
+						Plugin:  mockedSlowPackagingPlugin.plugin,
+						Code:    int32(codes.DeadlineExceeded),
+						Message: "context deadline exceeded",
+					},
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should only request results from the plugins matching the filter options",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Plugins: []*plugins.Plugin{mockedPackagingPlugin2.plugin},
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin2.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should return the results from all plugins matching the filter options",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Query: "pkg-2",
+				},
+			},
+
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+					plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin2.plugin),
+				},
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should filter out results from plugins which do not apply the filter options",
+			configuredPlugins: []pkgPluginWithServer{
+				{
+					plugin: mockedPackagingPlugin1.plugin,
+					server: &plugin_test.TestPackagingPluginServer{
+						Plugin: mockedPackagingPlugin1.plugin,
+						InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+							plugin_test.MakeInstalledPackageSummary("pkg-1", mockedPackagingPlugin1.plugin),
+							plugin_test.MakeInstalledPackageSummary("pkg-2", mockedPackagingPlugin1.plugin),
+						},
+						IgnoreFilterOptions: true,
+					},
+				},
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					UpgradeAvailable: true,
+				},
+			},
+
 			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
 				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{},
 			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail when calling the core GetInstalledPackageSummaries operation when all plugins return an error",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedNotFoundPackagingPlugin,
+			},
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			},
 			statusCode: codes.NotFound,
 		},
 	}
@@ -435,6 +634,7 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			server := &packagesServer{
 				pluginsWithServers: tc.configuredPlugins,
+				pluginTimeout:      tc.pluginTimeout,
 			}
 			installedPackageSummaries, err := server.GetInstalledPackageSummaries(context.Background(), tc.request)
 
@@ -843,6 +1043,185 @@ func TestDeleteInstalledPackage(t *testing.T) {
 	}
 }
 
+func TestGetInstalledPackageRevisions(t *testing.T) {
+	installedPlugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+	revisions := []*corev1.InstalledPackageRevision{
+		{
+			ReleaseRevision: 2,
+			Version:         &corev1.PackageAppVersion{PkgVersion: "1.2.4", AppVersion: "2.0.1"},
+			ValuesApplied:   "{\"replicas\": 2}",
+			Status: &corev1.InstalledPackageStatus{
+				Ready:  true,
+				Reason: corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+			},
+		},
+		{
+			ReleaseRevision: 1,
+			Version:         &corev1.PackageAppVersion{PkgVersion: "1.2.3", AppVersion: "2.0.0"},
+			Status: &corev1.InstalledPackageStatus{
+				Reason: corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		statusCode       codes.Code
+		pluginStatusCode codes.Code
+		request          *corev1.GetInstalledPackageRevisionsRequest
+		expectedResponse *corev1.GetInstalledPackageRevisionsResponse
+	}{
+		{
+			name: "returns the revisions from the plugin",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+			statusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageRevisionsResponse{
+				Revisions: revisions,
+			},
+		},
+		{
+			name: "returns invalid argument if plugin not specified in request",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "installed-pkg-1",
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns internal error if unable to find the plugin",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "installed-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "other-plugin.packages", Version: "v1alpha1"},
+				},
+			},
+			statusCode: codes.Internal,
+		},
+		{
+			name: "returns the status code from the plugin",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+			pluginStatusCode: codes.Unimplemented,
+			statusCode:       codes.Unimplemented,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{
+					{
+						plugin: installedPlugin,
+						server: &plugin_test.TestPackagingPluginServer{
+							Plugin:    installedPlugin,
+							Revisions: revisions,
+							Status:    tc.pluginStatusCode,
+						},
+					},
+				},
+			}
+
+			response, err := server.GetInstalledPackageRevisions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
+func TestRollbackInstalledPackage(t *testing.T) {
+	installedPlugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
+
+	testCases := []struct {
+		name             string
+		statusCode       codes.Code
+		request          *corev1.RollbackInstalledPackageRequest
+		expectedResponse *corev1.RollbackInstalledPackageResponse
+	}{
+		{
+			name: "rolls back the installed package",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+				ReleaseRevision: 1,
+			},
+			statusCode: codes.OK,
+			expectedResponse: &corev1.RollbackInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "installed-pkg-1",
+					Plugin:     installedPlugin,
+				},
+			},
+		},
+		{
+			name: "returns invalid argument if plugin not specified in request",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "installed-pkg-1",
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns internal error if unable to find the plugin",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "installed-pkg-1",
+					Plugin:     &plugins.Plugin{Name: "other-plugin.packages", Version: "v1alpha1"},
+				},
+			},
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: []pkgPluginWithServer{
+					{
+						plugin: installedPlugin,
+						server: &plugin_test.TestPackagingPluginServer{Plugin: installedPlugin},
+					},
+				},
+			}
+
+			response, err := server.RollbackInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedOpts))
+				}
+			}
+		})
+	}
+}
+
 func TestGetInstalledPackageResourceRefs(t *testing.T) {
 	installedPlugin := &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"}
 
@@ -932,3 +1311,122 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 		})
 	}
 }
+
+// fakeWatchInstalledPackageSummariesServer records the events sent on the stream.
+type fakeWatchInstalledPackageSummariesServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*corev1.WatchInstalledPackageSummariesResponse
+}
+
+func (s *fakeWatchInstalledPackageSummariesServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchInstalledPackageSummariesServer) Send(response *corev1.WatchInstalledPackageSummariesResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func TestWatchInstalledPackageSummaries(t *testing.T) {
+	addedEvent := func(identifier string, plugin *plugins.Plugin) *corev1.WatchInstalledPackageSummariesResponse {
+		return &corev1.WatchInstalledPackageSummariesResponse{
+			EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+			InstalledPackageSummary: plugin_test.MakeInstalledPackageSummary(identifier, plugin),
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []pkgPluginWithServer
+		statusCode        codes.Code
+		expectedResponses []*corev1.WatchInstalledPackageSummariesResponse
+	}{
+		{
+			name: "it should send the events from all plugins",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedPackagingPlugin2,
+			},
+			expectedResponses: []*corev1.WatchInstalledPackageSummariesResponse{
+				addedEvent("pkg-1", mockedPackagingPlugin1.plugin),
+				addedEvent("pkg-1", mockedPackagingPlugin2.plugin),
+				addedEvent("pkg-2", mockedPackagingPlugin1.plugin),
+				addedEvent("pkg-2", mockedPackagingPlugin2.plugin),
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should send the events from other plugins and the error when a plugin returns an error",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedPackagingPlugin1,
+				mockedNotFoundPackagingPlugin,
+			},
+			expectedResponses: []*corev1.WatchInstalledPackageSummariesResponse{
+				{
+					PluginError: &corev1.PluginError{
+						Plugin:  mockedNotFoundPackagingPlugin.plugin,
+						Code:    int32(codes.NotFound),
+						Message: "Non-OK response",
+					},
+				},
+				addedEvent("pkg-1", mockedPackagingPlugin1.plugin),
+				addedEvent("pkg-2", mockedPackagingPlugin1.plugin),
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "it should fail when all plugins return an error",
+			configuredPlugins: []pkgPluginWithServer{
+				mockedNotFoundPackagingPlugin,
+			},
+			expectedResponses: []*corev1.WatchInstalledPackageSummariesResponse{
+				{
+					PluginError: &corev1.PluginError{
+						Plugin:  mockedNotFoundPackagingPlugin.plugin,
+						Code:    int32(codes.NotFound),
+						Message: "Non-OK response",
+					},
+				},
+			},
+			statusCode: codes.NotFound,
+		},
+	}
+
+	sortEvents := cmpopts.SortSlices(func(a, b *corev1.WatchInstalledPackageSummariesResponse) bool {
+		refA, refB := a.GetInstalledPackageSummary().GetInstalledPackageRef(), b.GetInstalledPackageSummary().GetInstalledPackageRef()
+		if refA.GetIdentifier() != refB.GetIdentifier() {
+			return refA.GetIdentifier() < refB.GetIdentifier()
+		}
+		return refA.GetPlugin().GetName() < refB.GetPlugin().GetName()
+	})
+	opts := cmp.Options{
+		ignoreUnexportedOpts,
+		cmpopts.IgnoreUnexported(corev1.WatchInstalledPackageSummariesResponse{}),
+		sortEvents,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &packagesServer{
+				pluginsWithServers: tc.configuredPlugins,
+			}
+			stream := &fakeWatchInstalledPackageSummariesServer{ctx: context.Background()}
+
+			err := server.WatchInstalledPackageSummaries(&corev1.WatchInstalledPackageSummariesRequest{
+				Context: &corev1.Context{
+					Cluster:   "",
+					Namespace: globalPackagingNamespace,
+				},
+			}, stream)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if got, want := stream.responses, tc.expectedResponses; !cmp.Equal(got, want, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
@@ -203,6 +203,61 @@ func (s repositoriesServer) DeletePackageRepository(ctx context.Context, request
 	return response, nil
 }
 
+// RefreshPackageRepository requests that the package repository fetches
+// the latest packages from the upstream, using configured plugins.
+func (s repositoriesServer) RefreshPackageRepository(ctx context.Context, request *packages.RefreshPackageRepositoryRequest) (*packages.RefreshPackageRepositoryResponse, error) {
+	log.InfoS("+core RefreshPackageRepository", "cluster", request.GetPackageRepoRef().GetContext().GetCluster(), "namespace", request.GetPackageRepoRef().GetContext().GetNamespace(), "id", request.GetPackageRepoRef().GetIdentifier())
+
+	if request.GetPackageRepoRef().GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing PackageRepoRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.PackageRepoRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", request.PackageRepoRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.RefreshPackageRepository(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to refresh the package repository %q using the plugin %q: %v",
+			request.PackageRepoRef.Identifier, request.PackageRepoRef.Plugin.Name, err)
+	}
+
+	// Validate the plugin response
+	if response.PackageRepoRef == nil {
+		return nil, status.Errorf(codes.Internal, "Invalid RefreshPackageRepository response from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	return response, nil
+}
+
+// ValidatePackageRepository checks whether a package repository can be
+// reached with the given details, without adding it, using configured plugins.
+func (s repositoriesServer) ValidatePackageRepository(ctx context.Context, request *packages.ValidatePackageRepositoryRequest) (*packages.ValidatePackageRepositoryResponse, error) {
+	repo := request.GetPackageRepository()
+	log.InfoS("+core ValidatePackageRepository", "name", repo.GetName(), "cluster", repo.GetContext().GetCluster(), "namespace", repo.GetContext().GetNamespace())
+
+	if repo.GetPlugin() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing PackageRepository.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(repo.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable to get the plugin %v", repo.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.ValidatePackageRepository(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to validate the package repository %q using the plugin %q: %v", repo.Name, repo.Plugin.Name, err)
+	}
+
+	return response, nil
+}
+
 // getPluginWithServer returns the *pkgPluginsWithServer from a given packagesServer
 // matching the plugin name
 func (s repositoriesServer) getPluginWithServer(plugin *v1alpha1.Plugin) *repoPluginsWithServer {
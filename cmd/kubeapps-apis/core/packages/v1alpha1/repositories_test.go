@@ -32,6 +32,8 @@ var ignoreUnexportedRepoOpts = cmpopts.IgnoreUnexported(
 	corev1.GetPackageRepositorySummariesResponse{},
 	corev1.PackageRepositorySummary{},
 	corev1.UpdatePackageRepositoryResponse{},
+	corev1.RefreshPackageRepositoryResponse{},
+	corev1.ValidatePackageRepositoryResponse{},
 )
 
 func makeDefaultTestRepositoriesPlugin(pluginName string) repoPluginsWithServer {
@@ -435,3 +437,164 @@ func TestDeletePackageRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestRefreshPackageRepository(t *testing.T) {
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []*plugins.Plugin
+		statusCode        codes.Code
+		request           *corev1.RefreshPackageRepositoryRequest
+		expectedResponse  *corev1.RefreshPackageRepositoryResponse
+	}{
+		{
+			name: "refreshes the package repository using the correct plugin",
+			configuredPlugins: []*plugins.Plugin{
+				{Name: "plugin-1", Version: "v1alpha1"},
+				{Name: "plugin-2", Version: "v1alpha1"},
+			},
+			statusCode: codes.OK,
+			request: &corev1.RefreshPackageRepositoryRequest{
+				PackageRepoRef: &corev1.PackageRepositoryReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "repo-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+			},
+			expectedResponse: &corev1.RefreshPackageRepositoryResponse{
+				PackageRepoRef: &corev1.PackageRepositoryReference{
+					Context:    &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Identifier: "repo-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+			},
+		},
+		{
+			name:       "returns invalid argument if plugin not specified in request",
+			statusCode: codes.InvalidArgument,
+			request: &corev1.RefreshPackageRepositoryRequest{
+				PackageRepoRef: &corev1.PackageRepositoryReference{
+					Identifier: "repo-1",
+				},
+			},
+		},
+		{
+			name:       "returns internal error if unable to find the plugin",
+			statusCode: codes.Internal,
+			request: &corev1.RefreshPackageRepositoryRequest{
+				PackageRepoRef: &corev1.PackageRepositoryReference{
+					Identifier: "repo-1",
+					Plugin:     &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configuredPluginServers := []repoPluginsWithServer{}
+			for _, p := range tc.configuredPlugins {
+				configuredPluginServers = append(configuredPluginServers, repoPluginsWithServer{
+					plugin: p,
+					server: plugin_test.TestRepositoriesPluginServer{Plugin: p},
+				})
+			}
+
+			server := &repositoriesServer{
+				pluginsWithServers: configuredPluginServers,
+			}
+
+			refreshedRepoResponse, err := server.RefreshPackageRepository(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := refreshedRepoResponse, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedRepoOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedRepoOpts))
+				}
+			}
+		})
+	}
+}
+
+func TestValidatePackageRepository(t *testing.T) {
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []repoPluginsWithServer
+		statusCode        codes.Code
+		request           *corev1.ValidatePackageRepositoryRequest
+		expectedResponse  *corev1.ValidatePackageRepositoryResponse
+	}{
+		{
+			name:              "validates the package repository using the correct plugin",
+			configuredPlugins: []repoPluginsWithServer{mockedRepoPlugin1, mockedRepoPlugin2},
+			statusCode:        codes.OK,
+			request: &corev1.ValidatePackageRepositoryRequest{
+				PackageRepository: &corev1.AddPackageRepositoryRequest{
+					Name:    "repo-1",
+					Context: &corev1.Context{Cluster: "default", Namespace: "my-ns"},
+					Url:     "https://example.com",
+					Plugin:  mockedRepoPlugin1.plugin,
+				},
+			},
+			expectedResponse: &corev1.ValidatePackageRepositoryResponse{
+				Valid: true,
+			},
+		},
+		{
+			name:              "returns invalid argument if plugin not specified in request",
+			configuredPlugins: []repoPluginsWithServer{mockedRepoPlugin1},
+			statusCode:        codes.InvalidArgument,
+			request: &corev1.ValidatePackageRepositoryRequest{
+				PackageRepository: &corev1.AddPackageRepositoryRequest{
+					Name: "repo-1",
+				},
+			},
+		},
+		{
+			name:              "returns internal error if unable to find the plugin",
+			configuredPlugins: []repoPluginsWithServer{mockedRepoPlugin1},
+			statusCode:        codes.Internal,
+			request: &corev1.ValidatePackageRepositoryRequest{
+				PackageRepository: &corev1.AddPackageRepositoryRequest{
+					Name:   "repo-1",
+					Plugin: &plugins.Plugin{Name: "plugin-1", Version: "v1alpha1"},
+				},
+			},
+		},
+		{
+			name:              "returns the status code of the plugin",
+			configuredPlugins: []repoPluginsWithServer{mockedNotFoundRepoPlugin},
+			statusCode:        codes.NotFound,
+			request: &corev1.ValidatePackageRepositoryRequest{
+				PackageRepository: &corev1.AddPackageRepositoryRequest{
+					Name:   "repo-1",
+					Plugin: mockedNotFoundRepoPlugin.plugin,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &repositoriesServer{
+				pluginsWithServers: tc.configuredPlugins,
+			}
+
+			validateRepoResponse, err := server.ValidatePackageRepository(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := validateRepoResponse, tc.expectedResponse; !cmp.Equal(got, want, ignoreUnexportedRepoOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexportedRepoOpts))
+				}
+			}
+		})
+	}
+}
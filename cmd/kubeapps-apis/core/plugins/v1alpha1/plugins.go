@@ -41,6 +41,9 @@ const (
 // a plugin's RegisterWithGRPCServer function must accept. This allows
 // the arguments to be defined (or modified) in the one place.
 type GRPCPluginRegistrationOptions struct {
+	// Ctx is cancelled once the server stops serving, so that plugins can
+	// stop any background work, such as watches or caches.
+	Ctx              context.Context
 	Registrar        grpc.ServiceRegistrar
 	ConfigGetter     core.KubernetesConfigGetter
 	ClustersConfig   kube.ClustersConfig
@@ -135,7 +138,7 @@ func (s *PluginsServer) registerPlugins(pluginPaths []string, grpcReg grpc.Servi
 			return err
 		}
 
-		if grpcServer, err := s.registerGRPC(p, pluginDetail, grpcReg, configGetter, serveOpts); err != nil {
+		if grpcServer, err := s.registerGRPC(gwArgs.Ctx, p, pluginDetail, grpcReg, configGetter, serveOpts); err != nil {
 			return err
 		} else {
 			pluginsWithServers = append(pluginsWithServers, PluginWithServer{
@@ -151,6 +154,17 @@ func (s *PluginsServer) registerPlugins(pluginPaths []string, grpcReg grpc.Servi
 		log.InfoS("Successfully registered plugin", "pluginPath", pluginPath)
 	}
 
+	// Register any out-of-process plugins configured in the plugin config.
+	remotePlugins, err := parseRemotePluginsConfig(serveOpts.PluginConfigPath)
+	if err != nil {
+		return fmt.Errorf("unable to parse remote plugins config: %w", err)
+	}
+	remotePluginsWithServers, err := registerRemotePlugins(remotePlugins)
+	if err != nil {
+		return err
+	}
+	pluginsWithServers = append(pluginsWithServers, remotePluginsWithServers...)
+
 	sortPlugins(pluginsWithServers)
 
 	s.pluginsWithServers = pluginsWithServers
@@ -159,7 +173,7 @@ func (s *PluginsServer) registerPlugins(pluginPaths []string, grpcReg grpc.Servi
 }
 
 // registerGRPC finds and calls the required function for registering the plugin for the GRPC server.
-func (s *PluginsServer) registerGRPC(p *plugin.Plugin, pluginDetail *plugins.Plugin, registrar grpc.ServiceRegistrar,
+func (s *PluginsServer) registerGRPC(ctx context.Context, p *plugin.Plugin, pluginDetail *plugins.Plugin, registrar grpc.ServiceRegistrar,
 	configGetter core.KubernetesConfigGetter, serveOpts core.ServeOptions) (interface{}, error) {
 	grpcRegFn, err := p.Lookup(grpcRegisterFunction)
 	if err != nil {
@@ -176,6 +190,7 @@ func (s *PluginsServer) registerGRPC(p *plugin.Plugin, pluginDetail *plugins.Plu
 	}
 
 	server, err := grpcFn(GRPCPluginRegistrationOptions{
+		Ctx:              ctx,
 		Registrar:        registrar,
 		ConfigGetter:     configGetter,
 		ClustersConfig:   s.clustersConfig,
@@ -0,0 +1,264 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	remotePackagesService     = "packages"
+	remoteRepositoriesService = "repositories"
+)
+
+// RemotePluginConfig defines a plugin which runs out-of-process (for example,
+// as a sidecar) and serves the core packages and/or repositories APIs over gRPC.
+// These are configured in the plugin config file under core.plugins.v1alpha1.remotePlugins.
+type RemotePluginConfig struct {
+	// Name and Version identify the plugin, as the GetPluginDetail function
+	// does for .so plugins.
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// Address is the gRPC target on which the plugin is served, such as
+	// "unix:///var/run/kubeapps/my-plugin.sock" or "localhost:50052".
+	Address string `json:"address"`
+	// Services lists the core services implemented by the plugin. Valid values
+	// are "packages" and "repositories". Defaults to ["packages"].
+	Services []string `json:"services"`
+}
+
+// parseRemotePluginsConfig returns the remote plugins configured in the plugin
+// config file, if any.
+func parseRemotePluginsConfig(pluginConfigPath string) ([]RemotePluginConfig, error) {
+	if pluginConfigPath == "" {
+		return nil, nil
+	}
+
+	type remotePluginsConfig struct {
+		Core struct {
+			Plugins struct {
+				V1alpha1 struct {
+					RemotePlugins []RemotePluginConfig `json:"remotePlugins"`
+				} `json:"v1alpha1"`
+			} `json:"plugins"`
+		} `json:"core"`
+	}
+	var config remotePluginsConfig
+
+	// #nosec G304
+	pluginConfig, err := ioutil.ReadFile(pluginConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open plugin config at %q: %w", pluginConfigPath, err)
+	}
+	err = json.Unmarshal(pluginConfig, &config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal pluginconfig: %q error: %w", string(pluginConfig), err)
+	}
+
+	remotePlugins := config.Core.Plugins.V1alpha1.RemotePlugins
+	for i, p := range remotePlugins {
+		if p.Name == "" || p.Version == "" || p.Address == "" {
+			return nil, fmt.Errorf("remote plugin %d requires a name, version and address: %+v", i, p)
+		}
+		if len(p.Services) == 0 {
+			remotePlugins[i].Services = []string{remotePackagesService}
+		}
+		for _, svc := range remotePlugins[i].Services {
+			if svc != remotePackagesService && svc != remoteRepositoriesService {
+				return nil, fmt.Errorf("remote plugin %q has an unsupported service %q", p.Name, svc)
+			}
+		}
+	}
+	return remotePlugins, nil
+}
+
+// registerRemotePlugins creates a client connection for each configured
+// remote plugin and returns a PluginWithServer whose server forwards requests
+// to the remote process.
+//
+// The connections are established lazily by grpc, so a remote plugin which is
+// not yet available (or which crashes) results in errors for the requests
+// routed to it rather than a failure of the core server.
+func registerRemotePlugins(remotePlugins []RemotePluginConfig) ([]PluginWithServer, error) {
+	pluginsWithServers := []PluginWithServer{}
+	for _, p := range remotePlugins {
+		conn, err := grpc.Dial(p.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to create client for remote plugin %q at %q: %w", p.Name, p.Address, err)
+		}
+		server := newRemotePluginServer(conn, p.Services)
+		pluginsWithServers = append(pluginsWithServers, PluginWithServer{
+			Plugin: &plugins.Plugin{
+				Name:    p.Name,
+				Version: p.Version,
+			},
+			Server: server,
+		})
+		log.InfoS("Successfully registered remote plugin", "plugin", p.Name, "address", p.Address)
+	}
+	return pluginsWithServers, nil
+}
+
+// newRemotePluginServer returns a server which satisfies only the core
+// interfaces for the services implemented by the remote plugin, so that
+// GetPluginsSatisfyingInterface does not aggregate services which the plugin
+// does not serve.
+func newRemotePluginServer(conn grpc.ClientConnInterface, services []string) interface{} {
+	var pkgs, repos bool
+	for _, svc := range services {
+		switch svc {
+		case remotePackagesService:
+			pkgs = true
+		case remoteRepositoriesService:
+			repos = true
+		}
+	}
+	pkgsServer := &remotePackagesServer{client: packages.NewPackagesServiceClient(conn)}
+	reposServer := &remoteRepositoriesServer{client: packages.NewRepositoriesServiceClient(conn)}
+	switch {
+	case pkgs && repos:
+		return &remotePackagesAndRepositoriesServer{pkgsServer, reposServer}
+	case repos:
+		return reposServer
+	default:
+		return pkgsServer
+	}
+}
+
+// forwardContext returns an outgoing context with the incoming metadata (in
+// particular, the user's authorization) so the remote plugin can act on behalf
+// of the user.
+func forwardContext(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		return metadata.NewOutgoingContext(ctx, md.Copy())
+	}
+	return ctx
+}
+
+// remotePackagesServer implements the core packages API by forwarding each
+// request to a remote plugin.
+type remotePackagesServer struct {
+	packages.UnimplementedPackagesServiceServer
+	client packages.PackagesServiceClient
+}
+
+var _ packages.PackagesServiceServer = (*remotePackagesServer)(nil)
+
+func (s *remotePackagesServer) GetAvailablePackageSummaries(ctx context.Context, request *packages.GetAvailablePackageSummariesRequest) (*packages.GetAvailablePackageSummariesResponse, error) {
+	return s.client.GetAvailablePackageSummaries(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetAvailablePackageDetail(ctx context.Context, request *packages.GetAvailablePackageDetailRequest) (*packages.GetAvailablePackageDetailResponse, error) {
+	return s.client.GetAvailablePackageDetail(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetAvailablePackageVersions(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error) {
+	return s.client.GetAvailablePackageVersions(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error) {
+	return s.client.GetInstalledPackageSummaries(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageDetail(ctx context.Context, request *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error) {
+	return s.client.GetInstalledPackageDetail(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
+	return s.client.CreateInstalledPackage(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
+	return s.client.UpdateInstalledPackage(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
+	return s.client.DeleteInstalledPackage(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageResourceRefs(ctx context.Context, request *packages.GetInstalledPackageResourceRefsRequest) (*packages.GetInstalledPackageResourceRefsResponse, error) {
+	return s.client.GetInstalledPackageResourceRefs(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) GetInstalledPackageRevisions(ctx context.Context, request *packages.GetInstalledPackageRevisionsRequest) (*packages.GetInstalledPackageRevisionsResponse, error) {
+	return s.client.GetInstalledPackageRevisions(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) RollbackInstalledPackage(ctx context.Context, request *packages.RollbackInstalledPackageRequest) (*packages.RollbackInstalledPackageResponse, error) {
+	return s.client.RollbackInstalledPackage(forwardContext(ctx), request)
+}
+
+func (s *remotePackagesServer) WatchInstalledPackageSummaries(request *packages.WatchInstalledPackageSummariesRequest, stream packages.PackagesService_WatchInstalledPackageSummariesServer) error {
+	client, err := s.client.WatchInstalledPackageSummaries(forwardContext(stream.Context()), request)
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := client.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// remoteRepositoriesServer implements the core repositories API by forwarding
+// each request to a remote plugin.
+type remoteRepositoriesServer struct {
+	packages.UnimplementedRepositoriesServiceServer
+	client packages.RepositoriesServiceClient
+}
+
+var _ packages.RepositoriesServiceServer = (*remoteRepositoriesServer)(nil)
+
+func (s *remoteRepositoriesServer) AddPackageRepository(ctx context.Context, request *packages.AddPackageRepositoryRequest) (*packages.AddPackageRepositoryResponse, error) {
+	return s.client.AddPackageRepository(forwardContext(ctx), request)
+}
+
+func (s *remoteRepositoriesServer) GetPackageRepositoryDetail(ctx context.Context, request *packages.GetPackageRepositoryDetailRequest) (*packages.GetPackageRepositoryDetailResponse, error) {
+	return s.client.GetPackageRepositoryDetail(forwardContext(ctx), request)
+}
+
+func (s *remoteRepositoriesServer) GetPackageRepositorySummaries(ctx context.Context, request *packages.GetPackageRepositorySummariesRequest) (*packages.GetPackageRepositorySummariesResponse, error) {
+	return s.client.GetPackageRepositorySummaries(forwardContext(ctx), request)
+}
+
+func (s *remoteRepositoriesServer) UpdatePackageRepository(ctx context.Context, request *packages.UpdatePackageRepositoryRequest) (*packages.UpdatePackageRepositoryResponse, error) {
+	return s.client.UpdatePackageRepository(forwardContext(ctx), request)
+}
+
+func (s *remoteRepositoriesServer) DeletePackageRepository(ctx context.Context, request *packages.DeletePackageRepositoryRequest) (*packages.DeletePackageRepositoryResponse, error) {
+	return s.client.DeletePackageRepository(forwardContext(ctx), request)
+}
+
+func (s *remoteRepositoriesServer) RefreshPackageRepository(ctx context.Context, request *packages.RefreshPackageRepositoryRequest) (*packages.RefreshPackageRepositoryResponse, error) {
+	return s.client.RefreshPackageRepository(forwardContext(ctx), request)
+}
+
+func (s *remoteRepositoriesServer) ValidatePackageRepository(ctx context.Context, request *packages.ValidatePackageRepositoryRequest) (*packages.ValidatePackageRepositoryResponse, error) {
+	return s.client.ValidatePackageRepository(forwardContext(ctx), request)
+}
+
+// remotePackagesAndRepositoriesServer is used for remote plugins which
+// implement both the core packages and repositories APIs.
+type remotePackagesAndRepositoriesServer struct {
+	*remotePackagesServer
+	*remoteRepositoriesServer
+}
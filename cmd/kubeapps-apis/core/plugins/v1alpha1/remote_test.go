@@ -0,0 +1,298 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"net"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	packages "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+	"sigs.k8s.io/yaml"
+)
+
+func TestParseRemotePluginsConfig(t *testing.T) {
+	testCases := []struct {
+		name             string
+		pluginYAMLConf   []byte
+		expectedPlugins  []RemotePluginConfig
+		expectedErrorStr string
+	}{
+		{
+			name:            "no plugin config path",
+			pluginYAMLConf:  nil,
+			expectedPlugins: nil,
+		},
+		{
+			name: "no remote plugins configured",
+			pluginYAMLConf: []byte(`
+core:
+  packages:
+    v1alpha1:
+      timeoutSeconds: 650
+`),
+			expectedPlugins: nil,
+		},
+		{
+			name: "remote plugins with default and explicit services",
+			pluginYAMLConf: []byte(`
+core:
+  plugins:
+    v1alpha1:
+      remotePlugins:
+        - name: acme.packages
+          version: v1alpha1
+          address: unix:///var/run/acme/plugin.sock
+        - name: other.packages
+          version: v1alpha2
+          address: localhost:50052
+          services: ["packages", "repositories"]
+`),
+			expectedPlugins: []RemotePluginConfig{
+				{
+					Name:     "acme.packages",
+					Version:  "v1alpha1",
+					Address:  "unix:///var/run/acme/plugin.sock",
+					Services: []string{"packages"},
+				},
+				{
+					Name:     "other.packages",
+					Version:  "v1alpha2",
+					Address:  "localhost:50052",
+					Services: []string{"packages", "repositories"},
+				},
+			},
+		},
+		{
+			name: "missing address",
+			pluginYAMLConf: []byte(`
+core:
+  plugins:
+    v1alpha1:
+      remotePlugins:
+        - name: acme.packages
+          version: v1alpha1
+`),
+			expectedErrorStr: "requires a name, version and address",
+		},
+		{
+			name: "unsupported service",
+			pluginYAMLConf: []byte(`
+core:
+  plugins:
+    v1alpha1:
+      remotePlugins:
+        - name: acme.packages
+          version: v1alpha1
+          address: localhost:50052
+          services: ["resources"]
+`),
+			expectedErrorStr: "unsupported service \"resources\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filename := ""
+			if tc.pluginYAMLConf != nil {
+				pluginJSONConf, err := yaml.YAMLToJSON(tc.pluginYAMLConf)
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				f, err := os.CreateTemp(t.TempDir(), "plugin_json_conf")
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if _, err := f.Write(pluginJSONConf); err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if err := f.Close(); err != nil {
+					t.Fatalf("%+v", err)
+				}
+				filename = f.Name()
+			}
+
+			remotePlugins, err := parseRemotePluginsConfig(filename)
+			if tc.expectedErrorStr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErrorStr) {
+					t.Fatalf("got: %v, want error containing: %q", err, tc.expectedErrorStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := remotePlugins, tc.expectedPlugins; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestNewRemotePluginServerSatisfiesInterfaces(t *testing.T) {
+	pkgsInterface := reflect.TypeOf((*packages.PackagesServiceServer)(nil)).Elem()
+	reposInterface := reflect.TypeOf((*packages.RepositoriesServiceServer)(nil)).Elem()
+
+	testCases := []struct {
+		name          string
+		services      []string
+		expectedPkgs  bool
+		expectedRepos bool
+	}{
+		{
+			name:         "packages only",
+			services:     []string{"packages"},
+			expectedPkgs: true,
+		},
+		{
+			name:          "repositories only",
+			services:      []string{"repositories"},
+			expectedRepos: true,
+		},
+		{
+			name:          "packages and repositories",
+			services:      []string{"repositories", "packages"},
+			expectedPkgs:  true,
+			expectedRepos: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ps := PluginsServer{
+				pluginsWithServers: []PluginWithServer{
+					{Server: newRemotePluginServer(nil, tc.services)},
+				},
+			}
+
+			if got, want := len(ps.GetPluginsSatisfyingInterface(pkgsInterface)) == 1, tc.expectedPkgs; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+			if got, want := len(ps.GetPluginsSatisfyingInterface(reposInterface)) == 1, tc.expectedRepos; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+// fakeRemotePackagesServer records the authorization it receives.
+type fakeRemotePackagesServer struct {
+	packages.UnimplementedPackagesServiceServer
+	authorization []string
+}
+
+func (s *fakeRemotePackagesServer) GetAvailablePackageSummaries(ctx context.Context, request *packages.GetAvailablePackageSummariesRequest) (*packages.GetAvailablePackageSummariesResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.authorization = md["authorization"]
+	return &packages.GetAvailablePackageSummariesResponse{
+		Categories: []string{"remote"},
+	}, nil
+}
+
+func (s *fakeRemotePackagesServer) WatchInstalledPackageSummaries(request *packages.WatchInstalledPackageSummariesRequest, stream packages.PackagesService_WatchInstalledPackageSummariesServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	s.authorization = md["authorization"]
+	for _, name := range []string{"pkg-1", "pkg-2"} {
+		err := stream.Send(&packages.WatchInstalledPackageSummariesResponse{
+			EventType: packages.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+			InstalledPackageSummary: &packages.InstalledPackageSummary{
+				Name: name,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fakeWatchStream records the names of the installed packages sent on the stream.
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	names []string
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Send(response *packages.WatchInstalledPackageSummariesResponse) error {
+	s.names = append(s.names, response.GetInstalledPackageSummary().GetName())
+	return nil
+}
+
+// newRemotePackagesServerForTest returns a remote packages server which
+// forwards to the given fake server over an in-memory connection.
+func newRemotePackagesServerForTest(t *testing.T, fakeServer *fakeRemotePackagesServer) packages.PackagesServiceServer {
+	lis := bufconn.Listen(1024 * 1024)
+	grpcSrv := grpc.NewServer()
+	packages.RegisterPackagesServiceServer(grpcSrv, fakeServer)
+	go func() {
+		if err := grpcSrv.Serve(lis); err != nil {
+			t.Errorf("%+v", err)
+		}
+	}()
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	server, ok := newRemotePluginServer(conn, []string{"packages"}).(packages.PackagesServiceServer)
+	if !ok {
+		t.Fatalf("remote plugin server does not implement the packages service")
+	}
+	return server
+}
+
+func TestRemotePackagesServerForwardsRequests(t *testing.T) {
+	fakeServer := &fakeRemotePackagesServer{}
+	server := newRemotePackagesServerForTest(t, fakeServer)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"authorization": "Bearer abc"}))
+	resp, err := server.GetAvailablePackageSummaries(ctx, &packages.GetAvailablePackageSummariesRequest{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if got, want := resp.Categories, []string{"remote"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	if got, want := fakeServer.authorization, []string{"Bearer abc"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestRemotePackagesServerForwardsWatch(t *testing.T) {
+	fakeServer := &fakeRemotePackagesServer{}
+	server := newRemotePackagesServerForTest(t, fakeServer)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"authorization": "Bearer abc"}))
+	stream := &fakeWatchStream{ctx: ctx}
+	err := server.WatchInstalledPackageSummaries(&packages.WatchInstalledPackageSummariesRequest{}, stream)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if got, want := stream.names, []string{"pkg-1", "pkg-2"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	if got, want := fakeServer.authorization, []string{"Bearer abc"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
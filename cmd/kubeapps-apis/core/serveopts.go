@@ -23,6 +23,8 @@ type ServeOptions struct {
 	UnsafeLocalDevKubeconfig bool
 	QPS                      float32
 	Burst                    int
+	PluginTimeoutSeconds     int
+	OperationsNamespace      string
 }
 
 // GatewayHandlerArgs is a helper struct just encapsulating all the args
@@ -27,6 +27,8 @@ type RepositoriesServiceClient interface {
 	GetPackageRepositorySummaries(ctx context.Context, in *GetPackageRepositorySummariesRequest, opts ...grpc.CallOption) (*GetPackageRepositorySummariesResponse, error)
 	UpdatePackageRepository(ctx context.Context, in *UpdatePackageRepositoryRequest, opts ...grpc.CallOption) (*UpdatePackageRepositoryResponse, error)
 	DeletePackageRepository(ctx context.Context, in *DeletePackageRepositoryRequest, opts ...grpc.CallOption) (*DeletePackageRepositoryResponse, error)
+	RefreshPackageRepository(ctx context.Context, in *RefreshPackageRepositoryRequest, opts ...grpc.CallOption) (*RefreshPackageRepositoryResponse, error)
+	ValidatePackageRepository(ctx context.Context, in *ValidatePackageRepositoryRequest, opts ...grpc.CallOption) (*ValidatePackageRepositoryResponse, error)
 }
 
 type repositoriesServiceClient struct {
@@ -82,6 +84,24 @@ func (c *repositoriesServiceClient) DeletePackageRepository(ctx context.Context,
 	return out, nil
 }
 
+func (c *repositoriesServiceClient) RefreshPackageRepository(ctx context.Context, in *RefreshPackageRepositoryRequest, opts ...grpc.CallOption) (*RefreshPackageRepositoryResponse, error) {
+	out := new(RefreshPackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.RepositoriesService/RefreshPackageRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoriesServiceClient) ValidatePackageRepository(ctx context.Context, in *ValidatePackageRepositoryRequest, opts ...grpc.CallOption) (*ValidatePackageRepositoryResponse, error) {
+	out := new(ValidatePackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.RepositoriesService/ValidatePackageRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepositoriesServiceServer is the server API for RepositoriesService service.
 // All implementations should embed UnimplementedRepositoriesServiceServer
 // for forward compatibility
@@ -91,6 +111,8 @@ type RepositoriesServiceServer interface {
 	GetPackageRepositorySummaries(context.Context, *GetPackageRepositorySummariesRequest) (*GetPackageRepositorySummariesResponse, error)
 	UpdatePackageRepository(context.Context, *UpdatePackageRepositoryRequest) (*UpdatePackageRepositoryResponse, error)
 	DeletePackageRepository(context.Context, *DeletePackageRepositoryRequest) (*DeletePackageRepositoryResponse, error)
+	RefreshPackageRepository(context.Context, *RefreshPackageRepositoryRequest) (*RefreshPackageRepositoryResponse, error)
+	ValidatePackageRepository(context.Context, *ValidatePackageRepositoryRequest) (*ValidatePackageRepositoryResponse, error)
 }
 
 // UnimplementedRepositoriesServiceServer should be embedded to have forward compatible implementations.
@@ -112,6 +134,12 @@ func (UnimplementedRepositoriesServiceServer) UpdatePackageRepository(context.Co
 func (UnimplementedRepositoriesServiceServer) DeletePackageRepository(context.Context, *DeletePackageRepositoryRequest) (*DeletePackageRepositoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePackageRepository not implemented")
 }
+func (UnimplementedRepositoriesServiceServer) RefreshPackageRepository(context.Context, *RefreshPackageRepositoryRequest) (*RefreshPackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshPackageRepository not implemented")
+}
+func (UnimplementedRepositoriesServiceServer) ValidatePackageRepository(context.Context, *ValidatePackageRepositoryRequest) (*ValidatePackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePackageRepository not implemented")
+}
 
 // UnsafeRepositoriesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RepositoriesServiceServer will
@@ -214,6 +242,42 @@ func _RepositoriesService_DeletePackageRepository_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoriesService_RefreshPackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshPackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoriesServiceServer).RefreshPackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.RepositoriesService/RefreshPackageRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoriesServiceServer).RefreshPackageRepository(ctx, req.(*RefreshPackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoriesService_ValidatePackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoriesServiceServer).ValidatePackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.RepositoriesService/ValidatePackageRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoriesServiceServer).ValidatePackageRepository(ctx, req.(*ValidatePackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RepositoriesService_ServiceDesc is the grpc.ServiceDesc for RepositoriesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +305,14 @@ var RepositoriesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePackageRepository",
 			Handler:    _RepositoriesService_DeletePackageRepository_Handler,
 		},
+		{
+			MethodName: "RefreshPackageRepository",
+			Handler:    _RepositoriesService_RefreshPackageRepository_Handler,
+		},
+		{
+			MethodName: "ValidatePackageRepository",
+			Handler:    _RepositoriesService_ValidatePackageRepository_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/core/packages/v1alpha1/repositories.proto",
@@ -42,6 +42,21 @@ type FluxV2PackagesServiceClient interface {
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes
 	// resources created by an installed package.
 	GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageRevisions returns the release history of an installed package.
+	GetInstalledPackageRevisions(ctx context.Context, in *v1alpha1.GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageRevisionsResponse, error)
+	// RollbackInstalledPackage rolls back an installed package to a previous revision.
+	RollbackInstalledPackage(ctx context.Context, in *v1alpha1.RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.RollbackInstalledPackageResponse, error)
+	// SuspendInstalledPackage suspends the reconciliation of an installed package,
+	// i.e. a HelmRelease, until it is resumed.
+	SuspendInstalledPackage(ctx context.Context, in *SuspendInstalledPackageRequest, opts ...grpc.CallOption) (*SuspendInstalledPackageResponse, error)
+	// ResumeInstalledPackage resumes the reconciliation of a suspended installed package.
+	ResumeInstalledPackage(ctx context.Context, in *ResumeInstalledPackageRequest, opts ...grpc.CallOption) (*ResumeInstalledPackageResponse, error)
+	// ReconcileInstalledPackage requests the reconciliation of an installed package
+	// now, rather than at the next reconciliation interval.
+	ReconcileInstalledPackage(ctx context.Context, in *ReconcileInstalledPackageRequest, opts ...grpc.CallOption) (*ReconcileInstalledPackageResponse, error)
+	// CreateKustomization creates an installed package for the manifests at a path
+	// of a GitRepository or OCIRepository, i.e. a flux Kustomization.
+	CreateKustomization(ctx context.Context, in *CreateKustomizationRequest, opts ...grpc.CallOption) (*CreateKustomizationResponse, error)
 }
 
 type fluxV2PackagesServiceClient struct {
@@ -133,6 +148,60 @@ func (c *fluxV2PackagesServiceClient) GetInstalledPackageResourceRefs(ctx contex
 	return out, nil
 }
 
+func (c *fluxV2PackagesServiceClient) GetInstalledPackageRevisions(ctx context.Context, in *v1alpha1.GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageRevisionsResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) RollbackInstalledPackage(ctx context.Context, in *v1alpha1.RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.RollbackInstalledPackageResponse, error) {
+	out := new(v1alpha1.RollbackInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/RollbackInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) SuspendInstalledPackage(ctx context.Context, in *SuspendInstalledPackageRequest, opts ...grpc.CallOption) (*SuspendInstalledPackageResponse, error) {
+	out := new(SuspendInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/SuspendInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) ResumeInstalledPackage(ctx context.Context, in *ResumeInstalledPackageRequest, opts ...grpc.CallOption) (*ResumeInstalledPackageResponse, error) {
+	out := new(ResumeInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/ResumeInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) ReconcileInstalledPackage(ctx context.Context, in *ReconcileInstalledPackageRequest, opts ...grpc.CallOption) (*ReconcileInstalledPackageResponse, error) {
+	out := new(ReconcileInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/ReconcileInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) CreateKustomization(ctx context.Context, in *CreateKustomizationRequest, opts ...grpc.CallOption) (*CreateKustomizationResponse, error) {
+	out := new(CreateKustomizationResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/CreateKustomization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FluxV2PackagesServiceServer is the server API for FluxV2PackagesService service.
 // All implementations should embed UnimplementedFluxV2PackagesServiceServer
 // for forward compatibility
@@ -156,6 +225,21 @@ type FluxV2PackagesServiceServer interface {
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes
 	// resources created by an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageRevisions returns the release history of an installed package.
+	GetInstalledPackageRevisions(context.Context, *v1alpha1.GetInstalledPackageRevisionsRequest) (*v1alpha1.GetInstalledPackageRevisionsResponse, error)
+	// RollbackInstalledPackage rolls back an installed package to a previous revision.
+	RollbackInstalledPackage(context.Context, *v1alpha1.RollbackInstalledPackageRequest) (*v1alpha1.RollbackInstalledPackageResponse, error)
+	// SuspendInstalledPackage suspends the reconciliation of an installed package,
+	// i.e. a HelmRelease, until it is resumed.
+	SuspendInstalledPackage(context.Context, *SuspendInstalledPackageRequest) (*SuspendInstalledPackageResponse, error)
+	// ResumeInstalledPackage resumes the reconciliation of a suspended installed package.
+	ResumeInstalledPackage(context.Context, *ResumeInstalledPackageRequest) (*ResumeInstalledPackageResponse, error)
+	// ReconcileInstalledPackage requests the reconciliation of an installed package
+	// now, rather than at the next reconciliation interval.
+	ReconcileInstalledPackage(context.Context, *ReconcileInstalledPackageRequest) (*ReconcileInstalledPackageResponse, error)
+	// CreateKustomization creates an installed package for the manifests at a path
+	// of a GitRepository or OCIRepository, i.e. a flux Kustomization.
+	CreateKustomization(context.Context, *CreateKustomizationRequest) (*CreateKustomizationResponse, error)
 }
 
 // UnimplementedFluxV2PackagesServiceServer should be embedded to have forward compatible implementations.
@@ -189,6 +273,24 @@ func (UnimplementedFluxV2PackagesServiceServer) DeleteInstalledPackage(context.C
 func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
 }
+func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageRevisions(context.Context, *v1alpha1.GetInstalledPackageRevisionsRequest) (*v1alpha1.GetInstalledPackageRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageRevisions not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) RollbackInstalledPackage(context.Context, *v1alpha1.RollbackInstalledPackageRequest) (*v1alpha1.RollbackInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackInstalledPackage not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) SuspendInstalledPackage(context.Context, *SuspendInstalledPackageRequest) (*SuspendInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendInstalledPackage not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) ResumeInstalledPackage(context.Context, *ResumeInstalledPackageRequest) (*ResumeInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeInstalledPackage not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) ReconcileInstalledPackage(context.Context, *ReconcileInstalledPackageRequest) (*ReconcileInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileInstalledPackage not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) CreateKustomization(context.Context, *CreateKustomizationRequest) (*CreateKustomizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKustomization not implemented")
+}
 
 // UnsafeFluxV2PackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to FluxV2PackagesServiceServer will
@@ -363,6 +465,114 @@ func _FluxV2PackagesService_GetInstalledPackageResourceRefs_Handler(srv interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2PackagesService_GetInstalledPackageRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageRevisions(ctx, req.(*v1alpha1.GetInstalledPackageRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_RollbackInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.RollbackInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).RollbackInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/RollbackInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).RollbackInstalledPackage(ctx, req.(*v1alpha1.RollbackInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_SuspendInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).SuspendInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/SuspendInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).SuspendInstalledPackage(ctx, req.(*SuspendInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_ResumeInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).ResumeInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/ResumeInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).ResumeInstalledPackage(ctx, req.(*ResumeInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_ReconcileInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).ReconcileInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/ReconcileInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).ReconcileInstalledPackage(ctx, req.(*ReconcileInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_CreateKustomization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKustomizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).CreateKustomization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/CreateKustomization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).CreateKustomization(ctx, req.(*CreateKustomizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FluxV2PackagesService_ServiceDesc is the grpc.ServiceDesc for FluxV2PackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -406,6 +616,30 @@ var FluxV2PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstalledPackageResourceRefs",
 			Handler:    _FluxV2PackagesService_GetInstalledPackageResourceRefs_Handler,
 		},
+		{
+			MethodName: "GetInstalledPackageRevisions",
+			Handler:    _FluxV2PackagesService_GetInstalledPackageRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackInstalledPackage",
+			Handler:    _FluxV2PackagesService_RollbackInstalledPackage_Handler,
+		},
+		{
+			MethodName: "SuspendInstalledPackage",
+			Handler:    _FluxV2PackagesService_SuspendInstalledPackage_Handler,
+		},
+		{
+			MethodName: "ResumeInstalledPackage",
+			Handler:    _FluxV2PackagesService_ResumeInstalledPackage_Handler,
+		},
+		{
+			MethodName: "ReconcileInstalledPackage",
+			Handler:    _FluxV2PackagesService_ReconcileInstalledPackage_Handler,
+		},
+		{
+			MethodName: "CreateKustomization",
+			Handler:    _FluxV2PackagesService_CreateKustomization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/fluxv2/packages/v1alpha1/fluxv2.proto",
@@ -422,6 +656,14 @@ type FluxV2RepositoriesServiceClient interface {
 	GetPackageRepositorySummaries(ctx context.Context, in *v1alpha1.GetPackageRepositorySummariesRequest, opts ...grpc.CallOption) (*v1alpha1.GetPackageRepositorySummariesResponse, error)
 	UpdatePackageRepository(ctx context.Context, in *v1alpha1.UpdatePackageRepositoryRequest, opts ...grpc.CallOption) (*v1alpha1.UpdatePackageRepositoryResponse, error)
 	DeletePackageRepository(ctx context.Context, in *v1alpha1.DeletePackageRepositoryRequest, opts ...grpc.CallOption) (*v1alpha1.DeletePackageRepositoryResponse, error)
+	// SuspendPackageRepository suspends the reconciliation of a package repository,
+	// i.e. a HelmRepository, until it is resumed.
+	SuspendPackageRepository(ctx context.Context, in *SuspendPackageRepositoryRequest, opts ...grpc.CallOption) (*SuspendPackageRepositoryResponse, error)
+	// ResumePackageRepository resumes the reconciliation of a suspended package repository.
+	ResumePackageRepository(ctx context.Context, in *ResumePackageRepositoryRequest, opts ...grpc.CallOption) (*ResumePackageRepositoryResponse, error)
+	// ReconcilePackageRepository requests the reconciliation of a package repository
+	// now, rather than at the next reconciliation interval.
+	ReconcilePackageRepository(ctx context.Context, in *ReconcilePackageRepositoryRequest, opts ...grpc.CallOption) (*ReconcilePackageRepositoryResponse, error)
 	// this endpoint only exists for the purpose of integration tests
 	SetUserManagedSecrets(ctx context.Context, in *SetUserManagedSecretsRequest, opts ...grpc.CallOption) (*SetUserManagedSecretsResponse, error)
 }
@@ -479,6 +721,33 @@ func (c *fluxV2RepositoriesServiceClient) DeletePackageRepository(ctx context.Co
 	return out, nil
 }
 
+func (c *fluxV2RepositoriesServiceClient) SuspendPackageRepository(ctx context.Context, in *SuspendPackageRepositoryRequest, opts ...grpc.CallOption) (*SuspendPackageRepositoryResponse, error) {
+	out := new(SuspendPackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/SuspendPackageRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2RepositoriesServiceClient) ResumePackageRepository(ctx context.Context, in *ResumePackageRepositoryRequest, opts ...grpc.CallOption) (*ResumePackageRepositoryResponse, error) {
+	out := new(ResumePackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/ResumePackageRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2RepositoriesServiceClient) ReconcilePackageRepository(ctx context.Context, in *ReconcilePackageRepositoryRequest, opts ...grpc.CallOption) (*ReconcilePackageRepositoryResponse, error) {
+	out := new(ReconcilePackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/ReconcilePackageRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fluxV2RepositoriesServiceClient) SetUserManagedSecrets(ctx context.Context, in *SetUserManagedSecretsRequest, opts ...grpc.CallOption) (*SetUserManagedSecretsResponse, error) {
 	out := new(SetUserManagedSecretsResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/SetUserManagedSecrets", in, out, opts...)
@@ -499,6 +768,14 @@ type FluxV2RepositoriesServiceServer interface {
 	GetPackageRepositorySummaries(context.Context, *v1alpha1.GetPackageRepositorySummariesRequest) (*v1alpha1.GetPackageRepositorySummariesResponse, error)
 	UpdatePackageRepository(context.Context, *v1alpha1.UpdatePackageRepositoryRequest) (*v1alpha1.UpdatePackageRepositoryResponse, error)
 	DeletePackageRepository(context.Context, *v1alpha1.DeletePackageRepositoryRequest) (*v1alpha1.DeletePackageRepositoryResponse, error)
+	// SuspendPackageRepository suspends the reconciliation of a package repository,
+	// i.e. a HelmRepository, until it is resumed.
+	SuspendPackageRepository(context.Context, *SuspendPackageRepositoryRequest) (*SuspendPackageRepositoryResponse, error)
+	// ResumePackageRepository resumes the reconciliation of a suspended package repository.
+	ResumePackageRepository(context.Context, *ResumePackageRepositoryRequest) (*ResumePackageRepositoryResponse, error)
+	// ReconcilePackageRepository requests the reconciliation of a package repository
+	// now, rather than at the next reconciliation interval.
+	ReconcilePackageRepository(context.Context, *ReconcilePackageRepositoryRequest) (*ReconcilePackageRepositoryResponse, error)
 	// this endpoint only exists for the purpose of integration tests
 	SetUserManagedSecrets(context.Context, *SetUserManagedSecretsRequest) (*SetUserManagedSecretsResponse, error)
 }
@@ -522,6 +799,15 @@ func (UnimplementedFluxV2RepositoriesServiceServer) UpdatePackageRepository(cont
 func (UnimplementedFluxV2RepositoriesServiceServer) DeletePackageRepository(context.Context, *v1alpha1.DeletePackageRepositoryRequest) (*v1alpha1.DeletePackageRepositoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePackageRepository not implemented")
 }
+func (UnimplementedFluxV2RepositoriesServiceServer) SuspendPackageRepository(context.Context, *SuspendPackageRepositoryRequest) (*SuspendPackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendPackageRepository not implemented")
+}
+func (UnimplementedFluxV2RepositoriesServiceServer) ResumePackageRepository(context.Context, *ResumePackageRepositoryRequest) (*ResumePackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePackageRepository not implemented")
+}
+func (UnimplementedFluxV2RepositoriesServiceServer) ReconcilePackageRepository(context.Context, *ReconcilePackageRepositoryRequest) (*ReconcilePackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcilePackageRepository not implemented")
+}
 func (UnimplementedFluxV2RepositoriesServiceServer) SetUserManagedSecrets(context.Context, *SetUserManagedSecretsRequest) (*SetUserManagedSecretsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUserManagedSecrets not implemented")
 }
@@ -627,6 +913,60 @@ func _FluxV2RepositoriesService_DeletePackageRepository_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2RepositoriesService_SuspendPackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendPackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2RepositoriesServiceServer).SuspendPackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/SuspendPackageRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2RepositoriesServiceServer).SuspendPackageRepository(ctx, req.(*SuspendPackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2RepositoriesService_ResumePackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumePackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2RepositoriesServiceServer).ResumePackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/ResumePackageRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2RepositoriesServiceServer).ResumePackageRepository(ctx, req.(*ResumePackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2RepositoriesService_ReconcilePackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcilePackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2RepositoriesServiceServer).ReconcilePackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2RepositoriesService/ReconcilePackageRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2RepositoriesServiceServer).ReconcilePackageRepository(ctx, req.(*ReconcilePackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FluxV2RepositoriesService_SetUserManagedSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetUserManagedSecretsRequest)
 	if err := dec(in); err != nil {
@@ -672,6 +1012,18 @@ var FluxV2RepositoriesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePackageRepository",
 			Handler:    _FluxV2RepositoriesService_DeletePackageRepository_Handler,
 		},
+		{
+			MethodName: "SuspendPackageRepository",
+			Handler:    _FluxV2RepositoriesService_SuspendPackageRepository_Handler,
+		},
+		{
+			MethodName: "ResumePackageRepository",
+			Handler:    _FluxV2RepositoriesService_ResumePackageRepository_Handler,
+		},
+		{
+			MethodName: "ReconcilePackageRepository",
+			Handler:    _FluxV2RepositoriesService_ReconcilePackageRepository_Handler,
+		},
 		{
 			MethodName: "SetUserManagedSecrets",
 			Handler:    _FluxV2RepositoriesService_SetUserManagedSecrets_Handler,
@@ -42,6 +42,9 @@ type KappControllerPackagesServiceClient interface {
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
 	// an installed package.
 	GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// ConvertHelmPackage generates the Carvel PackageMetadata and Package for a Helm chart,
+	// optionally creating them in the requested target context.
+	ConvertHelmPackage(ctx context.Context, in *ConvertHelmPackageRequest, opts ...grpc.CallOption) (*ConvertHelmPackageResponse, error)
 }
 
 type kappControllerPackagesServiceClient struct {
@@ -133,6 +136,15 @@ func (c *kappControllerPackagesServiceClient) GetInstalledPackageResourceRefs(ct
 	return out, nil
 }
 
+func (c *kappControllerPackagesServiceClient) ConvertHelmPackage(ctx context.Context, in *ConvertHelmPackageRequest, opts ...grpc.CallOption) (*ConvertHelmPackageResponse, error) {
+	out := new(ConvertHelmPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/ConvertHelmPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KappControllerPackagesServiceServer is the server API for KappControllerPackagesService service.
 // All implementations should embed UnimplementedKappControllerPackagesServiceServer
 // for forward compatibility
@@ -156,6 +168,9 @@ type KappControllerPackagesServiceServer interface {
 	// GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
 	// an installed package.
 	GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// ConvertHelmPackage generates the Carvel PackageMetadata and Package for a Helm chart,
+	// optionally creating them in the requested target context.
+	ConvertHelmPackage(context.Context, *ConvertHelmPackageRequest) (*ConvertHelmPackageResponse, error)
 }
 
 // UnimplementedKappControllerPackagesServiceServer should be embedded to have forward compatible implementations.
@@ -189,6 +204,9 @@ func (UnimplementedKappControllerPackagesServiceServer) DeleteInstalledPackage(c
 func (UnimplementedKappControllerPackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
 }
+func (UnimplementedKappControllerPackagesServiceServer) ConvertHelmPackage(context.Context, *ConvertHelmPackageRequest) (*ConvertHelmPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertHelmPackage not implemented")
+}
 
 // UnsafeKappControllerPackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to KappControllerPackagesServiceServer will
@@ -363,6 +381,24 @@ func _KappControllerPackagesService_GetInstalledPackageResourceRefs_Handler(srv
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KappControllerPackagesService_ConvertHelmPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertHelmPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KappControllerPackagesServiceServer).ConvertHelmPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.kapp_controller.packages.v1alpha1.KappControllerPackagesService/ConvertHelmPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KappControllerPackagesServiceServer).ConvertHelmPackage(ctx, req.(*ConvertHelmPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KappControllerPackagesService_ServiceDesc is the grpc.ServiceDesc for KappControllerPackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -406,6 +442,10 @@ var KappControllerPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstalledPackageResourceRefs",
 			Handler:    _KappControllerPackagesService_GetInstalledPackageResourceRefs_Handler,
 		},
+		{
+			MethodName: "ConvertHelmPackage",
+			Handler:    _KappControllerPackagesService_ConvertHelmPackage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/kapp_controller/packages/v1alpha1/kapp_controller.proto",
@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ResourcesServiceClient interface {
 	GetResources(ctx context.Context, in *GetResourcesRequest, opts ...grpc.CallOption) (ResourcesService_GetResourcesClient, error)
+	GetInstalledPackageLogs(ctx context.Context, in *GetInstalledPackageLogsRequest, opts ...grpc.CallOption) (ResourcesService_GetInstalledPackageLogsClient, error)
 	GetServiceAccountNames(ctx context.Context, in *GetServiceAccountNamesRequest, opts ...grpc.CallOption) (*GetServiceAccountNamesResponse, error)
 	GetNamespaceNames(ctx context.Context, in *GetNamespaceNamesRequest, opts ...grpc.CallOption) (*GetNamespaceNamesResponse, error)
 	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error)
@@ -71,6 +72,38 @@ func (x *resourcesServiceGetResourcesClient) Recv() (*GetResourcesResponse, erro
 	return m, nil
 }
 
+func (c *resourcesServiceClient) GetInstalledPackageLogs(ctx context.Context, in *GetInstalledPackageLogsRequest, opts ...grpc.CallOption) (ResourcesService_GetInstalledPackageLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResourcesService_ServiceDesc.Streams[1], "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetInstalledPackageLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourcesServiceGetInstalledPackageLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourcesService_GetInstalledPackageLogsClient interface {
+	Recv() (*GetInstalledPackageLogsResponse, error)
+	grpc.ClientStream
+}
+
+type resourcesServiceGetInstalledPackageLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourcesServiceGetInstalledPackageLogsClient) Recv() (*GetInstalledPackageLogsResponse, error) {
+	m := new(GetInstalledPackageLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *resourcesServiceClient) GetServiceAccountNames(ctx context.Context, in *GetServiceAccountNamesRequest, opts ...grpc.CallOption) (*GetServiceAccountNamesResponse, error) {
 	out := new(GetServiceAccountNamesResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.resources.v1alpha1.ResourcesService/GetServiceAccountNames", in, out, opts...)
@@ -130,6 +163,7 @@ func (c *resourcesServiceClient) CreateSecret(ctx context.Context, in *CreateSec
 // for forward compatibility
 type ResourcesServiceServer interface {
 	GetResources(*GetResourcesRequest, ResourcesService_GetResourcesServer) error
+	GetInstalledPackageLogs(*GetInstalledPackageLogsRequest, ResourcesService_GetInstalledPackageLogsServer) error
 	GetServiceAccountNames(context.Context, *GetServiceAccountNamesRequest) (*GetServiceAccountNamesResponse, error)
 	GetNamespaceNames(context.Context, *GetNamespaceNamesRequest) (*GetNamespaceNamesResponse, error)
 	CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
@@ -145,6 +179,9 @@ type UnimplementedResourcesServiceServer struct {
 func (UnimplementedResourcesServiceServer) GetResources(*GetResourcesRequest, ResourcesService_GetResourcesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetResources not implemented")
 }
+func (UnimplementedResourcesServiceServer) GetInstalledPackageLogs(*GetInstalledPackageLogsRequest, ResourcesService_GetInstalledPackageLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetInstalledPackageLogs not implemented")
+}
 func (UnimplementedResourcesServiceServer) GetServiceAccountNames(context.Context, *GetServiceAccountNamesRequest) (*GetServiceAccountNamesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccountNames not implemented")
 }
@@ -196,6 +233,27 @@ func (x *resourcesServiceGetResourcesServer) Send(m *GetResourcesResponse) error
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ResourcesService_GetInstalledPackageLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetInstalledPackageLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourcesServiceServer).GetInstalledPackageLogs(m, &resourcesServiceGetInstalledPackageLogsServer{stream})
+}
+
+type ResourcesService_GetInstalledPackageLogsServer interface {
+	Send(*GetInstalledPackageLogsResponse) error
+	grpc.ServerStream
+}
+
+type resourcesServiceGetInstalledPackageLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourcesServiceGetInstalledPackageLogsServer) Send(m *GetInstalledPackageLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ResourcesService_GetServiceAccountNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetServiceAccountNamesRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +400,11 @@ var ResourcesService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ResourcesService_GetResources_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetInstalledPackageLogs",
+			Handler:       _ResourcesService_GetInstalledPackageLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "kubeappsapis/plugins/resources/v1alpha1/resources.proto",
 }
@@ -6,10 +6,12 @@ package plugin_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgfilter"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -23,9 +25,16 @@ type TestPackagingPluginServer struct {
 	InstalledPackageDetail    *corev1.InstalledPackageDetail
 	PackageAppVersions        []*corev1.PackageAppVersion
 	ResourceRefs              []*corev1.ResourceRef
+	Revisions                 []*corev1.InstalledPackageRevision
 	Categories                []string
 	NextPageToken             string
 	Status                    codes.Code
+	// Delay is an optional delay before responding to requests for summaries,
+	// for testing timeouts.
+	Delay time.Duration
+	// IgnoreFilterOptions returns unfiltered installed package summaries, for
+	// testing plugins which do not support filtering.
+	IgnoreFilterOptions bool
 }
 
 func NewTestPackagingPlugin(plugin *plugins.Plugin) *TestPackagingPluginServer {
@@ -36,6 +45,7 @@ func NewTestPackagingPlugin(plugin *plugins.Plugin) *TestPackagingPluginServer {
 
 // GetAvailablePackages returns the packages based on the request.
 func (s TestPackagingPluginServer) GetAvailablePackageSummaries(ctx context.Context, request *corev1.GetAvailablePackageSummariesRequest) (*corev1.GetAvailablePackageSummariesResponse, error) {
+	time.Sleep(s.Delay)
 	if s.Status != codes.OK {
 		return nil, status.Errorf(s.Status, "Non-OK response")
 	}
@@ -69,11 +79,21 @@ func (s TestPackagingPluginServer) GetAvailablePackageDetail(ctx context.Context
 
 // GetInstalledPackageSummaries returns the installed package summaries based on the request.
 func (s TestPackagingPluginServer) GetInstalledPackageSummaries(ctx context.Context, request *corev1.GetInstalledPackageSummariesRequest) (*corev1.GetInstalledPackageSummariesResponse, error) {
+	time.Sleep(s.Delay)
 	if s.Status != codes.OK {
 		return nil, status.Errorf(s.Status, "Non-OK response")
 	}
+	summaries := s.InstalledPackageSummaries
+	if !s.IgnoreFilterOptions && !pkgfilter.IsEmpty(request.GetFilterOptions()) {
+		summaries = []*corev1.InstalledPackageSummary{}
+		for _, summary := range s.InstalledPackageSummaries {
+			if pkgfilter.MatchesInstalledPackageSummary(request.GetFilterOptions(), summary) {
+				summaries = append(summaries, summary)
+			}
+		}
+	}
 	return &corev1.GetInstalledPackageSummariesResponse{
-		InstalledPackageSummaries: s.InstalledPackageSummaries,
+		InstalledPackageSummaries: summaries,
 		NextPageToken:             s.NextPageToken,
 	}, nil
 }
@@ -142,6 +162,47 @@ func (s TestPackagingPluginServer) DeleteInstalledPackage(ctx context.Context, r
 	return &corev1.DeleteInstalledPackageResponse{}, nil
 }
 
+// GetInstalledPackageRevisions returns the revisions based on the request.
+func (s TestPackagingPluginServer) GetInstalledPackageRevisions(ctx context.Context, request *corev1.GetInstalledPackageRevisionsRequest) (*corev1.GetInstalledPackageRevisionsResponse, error) {
+	if s.Status != codes.OK {
+		return nil, status.Errorf(s.Status, "Non-OK response")
+	}
+	return &corev1.GetInstalledPackageRevisionsResponse{
+		Revisions: s.Revisions,
+	}, nil
+}
+
+func (s TestPackagingPluginServer) RollbackInstalledPackage(ctx context.Context, request *corev1.RollbackInstalledPackageRequest) (*corev1.RollbackInstalledPackageResponse, error) {
+	if s.Status != codes.OK {
+		return nil, status.Errorf(s.Status, "Non-OK response")
+	}
+	return &corev1.RollbackInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    request.GetInstalledPackageRef().GetContext(),
+			Identifier: request.GetInstalledPackageRef().GetIdentifier(),
+			Plugin:     s.Plugin,
+		},
+	}, nil
+}
+
+// WatchInstalledPackageSummaries sends an added event for each installed package summary
+// and then ends the stream.
+func (s TestPackagingPluginServer) WatchInstalledPackageSummaries(request *corev1.WatchInstalledPackageSummariesRequest, stream corev1.PackagesService_WatchInstalledPackageSummariesServer) error {
+	if s.Status != codes.OK {
+		return status.Errorf(s.Status, "Non-OK response")
+	}
+	for _, summary := range s.InstalledPackageSummaries {
+		err := stream.Send(&corev1.WatchInstalledPackageSummariesResponse{
+			EventType:               corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED,
+			InstalledPackageSummary: summary,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type TestRepositoriesPluginServer struct {
 	corev1.UnimplementedRepositoriesServiceServer
 	Plugin                     *plugins.Plugin
@@ -207,3 +268,25 @@ func (s TestRepositoriesPluginServer) DeletePackageRepository(ctx context.Contex
 	}
 	return &corev1.DeletePackageRepositoryResponse{}, nil
 }
+
+func (s TestRepositoriesPluginServer) RefreshPackageRepository(ctx context.Context, request *corev1.RefreshPackageRepositoryRequest) (*corev1.RefreshPackageRepositoryResponse, error) {
+	if s.Status != codes.OK {
+		return nil, status.Errorf(s.Status, "Non-OK response")
+	}
+	return &corev1.RefreshPackageRepositoryResponse{
+		PackageRepoRef: &corev1.PackageRepositoryReference{
+			Context:    request.GetPackageRepoRef().GetContext(),
+			Identifier: request.GetPackageRepoRef().GetIdentifier(),
+			Plugin:     s.Plugin,
+		},
+	}, nil
+}
+
+func (s TestRepositoriesPluginServer) ValidatePackageRepository(ctx context.Context, request *corev1.ValidatePackageRepositoryRequest) (*corev1.ValidatePackageRepositoryResponse, error) {
+	if s.Status != codes.OK {
+		return nil, status.Errorf(s.Status, "Non-OK response")
+	}
+	return &corev1.ValidatePackageRepositoryResponse{
+		Valid: true,
+	}, nil
+}
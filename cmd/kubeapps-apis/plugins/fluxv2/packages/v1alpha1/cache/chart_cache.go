@@ -14,8 +14,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/go-redis/redis/v8"
-	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	"google.golang.org/grpc/codes"
@@ -38,11 +36,26 @@ const (
 var (
 	// pretty much a constant, init pattern similar to that of asset-syncer
 	verboseChartCacheQueue = os.Getenv("DEBUG_CHART_CACHE_QUEUE") == "true"
+
+	// the prefixes of the keys of the charts of GitRepositories and Buckets, keyed by
+	// the type of their repo, so they don't collide with the charts of a HelmRepository
+	// with the same name. The charts of HelmRepositories, whether of type "helm" or "oci",
+	// are prefixed with helmChartsKeyPrefix
+	sourceChartsKeyPrefixes = map[string]string{
+		"git":    "gitcharts",
+		"bucket": "bucketcharts",
+	}
 )
 
+const helmChartsKeyPrefix = "helmcharts"
+
 type ChartCache struct {
-	// the redis client
-	redisCli *redis.Client
+	// where the cache entries are kept, e.g. redis
+	storage Storage
+
+	// the cluster of the repositories the charts come from. Caches for different
+	// clusters may share the same storage, so the cluster is part of every key
+	cluster string
 
 	// queue is a rate limited work queue. This is used to queue work to be
 	// processed instead of performing it as soon as a change happens. This
@@ -59,7 +72,7 @@ type ChartCache struct {
 	processing k8scache.Store
 
 	// I am using a Read/Write Mutex to gate access to cache's resync() operation, which is
-	// significant in that it flushes the whole redis cache and re-populates the state from k8s.
+	// significant in that it flushes the whole cache and re-populates the state from k8s.
 	// When that happens we don't really want any concurrent access to the cache until the resync()
 	// operation is complete. In other words, we want to:
 	//  - be able to have multiple concurrent readers (goroutines doing GetForOne())
@@ -79,6 +92,8 @@ type DownloadChartFn func(chartID, chartUrl, chartVersion string) ([]byte, error
 //  - deleted flag to true
 // setting both for a given entry does not make sense
 type chartCacheStoreEntry struct {
+	keyPrefix  string
+	cluster    string
 	namespace  string
 	id         string
 	version    string
@@ -87,15 +102,16 @@ type chartCacheStoreEntry struct {
 	deleted    bool
 }
 
-func NewChartCache(name string, redisCli *redis.Client, stopCh <-chan struct{}) (*ChartCache, error) {
-	log.Infof("+NewChartCache(%s, %v)", name, redisCli)
+func NewChartCache(name, cluster string, storage Storage, stopCh <-chan struct{}) (*ChartCache, error) {
+	log.Infof("+NewChartCache(%s, %s, %v)", name, cluster, storage)
 
-	if redisCli == nil {
-		return nil, fmt.Errorf("server not configured with redis client")
+	if storage == nil {
+		return nil, fmt.Errorf("server not configured with cache storage")
 	}
 
 	c := ChartCache{
-		redisCli:   redisCli,
+		storage:    storage,
+		cluster:    cluster,
 		queue:      NewRateLimitingQueue(name, verboseChartCacheQueue),
 		processing: k8scache.NewStore(chartCacheKeyFunc),
 		resyncCond: sync.NewCond(&sync.RWMutex{}),
@@ -163,6 +179,8 @@ func (c *ChartCache) SyncCharts(charts []models.Chart, downloadFn DownloadChartF
 		}
 
 		entry := chartCacheStoreEntry{
+			keyPrefix:  chartKeyPrefix(chart.Repo.Type),
+			cluster:    c.cluster,
 			namespace:  chart.Repo.Namespace,
 			id:         chart.ID,
 			version:    chart.ChartVersions[0].Version,
@@ -259,9 +277,13 @@ func (c *ChartCache) processNextWorkItem(workerName string) bool {
 	return true
 }
 
-func (c *ChartCache) DeleteChartsForRepo(repo *types.NamespacedName) error {
-	log.Infof("+DeleteChartsForRepo(%s)", repo)
-	defer log.Infof("-DeleteChartsForRepo(%s)", repo)
+// DeleteChartsForRepo removes the charts of the repo with the given type and name from
+// the cache, i.e. the charts of a HelmRepository, a GitRepository or a Bucket
+func (c *ChartCache) DeleteChartsForRepo(repoType string, repo *types.NamespacedName) error {
+	log.Infof("+DeleteChartsForRepo(%s, %s)", repoType, repo)
+	defer log.Infof("-DeleteChartsForRepo(%s, %s)", repoType, repo)
+
+	keyPrefix := chartKeyPrefix(repoType)
 
 	// need to get a list of all charts/versions for this repo that are either:
 	//   a. already in the cache OR
@@ -270,49 +292,42 @@ func (c *ChartCache) DeleteChartsForRepo(repo *types.NamespacedName) error {
 	// this loop should take care of (a)
 	// glob-style pattern, you can use https://www.digitalocean.com/community/tools/glob to test
 	// also ref. https://stackoverflow.com/questions/4006324/how-to-atomically-delete-keys-matching-a-pattern-using-redis
-	match := fmt.Sprintf("helmcharts%s%s%s%s/*%s*",
+	match := fmt.Sprintf("%s%s%s%s%s%s%s/*%s*",
+		keyPrefix,
+		KeySegmentsSeparator,
+		c.cluster,
 		KeySegmentsSeparator,
 		repo.Namespace,
 		KeySegmentsSeparator,
 		repo.Name,
 		KeySegmentsSeparator)
 	redisKeysToDelete := sets.String{}
-	// https://redis.io/commands/scan An iteration starts when the cursor is set to 0,
-	// and terminates when the cursor returned by the server is 0
-	cursor := uint64(0)
-	for {
-		var keys []string
-		var err error
-		keys, cursor, err = c.redisCli.Scan(c.redisCli.Context(), cursor, match, 0).Result()
-		if err != nil {
-			return err
-		}
-		for _, k := range keys {
-			redisKeysToDelete.Insert(k)
-		}
-		if cursor == 0 {
-			break
-		}
+	if keys, err := c.storage.Keys(match); err != nil {
+		return err
+	} else {
+		redisKeysToDelete.Insert(keys...)
 	}
 
 	// we still need to take care of (b)
 	for _, k := range c.processing.ListKeys() {
-		if namespace, chartID, _, err := c.fromKey(k); err != nil {
+		if prefix, namespace, chartID, _, err := c.fromKey(k); err != nil {
 			log.Errorf("%+v", err)
 		} else {
 			if parts := strings.Split(chartID, "/"); len(parts) != 2 {
 				log.Errorf("unexpected chartID format: [%s]", chartID)
-			} else if repo.Namespace == namespace && repo.Name == parts[0] {
+			} else if prefix == keyPrefix && repo.Namespace == namespace && repo.Name == parts[0] {
 				redisKeysToDelete.Insert(k)
 			}
 		}
 	}
 
 	for k := range redisKeysToDelete {
-		if namespace, chartID, chartVersion, err := c.fromKey(k); err != nil {
+		if prefix, namespace, chartID, chartVersion, err := c.fromKey(k); err != nil {
 			log.Errorf("%+v", err)
 		} else {
 			entry := chartCacheStoreEntry{
+				keyPrefix: prefix,
+				cluster:   c.cluster,
 				namespace: namespace,
 				id:        chartID,
 				version:   chartVersion,
@@ -329,6 +344,38 @@ func (c *ChartCache) DeleteChartsForRepo(repo *types.NamespacedName) error {
 	return nil
 }
 
+// DeleteCharts removes the given charts from the cache. Only the latest version of
+// each chart is ever cached, so that is the one that gets deleted
+func (c *ChartCache) DeleteCharts(charts []models.Chart) error {
+	log.Infof("+DeleteCharts(%d)", len(charts))
+	defer log.Infof("-DeleteCharts(%d)", len(charts))
+
+	for _, chart := range charts {
+		if len(chart.ChartVersions) == 0 || chart.Repo == nil {
+			continue
+		}
+		entry := chartCacheStoreEntry{
+			keyPrefix: chartKeyPrefix(chart.Repo.Type),
+			cluster:   c.cluster,
+			namespace: chart.Repo.Namespace,
+			id:        chart.ID,
+			version:   chart.ChartVersions[0].Version,
+			deleted:   true,
+		}
+		if key, err := chartCacheKeyFunc(entry); err != nil {
+			log.Errorf("Failed to get key for chart due to %+v", err)
+		} else {
+			err = c.processing.Add(entry)
+			if err != nil {
+				log.Errorf("Failed to delete chart due to %+v", err)
+			}
+			log.V(4).Infof("Marked key [%s] to be deleted", key)
+			c.queue.Add(key)
+		}
+	}
+	return nil
+}
+
 func (c *ChartCache) OnResync() error {
 	log.Infof("+OnResync(), queue: [%s], size: [%d]", c.queue.Name(), c.queue.Len())
 	c.resyncCond.L.Lock()
@@ -354,7 +401,39 @@ func (c *ChartCache) OnResync() error {
 	log.Infof("Resetting work queue [%s] and store...", c.queue.Name())
 	c.queue.Reset()
 	c.processing = k8scache.NewStore(chartCacheKeyFunc)
-	return nil
+
+	// the storage may be shared with the caches of other clusters, so only the
+	// keys for this cluster are deleted. The charts of GitRepositories and Buckets
+	// are left to the resync of their own caches
+	return c.deleteChartsForCluster(helmChartsKeyPrefix)
+}
+
+// OnSourceResync is the counterpart of OnResync for the caches of the sources of
+// the charts of repos with the given type, i.e. GitRepositories or Buckets. Only the
+// keys of their charts are deleted, while the work queue, which is shared with the
+// charts of the other repos, is kept as is
+func (c *ChartCache) OnSourceResync(repoType string) error {
+	log.Infof("+OnSourceResync(%s)", repoType)
+	c.resyncCond.L.Lock()
+	defer func() {
+		c.resyncCond.L.Unlock()
+		log.Infof("-OnSourceResync(%s)", repoType)
+	}()
+
+	prefix := chartKeyPrefix(repoType)
+	if prefix == helmChartsKeyPrefix {
+		return fmt.Errorf("unexpected repo type for the charts of a source: [%s]", repoType)
+	}
+	return c.deleteChartsForCluster(prefix)
+}
+
+// deleteChartsForCluster deletes the keys with the given prefix for the cluster of the cache
+func (c *ChartCache) deleteChartsForCluster(keyPrefix string) error {
+	return c.storage.DeleteMatching(fmt.Sprintf("%s%s%s%s*",
+		keyPrefix,
+		KeySegmentsSeparator,
+		c.cluster,
+		KeySegmentsSeparator))
 }
 
 // this is what we store in the cache for each cached repo
@@ -390,17 +469,17 @@ func (c *ChartCache) syncHandler(workerName, key string) error {
 		// it *might* be to add a .GetAll() method to RateLimitingInterface,
 		// which will be a little tricky to make sure to get the logic right to be atomic and
 		// also when *SOME* of the items fail and some succeed
-		keysRemoved, _ := c.redisCli.Del(c.redisCli.Context(), key).Result()
-		log.Infof("Redis [DEL %s]: %d", key, keysRemoved)
+		keysRemoved, _ := c.storage.Delete(key)
+		log.Infof("Cache [DEL %s]: %d", key, keysRemoved)
 	} else {
 		// unlike helm repositories, specific version chart tarball contents never changes
 		// so before embarking on expensive operation such as getting chart tarball
 		// via HTTP/S, first see if the cache already's got this entry
-		if keysExist, err := c.redisCli.Exists(c.redisCli.Context(), key).Result(); err != nil {
-			return fmt.Errorf("error checking whether key [%s] exists in redis: %+v", key, err)
+		if keyExists, err := c.storage.Exists(key); err != nil {
+			return fmt.Errorf("error checking whether key [%s] exists in cache: %+v", key, err)
 		} else {
-			log.Infof("Redis [EXISTS %s]: %d", key, keysExist)
-			if keysExist == 1 {
+			log.Infof("Cache [EXISTS %s]: %t", key, keyExists)
+			if keyExists {
 				// nothing to do
 				return nil
 			}
@@ -410,14 +489,14 @@ func (c *ChartCache) syncHandler(workerName, key string) error {
 			return err
 		}
 		startTime := time.Now()
-		result, err := c.redisCli.Set(c.redisCli.Context(), key, byteArray, 0).Result()
+		err = c.storage.Set(key, byteArray)
 		if err != nil {
 			return fmt.Errorf("failed to set value for object with key [%s] in cache due to: %v", key, err)
 		} else {
 			duration := time.Since(startTime)
-			usedMemory, totalMemory := common.RedisMemoryStats(c.redisCli)
-			log.Infof("Redis [SET %s]: %s in [%d] ms. Redis [INFO memory]: [%s/%s]",
-				key, result, duration.Milliseconds(), usedMemory, totalMemory)
+			usedMemory, totalMemory := c.storage.MemoryStats()
+			log.Infof("Cache [SET %s]: OK in [%d] ms. Cache memory: [%s/%s]",
+				key, duration.Milliseconds(), usedMemory, totalMemory)
 		}
 	}
 	return err
@@ -432,17 +511,17 @@ func (c *ChartCache) FetchForOne(key string) ([]byte, error) {
 
 	// read back from cache: should be either:
 	//  - what we previously wrote OR
-	//  - redis.Nil if the key does  not exist or has been evicted due to memory pressure/TTL expiry
+	//  - nil if the key does  not exist or has been evicted due to memory pressure/TTL expiry
 	//
-	byteArray, err := c.redisCli.Get(c.redisCli.Context(), key).Bytes()
+	byteArray, err := c.storage.Get(key)
 	// debugging an intermittent issue
-	if err == redis.Nil {
-		log.Infof("Redis [GET %s]: Nil", key)
-		return nil, nil
-	} else if err != nil {
+	if err != nil {
 		return nil, fmt.Errorf("fetchForOne() failed to get value for key [%s] from cache due to: %v", key, err)
+	} else if byteArray == nil {
+		log.Infof("Cache [GET %s]: Nil", key)
+		return nil, nil
 	}
-	log.Infof("Redis [GET %s]: %d bytes read", key, len(byteArray))
+	log.Infof("Cache [GET %s]: %d bytes read", key, len(byteArray))
 
 	dec := gob.NewDecoder(bytes.NewReader(byteArray))
 	var entryValue chartCacheEntryValue
@@ -476,11 +555,11 @@ func (c *ChartCache) GetForOne(key string, chart *models.Chart, downloadFn Downl
 		return nil, err
 	} else if value == nil {
 		// cache miss
-		namespace, chartID, version, err := c.fromKey(key)
+		prefix, namespace, chartID, version, err := c.fromKey(key)
 		if err != nil {
 			return nil, err
 		}
-		if namespace != chart.Repo.Namespace || chartID != chart.ID {
+		if prefix != chartKeyPrefix(chart.Repo.Type) || namespace != chart.Repo.Namespace || chartID != chart.ID {
 			return nil, fmt.Errorf("unexpected state for chart with key [%s]", key)
 		}
 		var entry *chartCacheStoreEntry
@@ -490,6 +569,8 @@ func (c *ChartCache) GetForOne(key string, chart *models.Chart, downloadFn Downl
 					log.Warningf("chart: [%s], version: [%s] has no URLs", chart.ID, v.Version)
 				} else {
 					entry = &chartCacheStoreEntry{
+						keyPrefix:  prefix,
+						cluster:    c.cluster,
 						namespace:  namespace,
 						id:         chartID,
 						version:    v.Version,
@@ -514,8 +595,15 @@ func (c *ChartCache) GetForOne(key string, chart *models.Chart, downloadFn Downl
 	return value, nil
 }
 
+// KeyFor returns the key of a version of a chart of a HelmRepository
 func (c *ChartCache) KeyFor(namespace, chartID, chartVersion string) (string, error) {
-	return chartCacheKeyFor(namespace, chartID, chartVersion)
+	return chartCacheKeyFor(helmChartsKeyPrefix, c.cluster, namespace, chartID, chartVersion)
+}
+
+// KeyForRepoType returns the key of a version of a chart of a repo with the given
+// type, e.g. the repo of the charts of a GitRepository
+func (c *ChartCache) KeyForRepoType(repoType, namespace, chartID, chartVersion string) (string, error) {
+	return chartCacheKeyFor(chartKeyPrefix(repoType), c.cluster, namespace, chartID, chartVersion)
 }
 
 func (c *ChartCache) String() string {
@@ -524,12 +612,12 @@ func (c *ChartCache) String() string {
 
 // the opposite of keyFor
 // the goal is to keep the details of what exactly the key looks like localized to one piece of code
-func (c *ChartCache) fromKey(key string) (namespace, chartID, chartVersion string, err error) {
+func (c *ChartCache) fromKey(key string) (keyPrefix, namespace, chartID, chartVersion string, err error) {
 	parts := strings.Split(key, KeySegmentsSeparator)
-	if len(parts) != 4 || parts[0] != "helmcharts" || len(parts[1]) == 0 || len(parts[2]) == 0 || len(parts[3]) == 0 {
-		return "", "", "", status.Errorf(codes.Internal, "invalid key [%s]", key)
+	if len(parts) != 5 || !isChartKeyPrefix(parts[0]) || parts[1] != c.cluster || len(parts[2]) == 0 || len(parts[3]) == 0 || len(parts[4]) == 0 {
+		return "", "", "", "", status.Errorf(codes.Internal, "invalid key [%s]", key)
 	}
-	return parts[1], parts[2], parts[3], nil
+	return parts[0], parts[2], parts[3], parts[4], nil
 }
 
 // this func is used by unit tests only
@@ -585,13 +673,33 @@ func chartCacheKeyFunc(obj interface{}) (string, error) {
 	if entry, ok := obj.(chartCacheStoreEntry); !ok {
 		return "", fmt.Errorf("unexpected object in chartCacheKeyFunc: [%s]", reflect.TypeOf(obj))
 	} else {
-		return chartCacheKeyFor(entry.namespace, entry.id, entry.version)
+		return chartCacheKeyFor(entry.keyPrefix, entry.cluster, entry.namespace, entry.id, entry.version)
 	}
 }
 
-func chartCacheKeyFor(namespace, chartID, chartVersion string) (string, error) {
-	if namespace == "" || chartID == "" || chartVersion == "" {
-		return "", fmt.Errorf("invalid chart in chartCacheKeyFor: [%s,%s,%s]", namespace, chartID, chartVersion)
+// chartKeyPrefix returns the prefix of the keys of the charts of a repo with the given type
+func chartKeyPrefix(repoType string) string {
+	if prefix, ok := sourceChartsKeyPrefixes[repoType]; ok {
+		return prefix
+	}
+	return helmChartsKeyPrefix
+}
+
+func isChartKeyPrefix(prefix string) bool {
+	if prefix == helmChartsKeyPrefix {
+		return true
+	}
+	for _, p := range sourceChartsKeyPrefixes {
+		if p == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+func chartCacheKeyFor(keyPrefix, cluster, namespace, chartID, chartVersion string) (string, error) {
+	if keyPrefix == "" || namespace == "" || chartID == "" || chartVersion == "" {
+		return "", fmt.Errorf("invalid chart in chartCacheKeyFor: [%s,%s,%s,%s,%s]", keyPrefix, cluster, namespace, chartID, chartVersion)
 	}
 
 	var err error
@@ -602,9 +710,13 @@ func chartCacheKeyFor(namespace, chartID, chartVersion string) (string, error) {
 	// redis convention on key format
 	// https://redis.io/topics/data-types-intro
 	// Try to stick with a schema. For instance "object-type:id" is a good idea, as in "user:1000".
-	// We will use "helmcharts:ns:chartID:chartVersion"
+	// We will use "helmcharts:cluster:ns:chartID:chartVersion", or e.g. "gitcharts:..."
+	// for the charts of GitRepositories
 	// notice that chartID is of the form "repoName/id", so it includes the repo name
-	return fmt.Sprintf("helmcharts%s%s%s%s%s%s",
+	return fmt.Sprintf("%s%s%s%s%s%s%s%s%s",
+		keyPrefix,
+		KeySegmentsSeparator,
+		cluster,
 		KeySegmentsSeparator,
 		namespace,
 		KeySegmentsSeparator,
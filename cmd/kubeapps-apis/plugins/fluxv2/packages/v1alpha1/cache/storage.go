@@ -0,0 +1,290 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+)
+
+// Storage is where the caches keep their entries. Caches for different clusters
+// may share the same storage, so keys are expected to be unique across caches.
+// Implementations must be safe for concurrent use and may evict entries at any time
+// to make room for new ones, so a storage is never the "source of truth" for
+// which keys exist
+type Storage interface {
+	// Get returns the value stored for the given key, or nil if the key does not
+	// exist or has been evicted
+	Get(key string) ([]byte, error)
+	// Set stores the value for the given key, with no expiration time
+	Set(key string, value []byte) error
+	// Exists returns whether there is a value stored for the given key
+	Exists(key string) (bool, error)
+	// Delete removes the given keys and returns the number of keys that were removed
+	Delete(keys ...string) (int64, error)
+	// Keys returns the keys matching the given glob-style pattern, where '*'
+	// matches any sequence of characters and '?' matches any single character
+	Keys(match string) ([]string, error)
+	// DeleteMatching removes all the keys matching the given glob-style pattern
+	DeleteMatching(match string) error
+	// MemoryStats returns the used and total memory of the storage in
+	// human-readable form, or "?" if unknown
+	MemoryStats() (used, total string)
+}
+
+// redisStorage keeps cache entries in redis, which may be shared with other
+// instances of kubeapps-apis
+type redisStorage struct {
+	redisCli *redis.Client
+}
+
+// NewRedisStorage returns a Storage backed by the given redis client
+func NewRedisStorage(redisCli *redis.Client) (Storage, error) {
+	if redisCli == nil {
+		return nil, fmt.Errorf("server not configured with redis Client")
+	}
+	return &redisStorage{redisCli: redisCli}, nil
+}
+
+func (s *redisStorage) Get(key string) ([]byte, error) {
+	byteArray, err := s.redisCli.Get(s.redisCli.Context(), key).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	return byteArray, err
+}
+
+func (s *redisStorage) Set(key string, value []byte) error {
+	// Zero expiration means the key has no expiration time.
+	// However, cache entries may be evicted by redis in order to make room for new ones,
+	// if redis is limited by maxmemory constraint
+	return s.redisCli.Set(s.redisCli.Context(), key, value, 0).Err()
+}
+
+func (s *redisStorage) Exists(key string) (bool, error) {
+	keysExist, err := s.redisCli.Exists(s.redisCli.Context(), key).Result()
+	return keysExist == 1, err
+}
+
+func (s *redisStorage) Delete(keys ...string) (int64, error) {
+	return s.redisCli.Del(s.redisCli.Context(), keys...).Result()
+}
+
+func (s *redisStorage) Keys(match string) ([]string, error) {
+	result := []string{}
+	// https://redis.io/commands/scan An iteration starts when the cursor is set to 0,
+	// and terminates when the cursor returned by the server is 0
+	cursor := uint64(0)
+	for {
+		var keys []string
+		var err error
+		keys, cursor, err = s.redisCli.Scan(s.redisCli.Context(), cursor, match, 0).Result()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, keys...)
+		if cursor == 0 {
+			return result, nil
+		}
+	}
+}
+
+func (s *redisStorage) DeleteMatching(match string) error {
+	// keys are deleted one page of the scan at a time, so as not to have to hold
+	// all of them in memory at once
+	cursor := uint64(0)
+	for {
+		var keys []string
+		var err error
+		keys, cursor, err = s.redisCli.Scan(s.redisCli.Context(), cursor, match, 0).Result()
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			if _, err := s.Delete(keys...); err != nil {
+				return err
+			}
+		}
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *redisStorage) MemoryStats() (used, total string) {
+	return common.RedisMemoryStats(s.redisCli)
+}
+
+// memoryStorage keeps cache entries in the memory of this process, up to
+// a maximum size, evicting the least recently used entries to make room
+// for new ones, similarly to redis with the allkeys-lru maxmemory-policy.
+// It is meant for small installations where deploying redis is not warranted
+type memoryStorage struct {
+	mutex sync.Mutex
+	// most recently used entries are at the front
+	lru     *list.List
+	entries map[string]*list.Element
+	// the sizes of all keys and values currently stored, in bytes
+	usedBytes int64
+	maxBytes  int64
+}
+
+type memoryStorageEntry struct {
+	key   string
+	value []byte
+}
+
+func (e *memoryStorageEntry) size() int64 {
+	return int64(len(e.key) + len(e.value))
+}
+
+// NewMemoryStorage returns a Storage that keeps up to maxBytes of keys and values in memory
+func NewMemoryStorage(maxBytes int64) (Storage, error) {
+	if maxBytes <= 0 {
+		return nil, fmt.Errorf("invalid maximum size for in-memory cache: [%d]", maxBytes)
+	}
+	return &memoryStorage{
+		lru:      list.New(),
+		entries:  map[string]*list.Element{},
+		maxBytes: maxBytes,
+	}, nil
+}
+
+func (s *memoryStorage) Get(key string) ([]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if elem, ok := s.entries[key]; ok {
+		s.lru.MoveToFront(elem)
+		return elem.Value.(*memoryStorageEntry).value, nil
+	}
+	return nil, nil
+}
+
+func (s *memoryStorage) Set(key string, value []byte) error {
+	entry := &memoryStorageEntry{key: key, value: value}
+	if entry.size() > s.maxBytes {
+		return fmt.Errorf("value of [%d] bytes for key [%s] exceeds the maximum size of the in-memory cache: [%d] bytes",
+			len(value), key, s.maxBytes)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.remove(key)
+	s.entries[key] = s.lru.PushFront(entry)
+	s.usedBytes += entry.size()
+	for s.usedBytes > s.maxBytes {
+		s.remove(s.lru.Back().Value.(*memoryStorageEntry).key)
+	}
+	return nil
+}
+
+func (s *memoryStorage) Exists(key string) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, ok := s.entries[key]
+	return ok, nil
+}
+
+func (s *memoryStorage) Delete(keys ...string) (int64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	removed := int64(0)
+	for _, key := range keys {
+		if s.remove(key) {
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+func (s *memoryStorage) Keys(match string) ([]string, error) {
+	re, err := globToRegexp(match)
+	if err != nil {
+		return nil, err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	keys := []string{}
+	for key := range s.entries {
+		if re.MatchString(key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (s *memoryStorage) DeleteMatching(match string) error {
+	re, err := globToRegexp(match)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for key := range s.entries {
+		if re.MatchString(key) {
+			s.remove(key)
+		}
+	}
+	return nil
+}
+
+func (s *memoryStorage) MemoryStats() (used, total string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return humanReadableBytes(s.usedBytes), humanReadableBytes(s.maxBytes)
+}
+
+// remove must be called with the mutex held. It returns whether the key was found
+func (s *memoryStorage) remove(key string) bool {
+	if elem, ok := s.entries[key]; ok {
+		s.lru.Remove(elem)
+		delete(s.entries, key)
+		s.usedBytes -= elem.Value.(*memoryStorageEntry).size()
+		return true
+	}
+	return false
+}
+
+// globToRegexp converts a glob-style pattern, as supported by redis SCAN MATCH,
+// into a regular expression. Only '*' and '?' are special, character classes
+// are not supported as they are not used by the caches
+func globToRegexp(match string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, r := range match {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+// humanReadableBytes formats a number of bytes the same way redis INFO memory
+// does for used_memory_rss_human and maxmemory_human, e.g. 1.50M
+func humanReadableBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	value, suffix := float64(n), ""
+	for _, s := range []string{"K", "M", "G", "T", "P"} {
+		if value < unit {
+			break
+		}
+		value, suffix = value/unit, s
+	}
+	return fmt.Sprintf("%.2f%s", value, suffix)
+}
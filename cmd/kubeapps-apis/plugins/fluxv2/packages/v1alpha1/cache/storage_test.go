@@ -0,0 +1,122 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMemoryStorageGetSetDelete(t *testing.T) {
+	s, err := NewMemoryStorage(1024)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if value, err := s.Get("foo"); err != nil || value != nil {
+		t.Fatalf("expected no value, got: [%v], err: %v", value, err)
+	}
+	if err := s.Set("foo", []byte("bar")); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if value, err := s.Get("foo"); err != nil || string(value) != "bar" {
+		t.Fatalf("expected [bar], got: [%s], err: %v", value, err)
+	}
+	if exists, err := s.Exists("foo"); err != nil || !exists {
+		t.Fatalf("expected key to exist, got: %t, err: %v", exists, err)
+	}
+	if err := s.Set("foo", []byte("baz")); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if value, err := s.Get("foo"); err != nil || string(value) != "baz" {
+		t.Fatalf("expected [baz], got: [%s], err: %v", value, err)
+	}
+	if removed, err := s.Delete("foo", "missing"); err != nil || removed != 1 {
+		t.Fatalf("expected 1 key removed, got: %d, err: %v", removed, err)
+	}
+	if exists, err := s.Exists("foo"); err != nil || exists {
+		t.Fatalf("expected key not to exist, got: %t, err: %v", exists, err)
+	}
+	if used, total := s.MemoryStats(); used != "0B" || total != "1.00K" {
+		t.Errorf("unexpected memory stats: [%s/%s]", used, total)
+	}
+}
+
+func TestMemoryStorageEvictsLeastRecentlyUsed(t *testing.T) {
+	// room for exactly 3 entries of 1-byte keys with 9-byte values
+	s, err := NewMemoryStorage(30)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	value := []byte("123456789")
+	for _, key := range []string{"a", "b", "c"} {
+		if err := s.Set(key, value); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	// "a" becomes the most recently used, so "b" is evicted next
+	if _, err := s.Get("a"); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := s.Set("d", value); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	keys, err := s.Keys("*")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	sort.Strings(keys)
+	if got, want := keys, []string{"a", "c", "d"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	if err := s.Set("e", make([]byte, 30)); err == nil {
+		t.Errorf("expected an error setting a value larger than the storage")
+	}
+}
+
+func TestMemoryStorageKeysMatching(t *testing.T) {
+	s, err := NewMemoryStorage(1024)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, key := range []string{
+		"helmrepositories:default:default:bitnami",
+		"helmrepositories:other:default:bitnami",
+		"helmcharts:default:default:bitnami/redis:14.4.0",
+		"helmcharts:default:default:bitnami/redis:14.3.4",
+		"helmcharts:default:default:podinfo/podinfo:6.1.5",
+	} {
+		if err := s.Set(key, []byte("value")); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+
+	keys, err := s.Keys("helmcharts:default:default:bitnami/*:*")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	sort.Strings(keys)
+	expected := []string{
+		"helmcharts:default:default:bitnami/redis:14.3.4",
+		"helmcharts:default:default:bitnami/redis:14.4.0",
+	}
+	if got, want := keys, expected; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	if err := s.DeleteMatching("helmrepositories:default:*"); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	keys, err = s.Keys("helmrepositories:*")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := keys, []string{"helmrepositories:other:default:bitnami"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
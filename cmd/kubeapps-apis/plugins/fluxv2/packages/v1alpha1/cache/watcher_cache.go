@@ -13,7 +13,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/go-redis/redis/v8"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
 	"google.golang.org/grpc/codes"
@@ -55,8 +54,8 @@ var (
 // supported at this time
 type NamespacedResourceWatcherCache struct {
 	// these expected to be provided by the caller when creating new cache
-	config   NamespacedResourceWatcherCacheConfig
-	redisCli *redis.Client
+	config  NamespacedResourceWatcherCacheConfig
+	storage Storage
 
 	// queue is a rate limited work queue. This is used to queue work to be
 	// processed instead of performing it as soon as a change happens. This
@@ -78,7 +77,7 @@ type NamespacedResourceWatcherCache struct {
 	queue RateLimitingInterface
 
 	// I am using a Read/Write Mutex to gate access to cache's resync() operation, which is
-	// significant in that it flushes the whole redis cache and re-populates the state from k8s.
+	// significant in that it deletes all of the entries of this cache in storage and re-populates the state from k8s.
 	// When that happens we don't really want any concurrent access to the cache until the resync()
 	// operation is complete. In other words, we want to:
 	//  - be able to have multiple concurrent readers (goroutines doing GetForOne()/GetForMultiple())
@@ -102,6 +101,9 @@ type GetListItemsFunc func(ctrlclient.ObjectList) []ctrlclient.Object
 
 type NamespacedResourceWatcherCacheConfig struct {
 	Gvr schema.GroupVersionResource
+	// the cluster the resources are watched on. Caches for different clusters may
+	// share the same storage, so the cluster is part of every key
+	Cluster string
 	// this ClientGetter is for running out-of-request interactions with the Kubernetes API server,
 	// such as watching for resource changes
 	ClientGetter clientgetter.BackgroundClientGetterFunc
@@ -111,17 +113,13 @@ type NamespacedResourceWatcherCacheConfig struct {
 	// This allows the call site to return information about WHETHER OR NOT and WHAT is to be stored
 	// in the cache for a given k8s object (passed in as a ctrlclient.Object).
 	// ref https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.11.0/pkg/client#Object
-	// The call site may return []byte, but it doesn't have to be that.
-	// The list of all types actually supported by redis you can find in
-	// https://github.com/go-redis/redis/blob/v8.10.0/internal/proto/writer.go#L61
+	// The call site must return []byte, as that is what the cache storage keeps.
 	OnAddFunc ValueAdderFunc
 	// 'OnModifyFunc' hook is called when an object for which there is a corresponding cache entry
 	// is modified. This allows the call site to return information about WHETHER OR NOT and WHAT
 	// in the cache for a given k8s object (passed in as a ctrlclient.Object).
 	// ref https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.11.0/pkg/client#Object
-	// The call site may return []byte, but it doesn't have to be that.
-	// The list of all types actually supported by redis you can find in
-	// https://github.com/go-redis/redis/blob/v8.10.0/internal/proto/writer.go#L61
+	// The call site must return []byte, as that is what the cache storage keeps.
 	OnModifyFunc ValueModifierFunc
 	// the semantics of 'OnGetFunc' hook is to convert or "reverse engineer" what was previously
 	// stored in the cache (via onAdd/onModify hooks) to an object that the call site understands
@@ -140,11 +138,11 @@ type NamespacedResourceWatcherCacheConfig struct {
 }
 
 // invokeExpectResync arg is only set to true for by unit tests only
-func NewNamespacedResourceWatcherCache(name string, config NamespacedResourceWatcherCacheConfig, redisCli *redis.Client, stopCh <-chan struct{}, invokeExpectResync bool) (*NamespacedResourceWatcherCache, error) {
-	log.Infof("+NewNamespacedResourceWatcherCache(%s, %v, %v)", name, config.Gvr, redisCli)
+func NewNamespacedResourceWatcherCache(name string, config NamespacedResourceWatcherCacheConfig, storage Storage, stopCh <-chan struct{}, invokeExpectResync bool) (*NamespacedResourceWatcherCache, error) {
+	log.Infof("+NewNamespacedResourceWatcherCache(%s, %s, %v, %v)", name, config.Cluster, config.Gvr, storage)
 
-	if redisCli == nil {
-		return nil, fmt.Errorf("server not configured with redis Client")
+	if storage == nil {
+		return nil, fmt.Errorf("server not configured with cache storage")
 	} else if config.ClientGetter == nil {
 		return nil, fmt.Errorf("server not configured with clientGetter")
 	} else if config.OnAddFunc == nil || config.OnModifyFunc == nil ||
@@ -156,7 +154,7 @@ func NewNamespacedResourceWatcherCache(name string, config NamespacedResourceWat
 
 	c := NamespacedResourceWatcherCache{
 		config:     config,
-		redisCli:   redisCli,
+		storage:    storage,
 		queue:      NewRateLimitingQueue(name, verboseWatcherCacheQueue),
 		resyncCond: sync.NewCond(&sync.RWMutex{}),
 	}
@@ -420,17 +418,17 @@ func (c *NamespacedResourceWatcherCache) resync(bootstrap bool) (string, error)
 		}
 		log.Infof("Resetting work queue [%s]...", c.queue.Name())
 		c.queue.Reset()
+	}
 
-		if err := c.config.OnResyncFunc(); err != nil {
-			return "", status.Errorf(codes.Internal, "invocation of [OnResync] failed due to: %v", err)
-		}
+	// on bootstrap this gets rid of any entries left over from a previous run
+	if err := c.config.OnResyncFunc(); err != nil {
+		return "", status.Errorf(codes.Internal, "invocation of [OnResync] failed due to: %v", err)
 	}
 
-	// clear the entire cache in one call
-	if result, err := c.redisCli.FlushDB(c.redisCli.Context()).Result(); err != nil {
+	// clear the entire cache. The storage may be shared with the caches of other
+	// clusters, so only the keys for this cluster are deleted
+	if err := c.storage.DeleteMatching(c.keyPrefix() + "*"); err != nil {
 		return "", err
-	} else {
-		log.Infof("Redis [FLUSHDB]: %s", result)
 	}
 
 	ctx := context.Background()
@@ -574,10 +572,10 @@ func (c *NamespacedResourceWatcherCache) onAddOrModify(obj ctrlclient.Object) (e
 	}
 
 	var oldValue []byte
-	if oldValue, err = c.redisCli.Get(c.redisCli.Context(), key).Bytes(); err != redis.Nil && err != nil {
+	if oldValue, err = c.storage.Get(key); err != nil {
 		return fmt.Errorf("onAddOrModify() failed to get value for key [%s] in cache due to: %v", key, err)
 	} else {
-		log.V(4).Infof("Redis [GET %s]: %d bytes read", key, len(oldValue))
+		log.V(4).Infof("Cache [GET %s]: %d bytes read", key, len(oldValue))
 	}
 
 	var setVal bool
@@ -594,28 +592,30 @@ func (c *NamespacedResourceWatcherCache) onAddOrModify(obj ctrlclient.Object) (e
 	if err != nil {
 		log.Errorf("Invocation of [%s] for object %s\nfailed due to: %v", funcName, common.PrettyPrint(obj), err)
 		// clear that key so cache doesn't contain any stale info for this object
-		keysremoved, err2 := c.redisCli.Del(c.redisCli.Context(), key).Result()
+		keysremoved, err2 := c.storage.Delete(key)
 		if err2 != nil {
 			log.Errorf("failed to delete value for object [%s] from cache due to: %v", key, err2)
 		} else {
 			// debugging an intermittent failure
-			log.Infof("Redis [DEL %s]: %d", key, keysremoved)
+			log.Infof("Cache [DEL %s]: %d", key, keysremoved)
 		}
 		return nil
 	} else if setVal {
-		// Zero expiration means the key has no expiration time.
-		// However, cache entries may be evicted by redis in order to make room for new ones,
-		// if redis is limited by maxmemory constraint
+		byteArray, ok := newValue.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected value type for object with key [%s]: [%s]", key, reflect.TypeOf(newValue))
+		}
+		// cache entries may be evicted by the storage in order to make room for new ones,
+		// e.g. if redis is limited by maxmemory constraint
 		startTime := time.Now()
-		result, err := c.redisCli.Set(c.redisCli.Context(), key, newValue, 0).Result()
-		if err != nil {
+		if err := c.storage.Set(key, byteArray); err != nil {
 			return fmt.Errorf("failed to set value for object with key [%s] in cache due to: %v", key, err)
 		} else {
 			duration := time.Since(startTime)
 			// debugging an intermittent issue
-			usedMemory, totalMemory := common.RedisMemoryStats(c.redisCli)
-			log.Infof("Redis [SET %s]: %s in [%d] ms. Redis [INFO memory]: [%s/%s]",
-				key, result, duration.Milliseconds(), usedMemory, totalMemory)
+			usedMemory, totalMemory := c.storage.MemoryStats()
+			log.Infof("Cache [SET %s]: OK in [%d] ms. Cache memory: [%s/%s]",
+				key, duration.Milliseconds(), usedMemory, totalMemory)
 		}
 	}
 	return nil
@@ -633,12 +633,12 @@ func (c *NamespacedResourceWatcherCache) onDelete(key string) error {
 	}
 
 	if delete {
-		keysremoved, err := c.redisCli.Del(c.redisCli.Context(), key).Result()
+		keysremoved, err := c.storage.Delete(key)
 		if err != nil {
 			return fmt.Errorf("failed to delete value for object [%s] from cache due to: %v", key, err)
 		} else {
 			// debugging an intermittent failure
-			log.Infof("Redis [DEL %s]: %d", key, keysremoved)
+			log.Infof("Cache [DEL %s]: %d", key, keysremoved)
 		}
 	}
 	return nil
@@ -649,17 +649,17 @@ func (c *NamespacedResourceWatcherCache) fetchForOne(key string) (interface{}, e
 	log.InfoS("+fetchForOne", "key", key)
 	// read back from cache: should be either:
 	//  - what we previously wrote OR
-	//  - redis.Nil if the key does  not exist or has been evicted due to memory pressure/TTL expiry
+	//  - nil if the key does  not exist or has been evicted due to memory pressure/TTL expiry
 	//
-	byteArray, err := c.redisCli.Get(c.redisCli.Context(), key).Bytes()
+	byteArray, err := c.storage.Get(key)
 	// debugging an intermittent issue
-	if err == redis.Nil {
-		log.V(4).Infof("Redis [GET %s]: Nil", key)
-		return nil, nil
-	} else if err != nil {
+	if err != nil {
 		return nil, fmt.Errorf("fetchForOne() failed to get value for key [%s] from cache due to: %v", key, err)
+	} else if byteArray == nil {
+		log.V(4).Infof("Cache [GET %s]: Nil", key)
+		return nil, nil
 	}
-	log.V(4).Infof("Redis [GET %s]: %d bytes read", key, len(byteArray))
+	log.V(4).Infof("Cache [GET %s]: %d bytes read", key, len(byteArray))
 
 	// TODO (gfichtenholt) See if there might be a cleaner way than to have onGet() take []byte as
 	// a 2nd argument. In theory, I would have liked to pass in an interface{}, just like onAdd/onModify.
@@ -926,23 +926,31 @@ func (c *NamespacedResourceWatcherCache) KeyForNamespacedName(name types.Namespa
 	// redis convention on key format
 	// https://redis.io/topics/data-types-intro
 	// Try to stick with a schema. For instance "object-type:id" is a good idea, as in "user:1000".
-	// We will use "helmrepositories:ns:repoName"
-	return fmt.Sprintf("%s%s%s%s%s",
-		c.config.Gvr.Resource,
-		KeySegmentsSeparator,
+	// We will use "helmrepositories:cluster:ns:repoName"
+	return fmt.Sprintf("%s%s%s%s",
+		c.keyPrefix(),
 		name.Namespace,
 		KeySegmentsSeparator,
 		name.Name)
 }
 
+// the common prefix of all the keys of this cache
+func (c *NamespacedResourceWatcherCache) keyPrefix() string {
+	return fmt.Sprintf("%s%s%s%s",
+		c.config.Gvr.Resource,
+		KeySegmentsSeparator,
+		c.config.Cluster,
+		KeySegmentsSeparator)
+}
+
 // the opposite of keyFor()
 // the goal is to keep the details of what exactly the key looks like localized to one piece of code
 func (c *NamespacedResourceWatcherCache) fromKey(key string) (*types.NamespacedName, error) {
 	parts := strings.Split(key, KeySegmentsSeparator)
-	if len(parts) != 3 || parts[0] != c.config.Gvr.Resource || len(parts[1]) == 0 || len(parts[2]) == 0 {
+	if len(parts) != 4 || parts[0] != c.config.Gvr.Resource || parts[1] != c.config.Cluster || len(parts[2]) == 0 || len(parts[3]) == 0 {
 		return nil, status.Errorf(codes.Internal, "invalid key [%s]", key)
 	}
-	return &types.NamespacedName{Namespace: parts[1], Name: parts[2]}, nil
+	return &types.NamespacedName{Namespace: parts[2], Name: parts[3]}, nil
 }
 
 // GetForOne() is like fetchForOne() but if there is a cache miss, it will also check the
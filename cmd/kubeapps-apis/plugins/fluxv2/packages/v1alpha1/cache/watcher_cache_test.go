@@ -0,0 +1,103 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestKeysOfDifferentClusters(t *testing.T) {
+	name := types.NamespacedName{Namespace: "default", Name: "bitnami"}
+	defaultCache := &NamespacedResourceWatcherCache{
+		config: NamespacedResourceWatcherCacheConfig{Gvr: common.GetRepositoriesGvr(), Cluster: "default"},
+	}
+	otherCache := &NamespacedResourceWatcherCache{
+		config: NamespacedResourceWatcherCacheConfig{Gvr: common.GetRepositoriesGvr(), Cluster: "other"},
+	}
+
+	t.Run("repository keys are prefixed with the cluster", func(t *testing.T) {
+		defaultKey := defaultCache.KeyForNamespacedName(name)
+		otherKey := otherCache.KeyForNamespacedName(name)
+		if got, want := defaultKey, "helmrepositories:default:default:bitnami"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+		if got, want := otherKey, "helmrepositories:other:default:bitnami"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+
+		if got, err := defaultCache.fromKey(defaultKey); err != nil || *got != name {
+			t.Errorf("got: %v, want: %v, err: %v", got, name, err)
+		}
+		// a cache ignores the keys of the other clusters in the shared storage
+		if _, err := defaultCache.fromKey(otherKey); err == nil {
+			t.Errorf("expected an error for the key [%s] of another cluster", otherKey)
+		}
+	})
+
+	t.Run("chart keys are prefixed with the cluster", func(t *testing.T) {
+		defaultKey, err := chartCacheKeyFor(helmChartsKeyPrefix, "default", "default", "bitnami/redis", "14.4.0")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		otherKey, err := chartCacheKeyFor(helmChartsKeyPrefix, "other", "default", "bitnami/redis", "14.4.0")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := defaultKey, "helmcharts:default:default:bitnami/redis:14.4.0"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+		if got, want := otherKey, "helmcharts:other:default:bitnami/redis:14.4.0"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+
+		chartCache := &ChartCache{cluster: "default"}
+		if _, _, chartID, _, err := chartCache.fromKey(defaultKey); err != nil || chartID != "bitnami/redis" {
+			t.Errorf("got: %q, want: %q, err: %v", chartID, "bitnami/redis", err)
+		}
+		if _, _, _, _, err := chartCache.fromKey(otherKey); err == nil {
+			t.Errorf("expected an error for the key [%s] of another cluster", otherKey)
+		}
+	})
+}
+
+func TestChartKeysOfDifferentKindsOfRepos(t *testing.T) {
+	c := &ChartCache{cluster: "default"}
+
+	helmKey, err := c.KeyFor("default", "bitnami/redis", "14.4.0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	gitKey, err := c.KeyForRepoType("git", "default", "bitnami/redis", "14.4.0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	bucketKey, err := c.KeyForRepoType("bucket", "default", "bitnami/redis", "14.4.0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	ociKey, err := c.KeyForRepoType("oci", "default", "bitnami/redis", "14.4.0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// the charts of a GitRepository or Bucket don't collide with those of a
+	// HelmRepository with the same name
+	expected := []string{
+		"helmcharts:default:default:bitnami/redis:14.4.0",
+		"gitcharts:default:default:bitnami/redis:14.4.0",
+		"bucketcharts:default:default:bitnami/redis:14.4.0",
+		"helmcharts:default:default:bitnami/redis:14.4.0",
+	}
+	if got, want := []string{helmKey, gitKey, bucketKey, ociKey}, expected; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	if prefix, _, chartID, _, err := c.fromKey(gitKey); err != nil || prefix != "gitcharts" || chartID != "bitnami/redis" {
+		t.Errorf("got: [%s, %s], want: [gitcharts, bitnami/redis], err: %v", prefix, chartID, err)
+	}
+}
@@ -28,8 +28,8 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
-func (s *Server) getChartInCluster(ctx context.Context, key types.NamespacedName) (*sourcev1.HelmChart, error) {
-	client, err := s.getClient(ctx, key.Namespace)
+func (s *Server) getChartInCluster(ctx context.Context, cluster string, key types.NamespacedName) (*sourcev1.HelmChart, error) {
+	client, err := s.getClient(ctx, cluster, key.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -41,8 +41,13 @@ func (s *Server) getChartInCluster(ctx context.Context, key types.NamespacedName
 }
 
 // TODO (gfichtenholt) this func is too long. Break it up
-func (s *Server) availableChartDetail(ctx context.Context, packageRef *corev1.AvailablePackageReference, chartVersion string) (*corev1.AvailablePackageDetail, error) {
-	log.Infof("+availableChartDetail(%s, %s)", packageRef, chartVersion)
+func (s *Server) availableChartDetail(ctx context.Context, cluster string, packageRef *corev1.AvailablePackageReference, chartVersion string) (*corev1.AvailablePackageDetail, error) {
+	log.Infof("+availableChartDetail(%s, %s, %s)", cluster, packageRef, chartVersion)
+
+	caches, err := s.cachesFor(cluster)
+	if err != nil {
+		return nil, err
+	}
 
 	repoN, chartName, err := pkgutils.SplitPackageIdentifier(packageRef.Identifier)
 	if err != nil {
@@ -53,8 +58,16 @@ func (s *Server) availableChartDetail(ctx context.Context, packageRef *corev1.Av
 	repoName := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: repoN}
 
 	// this verifies that the repo exists
-	repo, err := s.getRepoInCluster(ctx, repoName)
-	if err != nil {
+	repo, err := s.getRepoInCluster(ctx, cluster, repoName)
+	if status.Code(err) == codes.NotFound {
+		// the chart may be from a GitRepository or a Bucket instead
+		if src, kind, err2 := s.findChartSourceInCluster(ctx, cluster, repoName); err2 != nil {
+			return nil, err2
+		} else if src != nil {
+			return s.availableSourceChartDetail(ctx, cluster, src, *kind, chartName, chartVersion)
+		}
+		return nil, err
+	} else if err != nil {
 		return nil, err
 	} else if !isRepoReady(*repo) {
 		return nil, status.Errorf(codes.Internal, "repository [%s] is not in Ready state", repoName)
@@ -65,16 +78,16 @@ func (s *Server) availableChartDetail(ctx context.Context, packageRef *corev1.Av
 	// happens to be in the cache
 	var byteArray []byte
 	if chartVersion != "" {
-		if key, err := s.chartCache.KeyFor(repoName.Namespace, chartID, chartVersion); err != nil {
+		if key, err := caches.chartCache.KeyFor(repoName.Namespace, chartID, chartVersion); err != nil {
 			return nil, err
-		} else if byteArray, err = s.chartCache.FetchForOne(key); err != nil {
+		} else if byteArray, err = caches.chartCache.FetchForOne(key); err != nil {
 			return nil, err
 		}
 	}
 
 	if byteArray == nil {
 		// no specific chart version was provided or a cache miss, need to do a bit of work
-		chartModel, err := s.getChart(ctx, repoName, chartName)
+		chartModel, err := s.getChart(ctx, cluster, repoName, chartName)
 		if err != nil {
 			return nil, err
 		} else if chartModel == nil {
@@ -86,25 +99,25 @@ func (s *Server) availableChartDetail(ctx context.Context, packageRef *corev1.Av
 		}
 
 		var key string
-		if key, err = s.chartCache.KeyFor(repoName.Namespace, chartID, chartVersion); err != nil {
+		if key, err = caches.chartCache.KeyFor(repoName.Namespace, chartID, chartVersion); err != nil {
 			return nil, err
 		}
 
 		var fn cache.DownloadChartFn
 		if chartModel.Repo.Type == "oci" {
-			if ociRegistry, err := s.newOCIRegistryAndLoginWithRepo(ctx, repoName); err != nil {
+			if ociRegistry, err := s.newOCIRegistryAndLoginWithRepo(ctx, cluster, repoName); err != nil {
 				return nil, err
 			} else {
 				fn = downloadOCIChartFn(ociRegistry)
 			}
 		} else {
-			if opts, err := s.httpClientOptionsForRepo(ctx, repoName); err != nil {
+			if opts, err := s.httpClientOptionsForRepo(ctx, cluster, repoName); err != nil {
 				return nil, err
 			} else {
 				fn = downloadChartViaHttpFn(opts)
 			}
 		}
-		if byteArray, err = s.chartCache.GetForOne(key, chartModel, fn); err != nil {
+		if byteArray, err = caches.chartCache.GetForOne(key, chartModel, fn); err != nil {
 			return nil, err
 		}
 
@@ -132,21 +145,22 @@ func (s *Server) availableChartDetail(ctx context.Context, packageRef *corev1.Av
 	pkgDetail.RepoUrl = repoUrl
 	pkgDetail.AvailablePackageRef.Context.Namespace = packageRef.Context.Namespace
 	// per https://github.com/vmware-tanzu/kubeapps/pull/3686#issue-1038093832
-	pkgDetail.AvailablePackageRef.Context.Cluster = s.kubeappsCluster
+	pkgDetail.AvailablePackageRef.Context.Cluster = cluster
 	return pkgDetail, nil
 }
 
-func (s *Server) getChart(ctx context.Context, repo types.NamespacedName, chartName string) (*models.Chart, error) {
-	if s.repoCache == nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "server cache has not been properly initialized")
-	} else if ok, err := s.hasAccessToNamespace(ctx, common.GetChartsGvr(), repo.Namespace); err != nil {
+func (s *Server) getChart(ctx context.Context, cluster string, repo types.NamespacedName, chartName string) (*models.Chart, error) {
+	caches, err := s.cachesFor(cluster)
+	if err != nil {
+		return nil, err
+	} else if ok, err := s.hasAccessToNamespace(ctx, cluster, common.GetChartsGvr(), repo.Namespace); err != nil {
 		return nil, err
 	} else if !ok {
 		return nil, status.Errorf(codes.PermissionDenied, "user has no [get] access for HelmCharts in namespace [%s]", repo.Namespace)
 	}
 
-	key := s.repoCache.KeyForNamespacedName(repo)
-	if entry, err := s.repoCache.GetForOne(key); err != nil {
+	key := caches.repoCache.KeyForNamespacedName(repo)
+	if entry, err := caches.repoCache.GetForOne(key); err != nil {
 		return nil, err
 	} else if entry != nil {
 		if typedEntry, ok := entry.(repoCacheEntryValue); !ok {
@@ -159,9 +173,11 @@ func (s *Server) getChart(ctx context.Context, repo types.NamespacedName, chartN
 					return &chart, nil // found it
 				}
 			}
+			return nil, nil
 		}
 	}
-	return nil, nil
+	// there is no such HelmRepository, but there may be a GitRepository or a Bucket
+	return s.getChartFromSources(caches, repo, chartName)
 }
 
 func passesFilter(chart models.Chart, filters *corev1.FilterOptions) bool {
@@ -199,7 +199,7 @@ func TestGetAvailablePackageDetail(t *testing.T) {
 				chartVersion = charts[0].chartRevision
 				requestChartUrl = charts[0].chartUrl
 			}
-			chartCacheKey, err := s.chartCache.KeyFor(
+			chartCacheKey, err := s.caches[KubeappsCluster].chartCache.KeyFor(
 				repoNamespace,
 				tc.request.AvailablePackageRef.Identifier,
 				chartVersion)
@@ -293,7 +293,7 @@ func TestTransientHttpFailuresAreRetriedForChartCache(t *testing.T) {
 		requestChartUrl := charts[0].chartUrl
 
 		s.redisMockExpectGetFromRepoCache(mock, nil, *repo)
-		chartCacheKey, err := s.chartCache.KeyFor(
+		chartCacheKey, err := s.caches[KubeappsCluster].chartCache.KeyFor(
 			repoNamespace,
 			packageIdentifier,
 			chartVersion)
@@ -468,7 +468,7 @@ func TestNonExistingRepoOrInvalidPkgVersionGetAvailablePackageDetail(t *testing.
 				requestChartName := strings.Split(tc.request.AvailablePackageRef.Identifier, "/")[1]
 				chartExists := requestChartName == "redis"
 				if chartExists {
-					chartCacheKey, err := s.chartCache.KeyFor(
+					chartCacheKey, err := s.caches[KubeappsCluster].chartCache.KeyFor(
 						requestRepoNamespace,
 						tc.request.AvailablePackageRef.Identifier,
 						tc.request.PkgVersion)
@@ -749,7 +749,7 @@ func TestChartCacheResyncNotIdle(t *testing.T) {
 		for i := 0; i < NUM_CHARTS; i++ {
 			chartID := fmt.Sprintf("%s/redis-%d", repoName, i)
 			chartVersion := "14.4.0"
-			chartCacheKey, err := s.chartCache.KeyFor(repoNamespace, chartID, chartVersion)
+			chartCacheKey, err := s.caches[KubeappsCluster].chartCache.KeyFor(repoNamespace, chartID, chartVersion)
 			if err != nil {
 				t.Fatalf("%+v", err)
 			}
@@ -761,11 +761,11 @@ func TestChartCacheResyncNotIdle(t *testing.T) {
 				}
 			}
 			redisMockSetValueForChart(mock, chartCacheKey, chartBytes)
-			s.chartCache.ExpectAdd(chartCacheKey)
+			s.caches[KubeappsCluster].chartCache.ExpectAdd(chartCacheKey)
 			chartCacheKeys = append(chartCacheKeys, chartCacheKey)
 		}
 
-		s.repoCache.ExpectAdd(repoKey)
+		s.caches[KubeappsCluster].repoCache.ExpectAdd(repoKey)
 
 		ctrlClient, watcher, err := ctrlClientAndWatcher(t, s)
 		if err != nil {
@@ -779,18 +779,18 @@ func TestChartCacheResyncNotIdle(t *testing.T) {
 		go func() {
 			// wait until the first of the added repos have been fully processed and
 			// just one of the charts has been sync'ed
-			s.repoCache.WaitUntilForgotten(repoKey)
-			s.chartCache.WaitUntilForgotten(chartCacheKeys[0])
+			s.caches[KubeappsCluster].repoCache.WaitUntilForgotten(repoKey)
+			s.caches[KubeappsCluster].chartCache.WaitUntilForgotten(chartCacheKeys[0])
 
 			// pretty delicate dance between the server and the client below using
 			// bi-directional channels in order to make sure the right expectations
 			// are set at the right time.
-			repoResyncCh, err := s.repoCache.ExpectResync()
+			repoResyncCh, err := s.caches[KubeappsCluster].repoCache.ExpectResync()
 			if err != nil {
 				t.Errorf("%v", err)
 			}
 
-			chartResyncCh, err := s.chartCache.ExpectResync()
+			chartResyncCh, err := s.caches[KubeappsCluster].chartCache.ExpectResync()
 			if err != nil {
 				t.Errorf("%v", err)
 			}
@@ -803,7 +803,7 @@ func TestChartCacheResyncNotIdle(t *testing.T) {
 			if len != 0 {
 				t.Errorf("ERROR: Expected empty repo work queue!")
 			} else {
-				mock.ExpectFlushDB().SetVal("OK")
+				redisMockExpectResync(mock, true)
 				redisMockSetValueForRepo(mock, repoKey, repoBytes, nil)
 				// now we can signal to the server it's ok to proceed
 				repoResyncCh <- 0
@@ -814,14 +814,14 @@ func TestChartCacheResyncNotIdle(t *testing.T) {
 				} else {
 					for i := 0; i < NUM_CHARTS; i++ {
 						redisMockSetValueForChart(mock, chartCacheKeys[i], chartBytes)
-						s.chartCache.ExpectAdd(chartCacheKeys[i])
+						s.caches[KubeappsCluster].chartCache.ExpectAdd(chartCacheKeys[i])
 					}
 					// now we can signal to the server it's ok to proceed
 					chartResyncCh <- 0
-					s.repoCache.WaitUntilResyncComplete()
-					s.chartCache.WaitUntilResyncComplete()
+					s.caches[KubeappsCluster].repoCache.WaitUntilResyncComplete()
+					s.caches[KubeappsCluster].chartCache.WaitUntilResyncComplete()
 					for i := 0; i < NUM_CHARTS; i++ {
-						s.chartCache.WaitUntilForgotten(chartCacheKeys[i])
+						s.caches[KubeappsCluster].chartCache.WaitUntilForgotten(chartCacheKeys[i])
 					}
 					// we do ClearExpect() here to avoid things like
 					// "there is a remaining expectation which was not matched:
@@ -962,7 +962,7 @@ func newChart(name, namespace string, spec *sourcev1.HelmChartSpec, status *sour
 }
 
 func (s *Server) redisMockSetValueForChart(mock redismock.ClientMock, key, url string, opts *common.HttpClientOptions) error {
-	sink := s.newRepoEventSink()
+	sink := s.newRepoEventSink(KubeappsCluster)
 	return sink.redisMockSetValueForChart(mock, key, url, opts)
 }
 
@@ -1016,10 +1016,10 @@ func redisMockExpectDeleteRepoWithCharts(mock redismock.ClientMock, name types.N
 	// each element of charts[] array looks like "chartName:chartVersion"
 	keys := []string{}
 	for _, c := range charts {
-		keys = append(keys, fmt.Sprintf("helmcharts:%s:%s/%s", name.Namespace, name.Name, c))
+		keys = append(keys, fmt.Sprintf("helmcharts:%s:%s:%s/%s", KubeappsCluster, name.Namespace, name.Name, c))
 	}
 
-	scanWildcard := fmt.Sprintf("helmcharts:%s:%s/*:*", name.Namespace, name.Name)
+	scanWildcard := fmt.Sprintf("helmcharts:%s:%s:%s/*:*", KubeappsCluster, name.Namespace, name.Name)
 	mock.ExpectScan(0, scanWildcard, 0).SetVal(keys, 0)
 	for _, k := range keys {
 		mock.ExpectDel(k).SetVal(0)
@@ -1029,10 +1029,10 @@ func redisMockExpectDeleteRepoWithCharts(mock redismock.ClientMock, name types.N
 
 func fromRedisKeyForChart(key string) (namespace, chartID, chartVersion string, err error) {
 	parts := strings.Split(key, ":")
-	if len(parts) != 4 || parts[0] != "helmcharts" || len(parts[1]) == 0 || len(parts[2]) == 0 || len(parts[3]) == 0 {
+	if len(parts) != 5 || parts[0] != "helmcharts" || parts[1] != KubeappsCluster || len(parts[2]) == 0 || len(parts[3]) == 0 || len(parts[4]) == 0 {
 		return "", "", "", status.Errorf(codes.Internal, "invalid key [%s]", key)
 	}
-	return parts[1], parts[2], parts[3], nil
+	return parts[2], parts[3], parts[4], nil
 }
 
 func compareActualVsExpectedAvailablePackageDetail(t *testing.T, actual *corev1.AvailablePackageDetail, expected *corev1.AvailablePackageDetail) {
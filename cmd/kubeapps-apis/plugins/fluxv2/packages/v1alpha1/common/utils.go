@@ -34,6 +34,7 @@ import (
 	"google.golang.org/grpc/status"
 	"helm.sh/helm/v3/pkg/getter"
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -48,6 +49,12 @@ const (
 	UserAgentPrefix          = "kubeapps-apis/plugins"
 	redisInitClientRetryWait = 1 * time.Second
 	redisInitClientTimeout   = 10 * time.Second
+
+	// the backends the caches of the plugin may keep their entries in
+	CacheBackendRedis  = "redis"
+	CacheBackendMemory = "memory"
+	// the default maximum size of the in-memory cache backend
+	defaultCacheMaxMemory = "256Mi"
 )
 
 // Set the pluginDetail once during a module init function so the single struct
@@ -56,10 +63,13 @@ var (
 	pluginDetail plugins.Plugin
 	// This version var is updated during the build (see the -ldflags option
 	// in the cmd/kubeapps-apis/Dockerfile)
-	version         = "devel"
-	repositoriesGvr schema.GroupVersionResource
-	chartsGvr       schema.GroupVersionResource
-	releasesGvr     schema.GroupVersionResource
+	version            = "devel"
+	repositoriesGvr    schema.GroupVersionResource
+	chartsGvr          schema.GroupVersionResource
+	releasesGvr        schema.GroupVersionResource
+	gitRepositoriesGvr schema.GroupVersionResource
+	bucketsGvr         schema.GroupVersionResource
+	kustomizationsGvr  schema.GroupVersionResource
 )
 
 func init() {
@@ -80,11 +90,31 @@ func init() {
 		Resource: "helmcharts",
 	}
 
+	gitRepositoriesGvr = schema.GroupVersionResource{
+		Group:    sourcev1.GroupVersion.Group,
+		Version:  sourcev1.GroupVersion.Version,
+		Resource: "gitrepositories",
+	}
+
+	bucketsGvr = schema.GroupVersionResource{
+		Group:    sourcev1.GroupVersion.Group,
+		Version:  sourcev1.GroupVersion.Version,
+		Resource: "buckets",
+	}
+
 	releasesGvr = schema.GroupVersionResource{
 		Group:    helmv2.GroupVersion.Group,
 		Version:  helmv2.GroupVersion.Version,
 		Resource: "helmreleases",
 	}
+
+	// the kustomize-controller API is not a dependency of the plugin, which
+	// only deals with Kustomizations as unstructured objects
+	kustomizationsGvr = schema.GroupVersionResource{
+		Group:    "kustomize.toolkit.fluxcd.io",
+		Version:  "v1beta2",
+		Resource: "kustomizations",
+	}
 }
 
 //
@@ -93,11 +123,14 @@ func init() {
 func NewDefaultPluginConfig() *FluxPluginConfig {
 	// If no config is provided, we default to the existing values for backwards
 	// compatibility.
+	cacheMaxMemory := resource.MustParse(defaultCacheMaxMemory)
 	return &FluxPluginConfig{
 		VersionsInSummary:    pkgutils.GetDefaultVersionsInSummary(),
 		TimeoutSeconds:       int32(-1),
 		DefaultUpgradePolicy: pkgutils.UpgradePolicyNone,
 		UserManagedSecrets:   false,
+		CacheBackend:         CacheBackendRedis,
+		CacheMaxMemoryBytes:  cacheMaxMemory.Value(),
 	}
 }
 
@@ -413,6 +446,14 @@ type FluxPluginConfig struct {
 	// see comments in design spec under AddPackageRepository.
 	// false (i.e. kubeapps manages secrets) by default
 	UserManagedSecrets bool
+	// names of the listers used to find the repositories of an OCI registry, in
+	// the order they are tried. Empty means only the Docker Registry API V2 one
+	OCIRepositoryListers []string
+	// where the caches keep their entries, either CacheBackendRedis (the default)
+	// or CacheBackendMemory
+	CacheBackend string
+	// the maximum size of the entries kept by the CacheBackendMemory backend
+	CacheMaxMemoryBytes int64
 }
 
 // ParsePluginConfig parses the input plugin configuration json file and return the
@@ -434,8 +475,13 @@ func ParsePluginConfig(pluginConfigPath string) (*FluxPluginConfig, error) {
 		Flux struct {
 			Packages struct {
 				V1alpha1 struct {
-					DefaultUpgradePolicy string `json:"defaultUpgradePolicy"`
-					UserManagedSecrets   bool   `json:"userManagedSecrets"`
+					DefaultUpgradePolicy string   `json:"defaultUpgradePolicy"`
+					UserManagedSecrets   bool     `json:"userManagedSecrets"`
+					OCIRepositoryListers []string `json:"ociRepositoryListers"`
+					Cache                struct {
+						Backend   string `json:"backend"`
+						MaxMemory string `json:"maxMemory"`
+					} `json:"cache"`
 				} `json:"v1alpha1"`
 			} `json:"packages"`
 		} `json:"flux"`
@@ -452,6 +498,26 @@ func ParsePluginConfig(pluginConfigPath string) (*FluxPluginConfig, error) {
 		return nil, fmt.Errorf("unable to unmarshal plugin config: %q error: %w", string(pluginConfig), err)
 	}
 
+	cacheBackend := config.Flux.Packages.V1alpha1.Cache.Backend
+	switch cacheBackend {
+	case "":
+		cacheBackend = CacheBackendRedis
+	case CacheBackendRedis, CacheBackendMemory:
+	default:
+		return nil, fmt.Errorf("unsupported cache backend: %q", cacheBackend)
+	}
+
+	cacheMaxMemory := config.Flux.Packages.V1alpha1.Cache.MaxMemory
+	if cacheMaxMemory == "" {
+		cacheMaxMemory = defaultCacheMaxMemory
+	}
+	cacheMaxMemoryQuantity, err := resource.ParseQuantity(cacheMaxMemory)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse cache maxMemory %q: %w", cacheMaxMemory, err)
+	} else if cacheMaxMemoryQuantity.Value() <= 0 {
+		return nil, fmt.Errorf("cache maxMemory must be positive, got: %q", cacheMaxMemory)
+	}
+
 	if defaultUpgradePolicy, err := pkgutils.UpgradePolicyFromString(
 		config.Flux.Packages.V1alpha1.DefaultUpgradePolicy); err != nil {
 		return nil, err
@@ -462,6 +528,9 @@ func ParsePluginConfig(pluginConfigPath string) (*FluxPluginConfig, error) {
 			TimeoutSeconds:       config.Core.Packages.V1alpha1.TimeoutSeconds,
 			DefaultUpgradePolicy: defaultUpgradePolicy,
 			UserManagedSecrets:   config.Flux.Packages.V1alpha1.UserManagedSecrets,
+			OCIRepositoryListers: config.Flux.Packages.V1alpha1.OCIRepositoryListers,
+			CacheBackend:         cacheBackend,
+			CacheMaxMemoryBytes:  cacheMaxMemoryQuantity.Value(),
 		}, nil
 	}
 }
@@ -478,6 +547,18 @@ func GetReleasesGvr() schema.GroupVersionResource {
 	return releasesGvr
 }
 
+func GetGitRepositoriesGvr() schema.GroupVersionResource {
+	return gitRepositoriesGvr
+}
+
+func GetBucketsGvr() schema.GroupVersionResource {
+	return bucketsGvr
+}
+
+func GetKustomizationsGvr() schema.GroupVersionResource {
+	return kustomizationsGvr
+}
+
 func GetSha256(src []byte) (string, error) {
 	f := bytes.NewReader(src)
 	h := sha256.New()
@@ -275,3 +275,158 @@ flux:
 		})
 	}
 }
+
+func TestParsePluginConfigOCIRepositoryListers(t *testing.T) {
+	testCases := []struct {
+		name           string
+		pluginYAMLConf []byte
+		exp_listers    []string
+		exp_error_str  string
+	}{
+		{
+			name:           "no listers specified in plugin config",
+			pluginYAMLConf: nil,
+			exp_listers:    nil,
+			exp_error_str:  "",
+		},
+		{
+			name: "specific listers in plugin config",
+			pluginYAMLConf: []byte(`
+flux:
+  packages:
+    v1alpha1:
+      ociRepositoryListers:
+      - harbor
+      - docker-registry-v2
+      `),
+			exp_listers:   []string{"harbor", "docker-registry-v2"},
+			exp_error_str: "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filename := ""
+			if tc.pluginYAMLConf != nil {
+				pluginJSONConf, err := yaml.YAMLToJSON(tc.pluginYAMLConf)
+				if err != nil {
+					log.Fatalf("%s", err)
+				}
+				f, err := os.CreateTemp(".", "plugin_json_conf")
+				if err != nil {
+					log.Fatalf("%s", err)
+				}
+				defer os.Remove(f.Name()) // clean up
+				if _, err := f.Write(pluginJSONConf); err != nil {
+					log.Fatalf("%s", err)
+				}
+				if err := f.Close(); err != nil {
+					log.Fatalf("%s", err)
+				}
+				filename = f.Name()
+			}
+			config, err := ParsePluginConfig(filename)
+			if err != nil && !strings.Contains(err.Error(), tc.exp_error_str) {
+				t.Errorf("err got %q, want to find %q", err.Error(), tc.exp_error_str)
+			}
+			if err == nil {
+				if got, want := config.OCIRepositoryListers, tc.exp_listers; !cmp.Equal(want, got) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+				}
+			}
+		})
+	}
+}
+
+func TestParsePluginConfigCache(t *testing.T) {
+	testCases := []struct {
+		name              string
+		pluginYAMLConf    []byte
+		exp_backend       string
+		exp_max_mem_bytes int64
+		exp_error_str     string
+	}{
+		{
+			name: "no cache specified in plugin config",
+			pluginYAMLConf: []byte(`
+flux:
+  packages:
+    v1alpha1:
+      defaultUpgradePolicy: none
+      `),
+			exp_backend:       CacheBackendRedis,
+			exp_max_mem_bytes: 256 * 1024 * 1024,
+			exp_error_str:     "",
+		},
+		{
+			name: "memory cache specified in plugin config",
+			pluginYAMLConf: []byte(`
+flux:
+  packages:
+    v1alpha1:
+      cache:
+        backend: memory
+        maxMemory: 64Mi
+      `),
+			exp_backend:       CacheBackendMemory,
+			exp_max_mem_bytes: 64 * 1024 * 1024,
+			exp_error_str:     "",
+		},
+		{
+			name: "unsupported cache backend specified in plugin config",
+			pluginYAMLConf: []byte(`
+flux:
+  packages:
+    v1alpha1:
+      cache:
+        backend: memcached
+      `),
+			exp_error_str: "unsupported cache backend",
+		},
+		{
+			name: "invalid cache max memory specified in plugin config",
+			pluginYAMLConf: []byte(`
+flux:
+  packages:
+    v1alpha1:
+      cache:
+        backend: memory
+        maxMemory: lots
+      `),
+			exp_error_str: "unable to parse cache maxMemory",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pluginJSONConf, err := yaml.YAMLToJSON(tc.pluginYAMLConf)
+			if err != nil {
+				log.Fatalf("%s", err)
+			}
+			f, err := os.CreateTemp(".", "plugin_json_conf")
+			if err != nil {
+				log.Fatalf("%s", err)
+			}
+			defer os.Remove(f.Name()) // clean up
+			if _, err := f.Write(pluginJSONConf); err != nil {
+				log.Fatalf("%s", err)
+			}
+			if err := f.Close(); err != nil {
+				log.Fatalf("%s", err)
+			}
+			config, err := ParsePluginConfig(f.Name())
+			if tc.exp_error_str != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.exp_error_str) {
+					t.Fatalf("err got %v, want to find %q", err, tc.exp_error_str)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := config.CacheBackend, tc.exp_backend; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := config.CacheMaxMemoryBytes, tc.exp_max_mem_bytes; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
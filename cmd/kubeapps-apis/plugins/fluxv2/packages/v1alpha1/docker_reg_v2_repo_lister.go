@@ -18,6 +18,9 @@ import (
 	orasregistryauthv2 "oras.land/oras-go/v2/registry/remote/auth"
 )
 
+// DockerRegistryApiV2RepositoryListerName is the name under which this lister is registered
+const DockerRegistryApiV2RepositoryListerName = "docker-registry-v2"
+
 // This flavor of OCI lister Works with respect to those OCI registry vendors that implement
 // Docker Registry API V2 or OCI Distribution Specification. For example, GitHub (ghcr.io)
 // References:
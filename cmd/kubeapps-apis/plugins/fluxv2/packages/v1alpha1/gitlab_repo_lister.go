@@ -0,0 +1,97 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	log "k8s.io/klog/v2"
+
+	orasregistryauthv2 "oras.land/oras-go/v2/registry/remote/auth"
+)
+
+// GitLabRepositoryListerName is the name under which this lister is registered
+const GitLabRepositoryListerName = "gitlab"
+
+// the maximum page size allowed by the GitLab API
+const gitlabPageSize = 100
+
+// This flavor of OCI lister works with respect to GitLab container registries, which
+// do not support the Docker Registry API V2 catalog endpoint, and lists the
+// repositories of a GitLab project using the GitLab API instead.
+// The OCI registry URL is expected to be of the form
+// oci://registry.<gitlab host>/<group>/<project>, where the GitLab API is served
+// from <gitlab host>. The password of the registry credentials is used as
+// the API token, e.g. a personal or deploy token with the read_registry scope
+// References:
+// - https://docs.gitlab.com/ee/api/container_registry.html
+func NewGitLabRepositoryLister() OCIRepositoryLister {
+	return &gitlabRepositoryLister{}
+}
+
+type gitlabRepositoryLister struct {
+}
+
+type gitlabVersion struct {
+	Version string `json:"version"`
+}
+
+type gitlabRegistryRepository struct {
+	Path string `json:"path"`
+}
+
+// ref https://docs.gitlab.com/ee/api/version.html
+func (l *gitlabRepositoryLister) IsApplicableFor(ociRegistry *OCIRegistry) (bool, error) {
+	log.Infof("+IsApplicableFor(%s)", ociRegistry.url.String())
+
+	version := gitlabVersion{}
+	apiURL := fmt.Sprintf("https://%s/api/v4/version", gitlabApiHost(ociRegistry))
+	if err := ociRegistry.getApiJSON(apiURL, gitlabAuth, &version); err != nil {
+		return false, err
+	}
+	log.Infof("GitLab registry [%s] version: [%s]", ociRegistry.url.String(), version.Version)
+	return version.Version != "", nil
+}
+
+// ref https://docs.gitlab.com/ee/api/container_registry.html#within-a-project
+func (l *gitlabRepositoryLister) ListRepositoryNames(ociRegistry *OCIRegistry) ([]string, error) {
+	log.Infof("+ListRepositoryNames()")
+
+	prefix := strings.Trim(ociRegistry.url.Path, "/")
+	if prefix == "" {
+		return nil, fmt.Errorf("expected a GitLab project in OCI registry URL: [%s]", ociRegistry.url.String())
+	}
+
+	repositoryNames := []string{}
+	for page := 1; ; page++ {
+		repos := []gitlabRegistryRepository{}
+		apiURL := fmt.Sprintf("https://%s/api/v4/projects/%s/registry/repositories?page=%d&per_page=%d",
+			gitlabApiHost(ociRegistry), url.QueryEscape(prefix), page, gitlabPageSize)
+		if err := ociRegistry.getApiJSON(apiURL, gitlabAuth, &repos); err != nil {
+			return nil, err
+		}
+		for _, r := range repos {
+			if strings.HasPrefix(r.Path, prefix+"/") {
+				repositoryNames = append(repositoryNames, r.Path)
+			}
+		}
+		if len(repos) < gitlabPageSize {
+			break
+		}
+	}
+	log.Infof("-ListRepositoryNames(): %s", repositoryNames)
+	return repositoryNames, nil
+}
+
+// by default the GitLab container registry is served from a "registry." subdomain
+// of the GitLab instance, e.g. registry.gitlab.com for gitlab.com
+func gitlabApiHost(ociRegistry *OCIRegistry) string {
+	return strings.TrimPrefix(ociRegistry.url.Host, "registry.")
+}
+
+func gitlabAuth(req *http.Request, cred orasregistryauthv2.Credential) {
+	req.Header.Set("Authorization", "Bearer "+cred.Password)
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	orasregistryauthv2 "oras.land/oras-go/v2/registry/remote/auth"
+)
+
+// newFakeGitLabServer stands up an https server implementing the few GitLab API
+// endpoints the lister uses, with the given repositories in project "group/project"
+func newFakeGitLabServer(t *testing.T, repoPaths []string) *httptest.Server {
+	authorized := func(r *http.Request) bool {
+		return r.Header.Get("Authorization") == "Bearer glpat-token"
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version":"15.1.0-ee","revision":"a2de5d5"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// the project path is expected to be url encoded
+		if r.URL.EscapedPath() != "/api/v4/projects/group%2Fproject/registry/repositories" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		repos := []gitlabRegistryRepository{}
+		for i := (page - 1) * perPage; i < len(repoPaths) && i < page*perPage; i++ {
+			repos = append(repos, gitlabRegistryRepository{Path: repoPaths[i]})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(repos); err != nil {
+			t.Errorf("%+v", err)
+		}
+	})
+	return httptest.NewTLSServer(mux)
+}
+
+func TestGitLabRepositoryLister(t *testing.T) {
+	manyRepoPaths := []string{}
+	for i := 0; i < gitlabPageSize+5; i++ {
+		manyRepoPaths = append(manyRepoPaths, fmt.Sprintf("group/project/repo-%03d", i))
+	}
+
+	testCases := []struct {
+		name          string
+		path          string
+		cred          orasregistryauthv2.Credential
+		repoPaths     []string
+		expectedPaths []string
+		expectedErr   bool
+	}{
+		{
+			name:          "lists the repositories of the project",
+			path:          "/group/project",
+			cred:          orasregistryauthv2.Credential{Username: "foo", Password: "glpat-token"},
+			repoPaths:     []string{"group/project/podinfo", "group/project/nginx"},
+			expectedPaths: []string{"group/project/podinfo", "group/project/nginx"},
+		},
+		{
+			name:          "lists the repositories over multiple pages",
+			path:          "/group/project",
+			cred:          orasregistryauthv2.Credential{Username: "foo", Password: "glpat-token"},
+			repoPaths:     manyRepoPaths,
+			expectedPaths: manyRepoPaths,
+		},
+		{
+			name:        "returns an error for an unknown project",
+			path:        "/group/other",
+			cred:        orasregistryauthv2.Credential{Username: "foo", Password: "glpat-token"},
+			repoPaths:   []string{"group/project/podinfo"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newFakeGitLabServer(t, tc.repoPaths)
+			defer ts.Close()
+
+			lister := NewGitLabRepositoryLister()
+			registry := newTestOCIRegistry(t, ts, tc.path, tc.cred, lister)
+
+			names, err := registry.listRepositoryNames()
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("expected an error, got: %s", names)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := names, tc.expectedPaths; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestGitLabRepositoryListerNotApplicableWithoutToken(t *testing.T) {
+	ts := newFakeGitLabServer(t, []string{"group/project/podinfo"})
+	defer ts.Close()
+
+	lister := NewGitLabRepositoryLister()
+	registry := newTestOCIRegistry(t, ts, "/group/project", orasregistryauthv2.EmptyCredential, lister)
+
+	if ok, _ := lister.IsApplicableFor(registry); ok {
+		t.Errorf("expected gitlab lister not to be applicable without a token")
+	}
+}
+
+func TestGitLabApiHost(t *testing.T) {
+	registry, err := newOCIRegistry("oci://registry.gitlab.com/group/project")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := gitlabApiHost(registry), "gitlab.com"; got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+}
@@ -11,6 +11,8 @@ import (
 	fluxmeta "github.com/fluxcd/pkg/apis/meta"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	fluxplugin "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"google.golang.org/protobuf/types/known/anypb"
 	"helm.sh/helm/v3/pkg/release"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -864,9 +866,30 @@ var (
 		},
 	}
 
+	add_repo_6 = sourcev1.HelmRepository{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       sourcev1.HelmRepositoryKind,
+			APIVersion: sourcev1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "bar",
+			Namespace:       "foo",
+			ResourceVersion: "1",
+			Annotations: map[string]string{
+				namespaceScopedAnnotation: "true",
+			},
+		},
+		Spec: sourcev1.HelmRepositorySpec{
+			URL:      "http://example.com",
+			Interval: metav1.Duration{Duration: 10 * time.Minute},
+		},
+	}
+
 	add_repo_req_1 = &corev1.AddPackageRepositoryRequest{
 		Name:            "bar",
 		Context:         &corev1.Context{Namespace: "foo"},
+		Type:            "helm",
+		Url:             "http://example.com",
 		NamespaceScoped: true,
 	}
 
@@ -1665,6 +1688,44 @@ var (
 		Version: "> 5",
 	})
 
+	flux_helm_release_values_from = &helmv2.HelmRelease{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       helmv2.HelmReleaseKind,
+			APIVersion: helmv2.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-podinfo",
+			Namespace:       "test",
+			ResourceVersion: "1",
+		},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart: "podinfo",
+					SourceRef: helmv2.CrossNamespaceObjectReference{
+						Kind:      sourcev1.HelmRepositoryKind,
+						Name:      "podinfo",
+						Namespace: "namespace-1",
+					},
+				},
+			},
+			Interval: metav1.Duration{Duration: 1 * time.Minute},
+			ValuesFrom: []helmv2.ValuesReference{
+				{
+					Kind: "ConfigMap",
+					Name: "podinfo-values",
+				},
+				{
+					Kind:       "Secret",
+					Name:       "podinfo-secret",
+					ValuesKey:  "message",
+					TargetPath: "ui.message",
+					Optional:   true,
+				},
+			},
+		},
+	}
+
 	flux_helm_release_reconcile_options = &helmv2.HelmRelease{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       helmv2.HelmReleaseKind,
@@ -1852,6 +1913,12 @@ var (
 		},
 	}
 
+	podinfo_6_0_0_chart_spec = testSpecChartWithFile{
+		name:     "podinfo",
+		tgzFile:  testTgz("podinfo-6.0.0.tgz"),
+		revision: "6.0.0",
+	}
+
 	redis_charts_spec = []testSpecChartWithFile{
 		{
 			name:     "redis",
@@ -1998,6 +2065,42 @@ var (
 		Values:              "# Default values for podinfo.\n---\nui:\n  message: what we do in the shadows",
 	}
 
+	create_package_values_not_satisfying_schema_req = &corev1.CreateInstalledPackageRequest{
+		AvailablePackageRef: availableRef("bitnami-1/redis", "namespace-1"),
+		Name:                "my-redis",
+		TargetContext:       &corev1.Context{Namespace: "test"},
+		Values:              "{\"architecture\": 1}",
+	}
+
+	create_package_values_from_req = &corev1.CreateInstalledPackageRequest{
+		AvailablePackageRef: availableRef("podinfo/podinfo", "namespace-1"),
+		Name:                "my-podinfo",
+		TargetContext:       &corev1.Context{Namespace: "test"},
+		CustomDetail: fluxInstallCustomDetail(
+			&fluxplugin.ValuesReference{
+				Kind: "ConfigMap",
+				Name: "podinfo-values",
+			},
+			&fluxplugin.ValuesReference{
+				Kind:       "Secret",
+				Name:       "podinfo-secret",
+				ValuesKey:  "message",
+				TargetPath: "ui.message",
+				Optional:   true,
+			}),
+	}
+
+	create_package_values_from_bad_kind_req = &corev1.CreateInstalledPackageRequest{
+		AvailablePackageRef: availableRef("podinfo/podinfo", "namespace-1"),
+		Name:                "my-podinfo",
+		TargetContext:       &corev1.Context{Namespace: "test"},
+		CustomDetail: fluxInstallCustomDetail(
+			&fluxplugin.ValuesReference{
+				Kind: "Deployment",
+				Name: "podinfo",
+			}),
+	}
+
 	create_package_for_test_of_upgrade_policy = &corev1.CreateInstalledPackageRequest{
 		AvailablePackageRef: availableRef("podinfo/podinfo", "namespace-1"),
 		Name:                "my-podinfo",
@@ -2464,6 +2567,20 @@ var (
 		},
 	}
 
+	get_repo_detail_resp_17 = &corev1.GetPackageRepositoryDetailResponse{
+		Detail: &corev1.PackageRepositoryDetail{
+			PackageRepoRef:  get_repo_detail_package_resp_ref,
+			Name:            "repo-1",
+			Description:     "",
+			NamespaceScoped: true,
+			Type:            "helm",
+			Url:             "https://example.repo.com/charts",
+			Interval:        "1m",
+			Auth:            &corev1.PackageRepositoryAuth{PassCredentials: false},
+			Status:          podinfo_repo_status_2,
+		},
+	}
+
 	get_summaries_repo_1 = newRepo("bar", "foo",
 		&sourcev1.HelmRepositorySpec{
 			URL:      "http://example.com",
@@ -3091,6 +3208,16 @@ var (
 		}
 	}
 
+	fluxInstallCustomDetail = func(valuesFrom ...*fluxplugin.ValuesReference) *anypb.Any {
+		customDetail, err := anypb.New(&fluxplugin.FluxPackageInstallCustomDetail{
+			ValuesFrom: valuesFrom,
+		})
+		if err != nil {
+			panic(err)
+		}
+		return customDetail
+	}
+
 	targetContext = func(namespace string) *corev1.Context {
 		return &corev1.Context{
 			// note that Namespace is just the prefix - the actual name will
@@ -0,0 +1,94 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	log "k8s.io/klog/v2"
+
+	orasregistryauthv2 "oras.land/oras-go/v2/registry/remote/auth"
+)
+
+// HarborRepositoryListerName is the name under which this lister is registered
+const HarborRepositoryListerName = "harbor"
+
+// the maximum page size allowed by the Harbor API
+const harborPageSize = 100
+
+// This flavor of OCI lister works with respect to Harbor registries, which
+// do not support the Docker Registry API V2 catalog endpoint for non-admin users,
+// and lists the repositories of a Harbor project using the Harbor API instead.
+// The OCI registry URL is expected to be of the form oci://<harbor host>/<project>[/path]
+// References:
+// - https://goharbor.io/docs/main/build-customize-contribute/configure-swagger/
+// - https://github.com/goharbor/harbor/blob/main/api/v2.0/swagger.yaml
+func NewHarborRepositoryLister() OCIRepositoryLister {
+	return &harborRepositoryLister{}
+}
+
+type harborRepositoryLister struct {
+}
+
+type harborSystemInfo struct {
+	HarborVersion string `json:"harbor_version"`
+}
+
+type harborRepository struct {
+	Name string `json:"name"`
+}
+
+// ref https://github.com/goharbor/harbor/blob/main/api/v2.0/swagger.yaml
+// see "/systeminfo", which is available to anonymous users
+func (l *harborRepositoryLister) IsApplicableFor(ociRegistry *OCIRegistry) (bool, error) {
+	log.Infof("+IsApplicableFor(%s)", ociRegistry.url.String())
+
+	info := harborSystemInfo{}
+	apiURL := fmt.Sprintf("https://%s/api/v2.0/systeminfo", ociRegistry.url.Host)
+	if err := ociRegistry.getApiJSON(apiURL, nil, &info); err != nil {
+		return false, err
+	}
+	log.Infof("Harbor registry [%s] version: [%s]", ociRegistry.url.String(), info.HarborVersion)
+	return info.HarborVersion != "", nil
+}
+
+// ref https://github.com/goharbor/harbor/blob/main/api/v2.0/swagger.yaml
+// see "/projects/{project_name}/repositories"
+func (l *harborRepositoryLister) ListRepositoryNames(ociRegistry *OCIRegistry) ([]string, error) {
+	log.Infof("+ListRepositoryNames()")
+
+	prefix := strings.Trim(ociRegistry.url.Path, "/")
+	if prefix == "" {
+		return nil, fmt.Errorf("expected a Harbor project in OCI registry URL: [%s]", ociRegistry.url.String())
+	}
+	project := strings.SplitN(prefix, "/", 2)[0]
+
+	repositoryNames := []string{}
+	for page := 1; ; page++ {
+		repos := []harborRepository{}
+		apiURL := fmt.Sprintf("https://%s/api/v2.0/projects/%s/repositories?page=%d&page_size=%d",
+			ociRegistry.url.Host, url.PathEscape(project), page, harborPageSize)
+		if err := ociRegistry.getApiJSON(apiURL, harborAuth, &repos); err != nil {
+			return nil, err
+		}
+		for _, r := range repos {
+			// harbor repository names include the project name
+			if strings.HasPrefix(r.Name, prefix+"/") {
+				repositoryNames = append(repositoryNames, r.Name)
+			}
+		}
+		if len(repos) < harborPageSize {
+			break
+		}
+	}
+	log.Infof("-ListRepositoryNames(): %s", repositoryNames)
+	return repositoryNames, nil
+}
+
+// harbor API accepts the same basic auth credentials as the registry itself
+func harborAuth(req *http.Request, cred orasregistryauthv2.Credential) {
+	req.SetBasicAuth(cred.Username, cred.Password)
+}
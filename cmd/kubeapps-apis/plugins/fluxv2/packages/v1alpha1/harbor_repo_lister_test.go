@@ -0,0 +1,138 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	orasregistryauthv2 "oras.land/oras-go/v2/registry/remote/auth"
+)
+
+// newFakeHarborServer stands up an https server implementing the few Harbor API
+// endpoints the lister uses, with the given repositories in project "test-oci-1"
+func newFakeHarborServer(t *testing.T, repoNames []string) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2.0/systeminfo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"harbor_version":"v2.5.0-1ce73f6e"}`)
+	})
+	mux.HandleFunc("/api/v2.0/projects/test-oci-1/repositories", func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "foo" || pass != "bar" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+		repos := []harborRepository{}
+		for i := (page - 1) * pageSize; i < len(repoNames) && i < page*pageSize; i++ {
+			repos = append(repos, harborRepository{Name: repoNames[i]})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(repos); err != nil {
+			t.Errorf("%+v", err)
+		}
+	})
+	return httptest.NewTLSServer(mux)
+}
+
+func TestHarborRepositoryLister(t *testing.T) {
+	manyRepoNames := []string{}
+	for i := 0; i < harborPageSize+5; i++ {
+		manyRepoNames = append(manyRepoNames, fmt.Sprintf("test-oci-1/repo-%03d", i))
+	}
+
+	testCases := []struct {
+		name          string
+		path          string
+		cred          orasregistryauthv2.Credential
+		repoNames     []string
+		expectedNames []string
+		expectedErr   bool
+	}{
+		{
+			name:          "lists the repositories of the project",
+			path:          "/test-oci-1",
+			cred:          orasregistryauthv2.Credential{Username: "foo", Password: "bar"},
+			repoNames:     []string{"test-oci-1/podinfo", "test-oci-1/nginx"},
+			expectedNames: []string{"test-oci-1/podinfo", "test-oci-1/nginx"},
+		},
+		{
+			name:          "lists only the repositories under the path of the registry url",
+			path:          "/test-oci-1/charts",
+			cred:          orasregistryauthv2.Credential{Username: "foo", Password: "bar"},
+			repoNames:     []string{"test-oci-1/charts/podinfo", "test-oci-1/images/podinfo"},
+			expectedNames: []string{"test-oci-1/charts/podinfo"},
+		},
+		{
+			name:          "lists the repositories over multiple pages",
+			path:          "/test-oci-1",
+			cred:          orasregistryauthv2.Credential{Username: "foo", Password: "bar"},
+			repoNames:     manyRepoNames,
+			expectedNames: manyRepoNames,
+		},
+		{
+			name:        "returns an error when the credentials are not valid",
+			path:        "/test-oci-1",
+			cred:        orasregistryauthv2.Credential{Username: "foo", Password: "wrong"},
+			repoNames:   []string{"test-oci-1/podinfo"},
+			expectedErr: true,
+		},
+		{
+			name:        "returns an error when the url has no project",
+			path:        "",
+			cred:        orasregistryauthv2.Credential{Username: "foo", Password: "bar"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newFakeHarborServer(t, tc.repoNames)
+			defer ts.Close()
+
+			lister := NewHarborRepositoryLister()
+			registry := newTestOCIRegistry(t, ts, tc.path, tc.cred, lister)
+
+			if ok, err := lister.IsApplicableFor(registry); err != nil {
+				t.Fatalf("%+v", err)
+			} else if !ok {
+				t.Fatalf("expected harbor lister to be applicable")
+			}
+
+			names, err := registry.listRepositoryNames()
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("expected an error, got: %s", names)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := names, tc.expectedNames; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestHarborRepositoryListerNotApplicable(t *testing.T) {
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	lister := NewHarborRepositoryLister()
+	registry := newTestOCIRegistry(t, ts, "/test-oci-1", orasregistryauthv2.EmptyCredential, lister)
+
+	if ok, _ := lister.IsApplicableFor(registry); ok {
+		t.Errorf("expected harbor lister not to be applicable")
+	}
+	if _, err := registry.listRepositoryNames(); err == nil {
+		t.Errorf("expected an error when no lister is applicable")
+	}
+}
@@ -1190,7 +1190,7 @@ func redisReceiveNotificationsLoop(t *testing.T, ch <-chan *redis.Message, sem *
 		}
 		t.Logf("Redis event: [%v]: [%v]", event.Channel, event.Payload)
 		if event.Channel == "__keyevent@0__:set" {
-			if strings.HasPrefix(event.Payload, "helmrepositories:default:bitnami-") {
+			if strings.HasPrefix(event.Payload, "helmrepositories:default:default:bitnami-") {
 				reposAdded.Insert(event.Payload)
 				// I am keeping track of charts being synced in the cache so that I only
 				// start to load repository N+1 after completely done with N, meaning waiting until
@@ -1199,9 +1199,9 @@ func redisReceiveNotificationsLoop(t *testing.T, ch <-chan *redis.Message, sem *
 				// that a repo AND its charts are completely synced before proceeding. To be
 				// continued...
 				chartsLeftToSync += totalBitnamiCharts
-			} else if strings.HasPrefix(event.Payload, "helmcharts:default:bitnami-") {
-				chartID := strings.Split(event.Payload, ":")[2]
-				repoKey := "helmrepositories:default:" + strings.Split(chartID, "/")[0]
+			} else if strings.HasPrefix(event.Payload, "helmcharts:default:default:bitnami-") {
+				chartID := strings.Split(event.Payload, ":")[3]
+				repoKey := "helmrepositories:default:default:" + strings.Split(chartID, "/")[0]
 				if reposAdded.Has(repoKey) {
 					chartsLeftToSync--
 				}
@@ -1212,7 +1212,7 @@ func redisReceiveNotificationsLoop(t *testing.T, ch <-chan *redis.Message, sem *
 				sem.Release(1)
 			}
 		} else if event.Channel == "__keyevent@0__:evicted" &&
-			strings.HasPrefix(event.Payload, "helmrepositories:default:bitnami-") {
+			strings.HasPrefix(event.Payload, "helmrepositories:default:default:bitnami-") {
 			evictedRepos.Insert(event.Payload)
 			if reposAdded.Len() > 0 && sem != nil {
 				// signal to the main goroutine it's okay to proceed to load the next copy
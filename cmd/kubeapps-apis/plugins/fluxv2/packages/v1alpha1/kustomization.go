@@ -0,0 +1,533 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	log "k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	kustomizationKind     = "Kustomization"
+	kustomizationListKind = "KustomizationList"
+
+	// The identifiers of the installed packages for Kustomizations have this prefix,
+	// which can't be part of the name of a kubernetes object, to tell them apart
+	// from those for HelmReleases, which often have the same names
+	kustomizationIdentifierPrefix = "kustomization:"
+
+	// the source-controller API the plugin depends on predates OCIRepositories
+	ociRepositoryKind = "OCIRepository"
+
+	// the reason of the ready condition of a Kustomization waiting for the
+	// Kustomizations it depends on
+	dependencyNotReadyReason = "DependencyNotReady"
+)
+
+// kustomization mirrors the fields of a flux Kustomization
+// (kustomize.toolkit.fluxcd.io/v1beta2) that the plugin reads. The
+// kustomize-controller API is not a dependency of the plugin, so Kustomizations
+// are read and written as unstructured objects. They are only converted to this
+// type for reading, so that the fields of a Kustomization unknown to the plugin
+// are kept as they are on updates.
+// See https://fluxcd.io/docs/components/kustomize/kustomization/
+type kustomization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              kustomizationSpec   `json:"spec,omitempty"`
+	Status            kustomizationStatus `json:"status,omitempty"`
+}
+
+type kustomizationSpec struct {
+	SourceRef          kustomizationSourceRef `json:"sourceRef"`
+	Path               string                 `json:"path,omitempty"`
+	Interval           metav1.Duration        `json:"interval"`
+	Prune              bool                   `json:"prune"`
+	Suspend            bool                   `json:"suspend,omitempty"`
+	ServiceAccountName string                 `json:"serviceAccountName,omitempty"`
+	TargetNamespace    string                 `json:"targetNamespace,omitempty"`
+}
+
+type kustomizationSourceRef struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+type kustomizationStatus struct {
+	ObservedGeneration    int64                   `json:"observedGeneration,omitempty"`
+	Conditions            []metav1.Condition      `json:"conditions,omitempty"`
+	LastAppliedRevision   string                  `json:"lastAppliedRevision,omitempty"`
+	LastAttemptedRevision string                  `json:"lastAttemptedRevision,omitempty"`
+	Inventory             *kustomizationInventory `json:"inventory,omitempty"`
+}
+
+// kustomizationInventory lists the resources applied by a Kustomization
+type kustomizationInventory struct {
+	Entries []kustomizationInventoryEntry `json:"entries"`
+}
+
+type kustomizationInventoryEntry struct {
+	// ID is the string representation of the kubernetes object metadata,
+	// in the format '<namespace>_<name>_<group>_<kind>'
+	ID string `json:"id"`
+	// Version is the API version of the kubernetes object kind
+	Version string `json:"v"`
+}
+
+func kustomizationGvk() schema.GroupVersionKind {
+	return common.GetKustomizationsGvr().GroupVersion().WithKind(kustomizationKind)
+}
+
+func newUnstructuredKustomization() *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(kustomizationGvk())
+	return u
+}
+
+func kustomizationFromUnstructured(u *unstructured.Unstructured) (*kustomization, error) {
+	var k kustomization
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &k); err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to read the Kustomization [%s/%s] due to: %v", u.GetNamespace(), u.GetName(), err)
+	}
+	return &k, nil
+}
+
+// kustomizationIdentifier returns the identifier of the installed package for
+// the Kustomization with the given name
+func kustomizationIdentifier(name string) string {
+	return kustomizationIdentifierPrefix + name
+}
+
+// kustomizationNameFromIdentifier returns the name of the Kustomization of an
+// installed package, and false if the installed package is for a HelmRelease
+func kustomizationNameFromIdentifier(identifier string) (string, bool) {
+	if !strings.HasPrefix(identifier, kustomizationIdentifierPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(identifier, kustomizationIdentifierPrefix), true
+}
+
+// namespace maybe "", in which case Kustomizations from all namespaces are returned.
+// No Kustomizations are returned when the kustomize-controller is not installed or
+// when the caller is not allowed to list them, e.g. a user who may only manage
+// HelmReleases
+func (s *Server) listKustomizationsInCluster(ctx context.Context, cluster, namespace string) ([]kustomization, error) {
+	client, err := s.clientGetter.ControllerRuntime(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(common.GetKustomizationsGvr().GroupVersion().WithKind(kustomizationListKind))
+	if err = client.List(ctx, list, ctrlclient.InNamespace(namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		} else if errors.IsForbidden(err) {
+			log.Infof("Skipping Kustomizations in namespace [%s] of cluster [%s] due to: %v", namespace, cluster, err)
+			return nil, nil
+		}
+		return nil, statuserror.FromK8sError("list", kustomizationKind, namespace+"/*", err)
+	}
+
+	kustomizations := make([]kustomization, 0, len(list.Items))
+	for i := range list.Items {
+		k, err := kustomizationFromUnstructured(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		kustomizations = append(kustomizations, *k)
+	}
+	return kustomizations, nil
+}
+
+func (s *Server) getKustomizationInCluster(ctx context.Context, cluster string, key types.NamespacedName) (*kustomization, error) {
+	client, err := s.clientGetter.ControllerRuntime(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	u := newUnstructuredKustomization()
+	if err = client.Get(ctx, key, u); err != nil {
+		return nil, statuserror.FromK8sError("get", kustomizationKind, key.String(), err)
+	}
+	return kustomizationFromUnstructured(u)
+}
+
+// updateKustomizationInCluster applies the given changes to the latest version of
+// a Kustomization and updates it, applying them again to the then latest version
+// when the kustomize-controller has modified the Kustomization in the meantime.
+func (s *Server) updateKustomizationInCluster(ctx context.Context, cluster string, key types.NamespacedName, applyChanges func(u *unstructured.Unstructured) error) (*kustomization, error) {
+	client, err := s.clientGetter.ControllerRuntime(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var u *unstructured.Unstructured
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		u = newUnstructuredKustomization()
+		if err := client.Get(ctx, key, u); err != nil {
+			return statuserror.FromK8sError("get", kustomizationKind, key.String(), err)
+		}
+		if err := applyChanges(u); err != nil {
+			return err
+		}
+		if err := client.Update(ctx, u); err != nil {
+			if errors.IsConflict(err) {
+				// retried
+				return err
+			}
+			return statuserror.FromK8sError("update", kustomizationKind, key.String(), err)
+		}
+		return nil
+	})
+	if errors.IsConflict(err) {
+		return nil, statuserror.FromK8sError("update", kustomizationKind, key.String(), err)
+	} else if err != nil {
+		return nil, err
+	}
+	return kustomizationFromUnstructured(u)
+}
+
+func installedPkgSummaryFromKustomization(cluster string, k kustomization) *corev1.InstalledPackageSummary {
+	return &corev1.InstalledPackageSummary{
+		InstalledPackageRef: kustomizationInstalledPackageRef(cluster, k.Namespace, k.Name),
+		Name:                k.Name,
+		PkgDisplayName:      k.Name,
+		ShortDescription:    kustomizationDescription(&k),
+		CurrentVersion: &corev1.PackageAppVersion{
+			PkgVersion: k.Status.LastAppliedRevision,
+		},
+		Status: kustomizationInstalledPackageStatus(&k),
+	}
+}
+
+func (s *Server) kustomizationDetail(ctx context.Context, cluster string, key types.NamespacedName) (*corev1.InstalledPackageDetail, error) {
+	k, err := s.getKustomizationInCluster(ctx, cluster, key)
+	if err != nil {
+		return nil, err
+	}
+
+	log.V(4).Infof("kustomizationDetail:\n[%s]", common.PrettyPrint(k))
+
+	customDetail, err := anypb.New(&v1alpha1.FluxKustomizationCustomDetail{
+		SourceRef: &v1alpha1.KustomizationSourceReference{
+			Kind:      k.Spec.SourceRef.Kind,
+			Name:      k.Spec.SourceRef.Name,
+			Namespace: k.Spec.SourceRef.Namespace,
+		},
+		Path:                  k.Spec.Path,
+		TargetNamespace:       k.Spec.TargetNamespace,
+		Prune:                 k.Spec.Prune,
+		LastAppliedRevision:   k.Status.LastAppliedRevision,
+		LastAttemptedRevision: k.Status.LastAttemptedRevision,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// same as for releases, the last attempted revision is the back-up option when
+	// the reconciliation is in progress or has failed
+	revision := k.Status.LastAppliedRevision
+	if revision == "" {
+		revision = k.Status.LastAttemptedRevision
+	}
+
+	return &corev1.InstalledPackageDetail{
+		InstalledPackageRef: kustomizationInstalledPackageRef(cluster, key.Namespace, key.Name),
+		Name:                key.Name,
+		CurrentVersion: &corev1.PackageAppVersion{
+			PkgVersion: revision,
+		},
+		ReconciliationOptions: &corev1.ReconciliationOptions{
+			Interval:           pkgutils.FromDuration(&k.Spec.Interval),
+			Suspend:            k.Spec.Suspend,
+			ServiceAccountName: k.Spec.ServiceAccountName,
+		},
+		Status:       kustomizationInstalledPackageStatus(k),
+		CustomDetail: customDetail,
+	}, nil
+}
+
+// kustomizationResourceRefs returns the references to the resources applied by
+// a Kustomization, as listed in its inventory
+func (s *Server) kustomizationResourceRefs(ctx context.Context, cluster string, key types.NamespacedName) ([]*corev1.ResourceRef, error) {
+	k, err := s.getKustomizationInCluster(ctx, cluster, key)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := []*corev1.ResourceRef{}
+	if k.Status.Inventory == nil {
+		return refs, nil
+	}
+	for _, entry := range k.Status.Inventory.Entries {
+		ref, err := resourceRefFromInventoryEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+func (s *Server) createKustomization(ctx context.Context, cluster string, request *v1alpha1.CreateKustomizationRequest) (*corev1.InstalledPackageReference, error) {
+	sourceRef := request.GetSourceRef()
+	if kind := sourceRef.GetKind(); kind != sourcev1.GitRepositoryKind && kind != ociRepositoryKind {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported kind of source: [%s], must be one of [%s, %s]",
+			kind, sourcev1.GitRepositoryKind, ociRepositoryKind)
+	}
+	if sourceRef.GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "no request SourceRef name provided")
+	}
+
+	interval := defaultReconcileInterval
+	if request.GetReconciliationOptions().GetInterval() != "" {
+		reconcileInterval, err := pkgutils.ToDuration(request.ReconciliationOptions.Interval)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "the reconciliation interval is invalid: %v", err)
+		}
+		interval = *reconcileInterval
+	}
+
+	path := request.Path
+	if path == "" {
+		path = "./"
+	}
+
+	fluxSourceRef := map[string]interface{}{
+		"kind": sourceRef.Kind,
+		"name": sourceRef.Name,
+	}
+	if sourceRef.Namespace != "" {
+		fluxSourceRef["namespace"] = sourceRef.Namespace
+	}
+	spec := map[string]interface{}{
+		"sourceRef": fluxSourceRef,
+		"path":      path,
+		"prune":     request.Prune,
+		"interval":  interval.Duration.String(),
+	}
+	if request.TargetNamespace != "" {
+		spec["targetNamespace"] = request.TargetNamespace
+	}
+	if request.GetReconciliationOptions().GetSuspend() {
+		spec["suspend"] = true
+	}
+	if sa := request.GetReconciliationOptions().GetServiceAccountName(); sa != "" {
+		spec["serviceAccountName"] = sa
+	}
+
+	u := newUnstructuredKustomization()
+	u.SetName(request.Name)
+	u.SetNamespace(request.TargetContext.Namespace)
+	u.Object["spec"] = spec
+
+	client, err := s.clientGetter.ControllerRuntime(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	key := types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}
+	if err = client.Create(ctx, u); err != nil {
+		return nil, statuserror.FromK8sError("create", kustomizationKind, key.String(), err)
+	}
+
+	log.V(4).Infof("Created kustomization: %s", common.PrettyPrint(u))
+
+	return kustomizationInstalledPackageRef(cluster, key.Namespace, key.Name), nil
+}
+
+// updateKustomization updates the reconciliation options of a Kustomization,
+// which are the only options of an installed package that apply to it
+func (s *Server) updateKustomization(ctx context.Context, cluster string, key types.NamespacedName, reconcile *corev1.ReconciliationOptions) (*corev1.InstalledPackageReference, error) {
+	_, err := s.updateKustomizationInCluster(ctx, cluster, key, func(u *unstructured.Unstructured) error {
+		interval := defaultReconcileInterval
+		if reconcile.GetInterval() != "" {
+			reconcileInterval, err := pkgutils.ToDuration(reconcile.Interval)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "the reconciliation interval is invalid: %v", err)
+			}
+			interval = *reconcileInterval
+		}
+		if err := unstructured.SetNestedField(u.Object, interval.Duration.String(), "spec", "interval"); err != nil {
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+		if err := unstructured.SetNestedField(u.Object, reconcile.GetSuspend(), "spec", "suspend"); err != nil {
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+		if sa := reconcile.GetServiceAccountName(); sa != "" {
+			if err := unstructured.SetNestedField(u.Object, sa, "spec", "serviceAccountName"); err != nil {
+				return status.Errorf(codes.Internal, "%v", err)
+			}
+		} else {
+			unstructured.RemoveNestedField(u.Object, "spec", "serviceAccountName")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.V(4).Infof("Updated kustomization: [%s]", key)
+
+	return kustomizationInstalledPackageRef(cluster, key.Namespace, key.Name), nil
+}
+
+func (s *Server) deleteKustomization(ctx context.Context, cluster string, key types.NamespacedName) error {
+	client, err := s.clientGetter.ControllerRuntime(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	log.V(4).Infof("Deleting kustomization: [%s]", key)
+
+	u := newUnstructuredKustomization()
+	u.SetName(key.Name)
+	u.SetNamespace(key.Namespace)
+	if err = client.Delete(ctx, u); err != nil {
+		return statuserror.FromK8sError("delete", kustomizationKind, key.String(), err)
+	}
+	return nil
+}
+
+// setKustomizationSuspended suspends or resumes the reconciliation of a Kustomization
+func (s *Server) setKustomizationSuspended(ctx context.Context, cluster string, key types.NamespacedName, suspend bool) (*kustomization, error) {
+	k, err := s.updateKustomizationInCluster(ctx, cluster, key, func(u *unstructured.Unstructured) error {
+		if err := unstructured.SetNestedField(u.Object, suspend, "spec", "suspend"); err != nil {
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.V(4).Infof("Set suspend of kustomization [%s] to [%t]", key, suspend)
+	return k, nil
+}
+
+// reconcileKustomization requests that the kustomize-controller reconciles the
+// Kustomization now, rather than at the next interval.
+func (s *Server) reconcileKustomization(ctx context.Context, cluster string, key types.NamespacedName) (*kustomization, error) {
+	k, err := s.updateKustomizationInCluster(ctx, cluster, key, func(u *unstructured.Unstructured) error {
+		// flux ignores reconcile requests for suspended resources
+		if suspended, _, _ := unstructured.NestedBool(u.Object, "spec", "suspend"); suspended {
+			return status.Errorf(codes.FailedPrecondition, "the Kustomization [%s] is suspended", key)
+		}
+		annotations := u.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[fluxmeta.ReconcileRequestAnnotation] = time.Now().Format(time.RFC3339Nano)
+		u.SetAnnotations(annotations)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.V(4).Infof("Requested reconciliation of kustomization: [%s]", key)
+	return k, nil
+}
+
+func kustomizationInstalledPackageRef(cluster, namespace, name string) *corev1.InstalledPackageReference {
+	return &corev1.InstalledPackageReference{
+		Context: &corev1.Context{
+			Namespace: namespace,
+			Cluster:   cluster,
+		},
+		Identifier: kustomizationIdentifier(name),
+		Plugin:     GetPluginDetail(),
+	}
+}
+
+func kustomizationDescription(k *kustomization) string {
+	sourceNamespace := k.Spec.SourceRef.Namespace
+	if sourceNamespace == "" {
+		sourceNamespace = k.Namespace
+	}
+	path := k.Spec.Path
+	if path == "" {
+		path = "./"
+	}
+	return fmt.Sprintf("Kustomization of %s from %s %s/%s",
+		path, k.Spec.SourceRef.Kind, sourceNamespace, k.Spec.SourceRef.Name)
+}
+
+// isKustomizationReady returns the same as isHelmReleaseReady, but for a Kustomization,
+// whose ready condition is false while the reconciliation is in progress, as well
+// as when it has failed
+func isKustomizationReady(k *kustomization) (ready bool, status corev1.InstalledPackageStatus_StatusReason, userReason string) {
+	if k.Generation <= 0 || k.Generation != k.Status.ObservedGeneration {
+		return false, corev1.InstalledPackageStatus_STATUS_REASON_PENDING, ""
+	}
+
+	readyCond := meta.FindStatusCondition(k.Status.Conditions, fluxmeta.ReadyCondition)
+	if readyCond == nil {
+		return false, corev1.InstalledPackageStatus_STATUS_REASON_PENDING, ""
+	}
+	userReason = readyCond.Reason
+	if readyCond.Message != "" {
+		userReason += ": " + readyCond.Message
+	}
+	switch {
+	case readyCond.Status == metav1.ConditionTrue:
+		return true, corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED, userReason
+	case readyCond.Status == metav1.ConditionFalse &&
+		readyCond.Reason != fluxmeta.ProgressingReason &&
+		readyCond.Reason != dependencyNotReadyReason:
+		return false, corev1.InstalledPackageStatus_STATUS_REASON_FAILED, userReason
+	default:
+		return false, corev1.InstalledPackageStatus_STATUS_REASON_PENDING, userReason
+	}
+}
+
+func kustomizationInstalledPackageStatus(k *kustomization) *corev1.InstalledPackageStatus {
+	ready, reason, userReason := isKustomizationReady(k)
+	return &corev1.InstalledPackageStatus{
+		Ready:      ready,
+		Reason:     reason,
+		UserReason: userReason,
+	}
+}
+
+// resourceRefFromInventoryEntry returns the reference to the resource of an entry
+// of the inventory of a Kustomization, see
+// https://fluxcd.io/docs/components/kustomize/kustomization/#inventory
+func resourceRefFromInventoryEntry(entry kustomizationInventoryEntry) (*corev1.ResourceRef, error) {
+	// none of the parts may contain '_', and the namespace is empty for
+	// cluster-scoped resources
+	parts := strings.Split(entry.ID, "_")
+	if len(parts) != 4 || parts[1] == "" || parts[3] == "" {
+		return nil, status.Errorf(codes.Internal, "invalid inventory entry: [%s]", entry.ID)
+	}
+	apiVersion := schema.GroupVersion{Group: parts[2], Version: entry.Version}.String()
+	return &corev1.ResourceRef{
+		ApiVersion: apiVersion,
+		Kind:       parts[3],
+		Name:       parts[1],
+		Namespace:  parts[0],
+	}, nil
+}
@@ -0,0 +1,376 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	fluxplugin "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestKustomizationInstalledPackage(t *testing.T) {
+	s, _, err := newServerWithChartsAndReleases(t, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	ctx := context.Background()
+
+	createResp, err := s.CreateKustomization(ctx, &fluxplugin.CreateKustomizationRequest{
+		TargetContext: &corev1.Context{Namespace: "test"},
+		Name:          "podinfo",
+		SourceRef: &fluxplugin.KustomizationSourceReference{
+			Kind:      sourcev1.GitRepositoryKind,
+			Name:      "podinfo",
+			Namespace: "flux-system",
+		},
+		Path:  "./kustomize",
+		Prune: true,
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expectedRef := &corev1.InstalledPackageReference{
+		Context:    &corev1.Context{Namespace: "test", Cluster: KubeappsCluster},
+		Identifier: "kustomization:podinfo",
+		Plugin:     GetPluginDetail(),
+	}
+	opts := cmpopts.IgnoreUnexported(corev1.InstalledPackageReference{}, corev1.Context{}, plugins.Plugin{})
+	if got, want := createResp.InstalledPackageRef, expectedRef; !cmp.Equal(want, got, opts) {
+		t.Fatalf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	// the kustomize-controller applies the manifests
+	key := types.NamespacedName{Namespace: "test", Name: "podinfo"}
+	setKustomizationStatus(t, s, key, map[string]interface{}{
+		"observedGeneration":  int64(1),
+		"lastAppliedRevision": "master/132f4e719209eb10b9485302f8593fc0e680f4fc",
+		"conditions": []interface{}{
+			map[string]interface{}{
+				"type":               fluxmeta.ReadyCondition,
+				"status":             string(metav1.ConditionTrue),
+				"reason":             "ReconciliationSucceeded",
+				"message":            "Applied revision: master/132f4e719209eb10b9485302f8593fc0e680f4fc",
+				"lastTransitionTime": "2022-06-20T12:00:00Z",
+			},
+		},
+		"inventory": map[string]interface{}{
+			"entries": []interface{}{
+				map[string]interface{}{"id": "test_podinfo_apps_Deployment", "v": "v1"},
+				map[string]interface{}{"id": "test_podinfo__Service", "v": "v1"},
+			},
+		},
+	})
+
+	summariesResp, err := s.GetInstalledPackageSummaries(ctx, &corev1.GetInstalledPackageSummariesRequest{
+		Context: &corev1.Context{Namespace: "test"},
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expectedSummaries := []*corev1.InstalledPackageSummary{
+		{
+			InstalledPackageRef: expectedRef,
+			Name:                "podinfo",
+			PkgDisplayName:      "podinfo",
+			ShortDescription:    "Kustomization of ./kustomize from GitRepository flux-system/podinfo",
+			CurrentVersion: &corev1.PackageAppVersion{
+				PkgVersion: "master/132f4e719209eb10b9485302f8593fc0e680f4fc",
+			},
+			Status: &corev1.InstalledPackageStatus{
+				Ready:      true,
+				Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+				UserReason: "ReconciliationSucceeded: Applied revision: master/132f4e719209eb10b9485302f8593fc0e680f4fc",
+			},
+		},
+	}
+	opts = cmpopts.IgnoreUnexported(
+		corev1.InstalledPackageSummary{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		plugins.Plugin{},
+		corev1.PackageAppVersion{},
+		corev1.InstalledPackageStatus{})
+	if got, want := summariesResp.InstalledPackageSummaries, expectedSummaries; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	detailResp, err := s.GetInstalledPackageDetail(ctx, &corev1.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: expectedRef,
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := detailResp.InstalledPackageDetail.ReconciliationOptions.Interval, "1m"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	customDetail := &fluxplugin.FluxKustomizationCustomDetail{}
+	if err = detailResp.InstalledPackageDetail.CustomDetail.UnmarshalTo(customDetail); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := customDetail.Path, "./kustomize"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := customDetail.LastAppliedRevision, "master/132f4e719209eb10b9485302f8593fc0e680f4fc"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+
+	refsResp, err := s.GetInstalledPackageResourceRefs(ctx, &corev1.GetInstalledPackageResourceRefsRequest{
+		InstalledPackageRef: expectedRef,
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expectedRefs := []*corev1.ResourceRef{
+		{ApiVersion: "apps/v1", Kind: "Deployment", Name: "podinfo", Namespace: "test"},
+		{ApiVersion: "v1", Kind: "Service", Name: "podinfo", Namespace: "test"},
+	}
+	opts = cmpopts.IgnoreUnexported(corev1.ResourceRef{})
+	if got, want := refsResp.ResourceRefs, expectedRefs; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	suspendResp, err := s.SuspendInstalledPackage(ctx, &fluxplugin.SuspendInstalledPackageRequest{InstalledPackageRef: expectedRef})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !suspendResp.Suspended {
+		t.Errorf("expected kustomization [%s] to be suspended", key)
+	}
+	resumeResp, err := s.ResumeInstalledPackage(ctx, &fluxplugin.ResumeInstalledPackageRequest{InstalledPackageRef: expectedRef})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if resumeResp.Suspended {
+		t.Errorf("expected kustomization [%s] not to be suspended", key)
+	}
+
+	if _, err = s.DeleteInstalledPackage(ctx, &corev1.DeleteInstalledPackageRequest{InstalledPackageRef: expectedRef}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	_, err = s.GetInstalledPackageDetail(ctx, &corev1.GetInstalledPackageDetailRequest{InstalledPackageRef: expectedRef})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
+
+// forbiddenKustomizationsClient is a client for a user who may not list Kustomizations
+type forbiddenKustomizationsClient struct {
+	ctrlclient.WithWatch
+}
+
+func (c *forbiddenKustomizationsClient) List(ctx context.Context, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+	if list.GetObjectKind().GroupVersionKind().Kind == kustomizationListKind {
+		return errors.NewForbidden(common.GetKustomizationsGvr().GroupResource(), "", fmt.Errorf("forbidden"))
+	}
+	return c.WithWatch.List(ctx, list, opts...)
+}
+
+func TestGetInstalledPackageSummariesWithoutAccessToKustomizations(t *testing.T) {
+	rel := newRelease("my-podinfo", "test", &helmv2.HelmReleaseSpec{
+		Chart: helmv2.HelmChartTemplate{
+			Spec: helmv2.HelmChartTemplateSpec{
+				Chart: "podinfo",
+				SourceRef: helmv2.CrossNamespaceObjectReference{
+					Kind:      sourcev1.HelmRepositoryKind,
+					Name:      "podinfo",
+					Namespace: "test",
+				},
+			},
+		},
+	}, &helmv2.HelmReleaseStatus{})
+	s, _, err := newServerWithChartsAndReleases(t, nil, nil, []helmv2.HelmRelease{rel})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	clientGetter := s.clientGetter
+	s.clientGetter = func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+		clients, err := clientGetter(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+		ctrlClient, err := clients.ControllerRuntime()
+		if err != nil {
+			return nil, err
+		}
+		typedClient, err := clients.Typed()
+		if err != nil {
+			return nil, err
+		}
+		return clientgetter.NewBuilder().
+			WithTyped(typedClient).
+			WithControllerRuntime(&forbiddenKustomizationsClient{ctrlClient}).
+			Build(), nil
+	}
+
+	// the releases are still listed
+	response, err := s.GetInstalledPackageSummaries(context.Background(), &corev1.GetInstalledPackageSummariesRequest{
+		Context: &corev1.Context{Namespace: "test"},
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var identifiers []string
+	for _, summary := range response.InstalledPackageSummaries {
+		identifiers = append(identifiers, summary.GetInstalledPackageRef().GetIdentifier())
+	}
+	if got, want := identifiers, []string{"my-podinfo"}; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestCreateKustomizationUnsupportedSource(t *testing.T) {
+	s, _, err := newServerWithChartsAndReleases(t, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	_, err = s.CreateKustomization(context.Background(), &fluxplugin.CreateKustomizationRequest{
+		TargetContext: &corev1.Context{Namespace: "test"},
+		Name:          "podinfo",
+		SourceRef: &fluxplugin.KustomizationSourceReference{
+			Kind: sourcev1.HelmRepositoryKind,
+			Name: "podinfo",
+		},
+	})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
+
+func TestIsKustomizationReady(t *testing.T) {
+	newKustomization := func(observedGeneration int64, conds ...metav1.Condition) *kustomization {
+		return &kustomization{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Status: kustomizationStatus{
+				ObservedGeneration: observedGeneration,
+				Conditions:         conds,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		kustomization  *kustomization
+		expectedReady  bool
+		expectedReason corev1.InstalledPackageStatus_StatusReason
+	}{
+		{
+			name:           "returns pending when the generation has not been reconciled yet",
+			kustomization:  newKustomization(1, metav1.Condition{Type: fluxmeta.ReadyCondition, Status: metav1.ConditionTrue}),
+			expectedReason: corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+		},
+		{
+			name:           "returns installed when ready",
+			kustomization:  newKustomization(2, metav1.Condition{Type: fluxmeta.ReadyCondition, Status: metav1.ConditionTrue}),
+			expectedReady:  true,
+			expectedReason: corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+		},
+		{
+			name:           "returns pending while progressing",
+			kustomization:  newKustomization(2, metav1.Condition{Type: fluxmeta.ReadyCondition, Status: metav1.ConditionFalse, Reason: fluxmeta.ProgressingReason}),
+			expectedReason: corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+		},
+		{
+			name:           "returns pending while waiting for dependencies",
+			kustomization:  newKustomization(2, metav1.Condition{Type: fluxmeta.ReadyCondition, Status: metav1.ConditionFalse, Reason: dependencyNotReadyReason}),
+			expectedReason: corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+		},
+		{
+			name:           "returns failed when the reconciliation failed",
+			kustomization:  newKustomization(2, metav1.Condition{Type: fluxmeta.ReadyCondition, Status: metav1.ConditionFalse, Reason: "BuildFailed"}),
+			expectedReason: corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ready, reason, _ := isKustomizationReady(tc.kustomization)
+			if ready != tc.expectedReady || reason != tc.expectedReason {
+				t.Errorf("got: (%t, %v), want: (%t, %v)", ready, reason, tc.expectedReady, tc.expectedReason)
+			}
+		})
+	}
+}
+
+func TestResourceRefFromInventoryEntry(t *testing.T) {
+	testCases := []struct {
+		name        string
+		entry       kustomizationInventoryEntry
+		expected    *corev1.ResourceRef
+		expectedErr bool
+	}{
+		{
+			name:     "returns the ref of a namespaced resource",
+			entry:    kustomizationInventoryEntry{ID: "test_podinfo_apps_Deployment", Version: "v1"},
+			expected: &corev1.ResourceRef{ApiVersion: "apps/v1", Kind: "Deployment", Name: "podinfo", Namespace: "test"},
+		},
+		{
+			name:     "returns the ref of a cluster-scoped resource of the core group",
+			entry:    kustomizationInventoryEntry{ID: "_test__Namespace", Version: "v1"},
+			expected: &corev1.ResourceRef{ApiVersion: "v1", Kind: "Namespace", Name: "test"},
+		},
+		{
+			name:        "returns an error for an invalid entry",
+			entry:       kustomizationInventoryEntry{ID: "test_podinfo", Version: "v1"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ref, err := resourceRefFromInventoryEntry(tc.entry)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("expected an error, got: %v", ref)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			opts := cmpopts.IgnoreUnexported(corev1.ResourceRef{})
+			if got, want := ref, tc.expected; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
+// setKustomizationStatus sets the status of a Kustomization as the kustomize-controller
+// would, along with the generation that the fake client does not set
+func setKustomizationStatus(t *testing.T, s *Server, key types.NamespacedName, kustomizationStatus map[string]interface{}) {
+	ctx := context.Background()
+	ctrlClient, err := s.clientGetter.ControllerRuntime(ctx, s.kubeappsCluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := newUnstructuredKustomization()
+	if err = ctrlClient.Get(ctx, key, u); err != nil {
+		t.Fatal(err)
+	}
+	u.SetGeneration(1)
+	if err = unstructured.SetNestedMap(u.Object, kustomizationStatus, "status"); err != nil {
+		t.Fatal(err)
+	}
+	if err = ctrlClient.Update(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+}
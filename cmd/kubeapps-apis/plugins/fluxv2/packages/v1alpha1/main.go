@@ -24,7 +24,7 @@ func RegisterWithGRPCServer(opts pluginsv1alpha1.GRPCPluginRegistrationOptions)
 	// 'Shutdown' hook
 	stopCh := make(chan struct{})
 
-	svr, err := NewServer(opts.ConfigGetter, opts.ClustersConfig.KubeappsClusterName, stopCh, opts.PluginConfigPath)
+	svr, err := NewServer(opts.ConfigGetter, opts.ClustersConfig, stopCh, opts.PluginConfigPath)
 	if err != nil {
 		return nil, err
 	}
@@ -17,12 +17,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"os"
 	"path"
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -65,6 +67,11 @@ type OCIRepositoryLister interface {
 	ListRepositoryNames(ociRegistry *OCIRegistry) ([]string, error)
 }
 
+// OCIRepositoryListerFactory returns a new instance of a lister. Listers are made
+// available under a name with RegisterOCIRepositoryLister, which the plugin
+// configuration then uses to choose among them
+type OCIRepositoryListerFactory func() OCIRepositoryLister
+
 // OCIRegistry represents a Helm chart repository, and the configuration
 // required to download the repository tags and charts from the repository.
 // All methods are thread safe unless defined otherwise.
@@ -88,6 +95,9 @@ type OCIRegistry struct {
 	//  including repositoryAuthorizer are internal, so this is a workaround
 	registryCredentialFn OCIRegistryCredentialFn
 
+	// the listers that may be used for this registry, in the order they are tried
+	repositoryListers []OCIRepositoryLister
+	// the first of repositoryListers that is applicable for this registry
 	repositoryLister OCIRepositoryLister
 }
 
@@ -109,14 +119,50 @@ var (
 		},
 	}
 
-	// TODO: make this thing extensible so code coming from other plugs/modules
-	// can register new repository listers
-	builtInRepoListers = []OCIRepositoryLister{
-		NewDockerRegistryApiV2RepositoryLister(),
-		// TODO
+	// the repository listers available to the plugin configuration, by name.
+	// Code coming from other plugins/modules may add to these with RegisterOCIRepositoryLister
+	repoListerFactories = map[string]OCIRepositoryListerFactory{
+		DockerRegistryApiV2RepositoryListerName: NewDockerRegistryApiV2RepositoryLister,
+		HarborRepositoryListerName:              NewHarborRepositoryLister,
+		GitLabRepositoryListerName:              NewGitLabRepositoryLister,
 	}
+	repoListerFactoriesMutex sync.RWMutex
+
+	// the repository listers used when none are configured
+	defaultRepoListerNames = []string{DockerRegistryApiV2RepositoryListerName}
 )
 
+// RegisterOCIRepositoryLister makes a repository lister available under the given
+// name, so that it may be chosen in the plugin configuration
+func RegisterOCIRepositoryLister(name string, factory OCIRepositoryListerFactory) error {
+	repoListerFactoriesMutex.Lock()
+	defer repoListerFactoriesMutex.Unlock()
+	if _, ok := repoListerFactories[name]; ok {
+		return fmt.Errorf("OCI repository lister [%s] is already registered", name)
+	}
+	repoListerFactories[name] = factory
+	return nil
+}
+
+// newOCIRepositoryListers returns new instances of the repository listers with
+// the given names, in the same order, or the default ones if no names are given
+func newOCIRepositoryListers(names []string) ([]OCIRepositoryLister, error) {
+	if len(names) == 0 {
+		names = defaultRepoListerNames
+	}
+	repoListerFactoriesMutex.RLock()
+	defer repoListerFactoriesMutex.RUnlock()
+	listers := []OCIRepositoryLister{}
+	for _, name := range names {
+		if factory, ok := repoListerFactories[name]; !ok {
+			return nil, fmt.Errorf("unknown OCI repository lister [%s]", name)
+		} else {
+			listers = append(listers, factory())
+		}
+	}
+	return listers, nil
+}
+
 // withOCIRegistryClient returns a OCIRegistryOption that will set the registry client
 func withOCIRegistryClient(client RegistryClient) OCIRegistryOption {
 	return func(r *OCIRegistry) error {
@@ -152,6 +198,17 @@ func withRegistryCredentialFn(fn OCIRegistryCredentialFn) OCIRegistryOption {
 	}
 }
 
+// withOCIRepositoryListers returns a OCIRegistryOption that will set the listers
+// that may be used to list the repositories of the registry
+func withOCIRepositoryListers(listers []OCIRepositoryLister) OCIRegistryOption {
+	return func(r *OCIRegistry) error {
+		if len(listers) > 0 {
+			r.repositoryListers = listers
+		}
+		return nil
+	}
+}
+
 // newOCIRegistry constructs and returns a new OCIRegistry with
 // the RegistryClient configured to the getter.Getter for the
 // registry URL scheme. It returns an error on URL parsing failures.
@@ -164,6 +221,7 @@ func newOCIRegistry(registryURL string, registryOpts ...OCIRegistryOption) (*OCI
 
 	r := &OCIRegistry{}
 	r.url = *u
+	r.repositoryListers = []OCIRepositoryLister{NewDockerRegistryApiV2RepositoryLister()}
 	for _, opt := range registryOpts {
 		if err := opt(r); err != nil {
 			return nil, err
@@ -174,7 +232,7 @@ func newOCIRegistry(registryURL string, registryOpts ...OCIRegistryOption) (*OCI
 
 func (r *OCIRegistry) listRepositoryNames() ([]string, error) {
 	// this needs to be done after a call to login()
-	for _, lister := range builtInRepoListers {
+	for _, lister := range r.repositoryListers {
 		if ok, err := lister.IsApplicableFor(r); ok && err == nil {
 			r.repositoryLister = lister
 			break
@@ -257,6 +315,37 @@ func (r *OCIRegistry) downloadChart(chart *repo.ChartVersion) (*bytes.Buffer, er
 	return r.helmGetter.Get(getThis, clientOpts...)
 }
 
+// getApiJSON issues a GET request for the given vendor-specific API URL of the
+// registry and decodes the JSON response into v. authFn, if not nil, is used to
+// set the authorization for the request from the registry credentials
+func (r *OCIRegistry) getApiJSON(apiURL string, authFn func(*http.Request, orasregistryauthv2.Credential), v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, apiURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if authFn != nil && r.registryCredentialFn != nil {
+		if cred, err := r.registryCredentialFn(req.Context(), r.url.Host); err != nil {
+			return err
+		} else if cred != orasregistryauthv2.EmptyCredential {
+			authFn(req, cred)
+		}
+	}
+
+	t := transport.NewOrIdle(r.tlsConfig)
+	defer transport.Release(t)
+
+	resp, err := (&http.Client{Transport: t}).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET [%s] returned unexpected status: %s", apiURL, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
 // logout attempts to logout from the OCI registry.
 // It returns an error on failure.
 func (r *OCIRegistry) logout() error {
@@ -354,7 +443,6 @@ func newRegistryClient(isLogin bool) (*registry.Client, string, error) {
 // OCI Helm repository, which defines a source, does not produce an Artifact
 // ref https://fluxcd.io/docs/components/source/helmrepositories/#helm-oci-repository
 
-// TODO: this function is way too long. Break it up
 func (s *repoEventSink) onAddOciRepo(repo sourcev1.HelmRepository) ([]byte, bool, error) {
 	log.Infof("+onAddOciRepo(%s)", common.PrettyPrint(repo))
 	defer log.Info("-onAddOciRepo")
@@ -364,6 +452,70 @@ func (s *repoEventSink) onAddOciRepo(repo sourcev1.HelmRepository) ([]byte, bool
 		return nil, false, err
 	}
 
+	checksum, err := ociRegistry.checksum(repo)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	charts, err := s.indexOneOciRepo(ociRegistry, repo, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	return s.encodeAndSyncOciCharts(ociRegistry, checksum, charts)
+}
+
+func (s *repoEventSink) onModifyOciRepo(key string, oldValue interface{}, repo sourcev1.HelmRepository) ([]byte, bool, error) {
+	log.Infof("+onModifyOciRepo(%s)", common.PrettyPrint(repo))
+	defer log.Info("-onModifyOciRepo")
+
+	// We should to compare checksums on what's stored in the cache
+	// vs the modified object to see if the contents has really changed before embarking on
+	// an expensive operation
+	cacheEntryUntyped, err := s.onGetRepo(key, oldValue)
+	if err != nil {
+		return nil, false, err
+	}
+
+	cacheEntry, ok := cacheEntryUntyped.(repoCacheEntryValue)
+	if !ok {
+		return nil, false, status.Errorf(
+			codes.Internal,
+			"unexpected value found in cache for key [%s]: %v",
+			key, cacheEntryUntyped)
+	}
+
+	ociRegistry, err := s.newOCIRegistryAndLoginWithRepo(context.Background(), repo)
+	if err != nil {
+		return nil, false, err
+	}
+
+	newChecksum, err := ociRegistry.checksum(repo)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if cacheEntry.Checksum != newChecksum {
+		// only the OCI repositories whose tags have changed are indexed again
+		charts, err := s.indexOneOciRepo(ociRegistry, repo, cacheEntry.Charts)
+		if err != nil {
+			return nil, false, err
+		}
+		if s.chartCache != nil {
+			if err = s.chartCache.DeleteCharts(staleOciCharts(cacheEntry.Charts, charts)); err != nil {
+				return nil, false, err
+			}
+		}
+		return s.encodeAndSyncOciCharts(ociRegistry, newChecksum, charts)
+	} else {
+		// skip because the content did not change
+		return nil, false, nil
+	}
+}
+
+// returns the charts of all the OCI repositories in the registry. A chart in
+// oldCharts is re-used as is when its OCI repository has the same tags as before,
+// since getting the metadata of a chart means downloading its tarball
+func (s *repoEventSink) indexOneOciRepo(ociRegistry *OCIRegistry, repo sourcev1.HelmRepository, oldCharts []models.Chart) ([]models.Chart, error) {
 	chartRepo := &models.Repo{
 		Namespace: repo.Namespace,
 		Name:      repo.Name,
@@ -371,17 +523,22 @@ func (s *repoEventSink) onAddOciRepo(repo sourcev1.HelmRepository) ([]byte, bool
 		Type:      repo.Spec.Type,
 	}
 
+	oldChartsByID := map[string]models.Chart{}
+	for _, c := range oldCharts {
+		oldChartsByID[c.ID] = c
+	}
+
 	// repository names aka application names
 	appNames, err := ociRegistry.listRepositoryNames()
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
 	charts := []models.Chart{}
 	for _, fullAppName := range appNames {
 		appName, err := ociRegistry.shortRepoName(fullAppName)
 		if err != nil {
-			return nil, false, err
+			return nil, err
 		}
 
 		// Encode repository names to store them in the database.
@@ -393,67 +550,26 @@ func (s *repoEventSink) onAddOciRepo(repo sourcev1.HelmRepository) ([]byte, bool
 		ref := fmt.Sprintf("%s/%s", ociRegistry.url.String(), appName)
 		allTags, err := ociRegistry.getTags(ref)
 		if err != nil {
-			return nil, false, err
+			return nil, err
 		}
 
-		// to be consistent with how we support helm http repos
-		// the chart fields like Desciption, home, sources come from the
-		// most recent chart version
-		// ref https://github.com/vmware-tanzu/kubeapps/blob/11c87926d6cd798af72875d01437d15ae8d85b9a/pkg/helm/index.go#L30
-		log.Infof("==========>: most recent chart version: %s", allTags[0])
-		latestChartVersion, err := ociRegistry.pickChartVersionFrom(appName, allTags[0], allTags)
-		if err != nil {
-			return nil, false, status.Errorf(codes.Internal, "%v", err)
+		if oldChart, ok := oldChartsByID[chartID]; ok && isOciChartUpToDate(oldChart, chartRepo, allTags) {
+			log.Infof("==========>: chart [%s] has not changed", chartID)
+			oldChart.Repo = chartRepo
+			charts = append(charts, oldChart)
+			continue
 		}
 
-		latestChartMetadata, err := getOCIChartMetadata(ociRegistry, chartID, latestChartVersion)
+		mc, err := newOciChartModel(ociRegistry, chartRepo, appName, chartID, allTags)
 		if err != nil {
-			return nil, false, err
-		}
-
-		maintainers := []chart.Maintainer{}
-		for _, maintainer := range latestChartMetadata.Maintainers {
-			maintainers = append(maintainers, *maintainer)
-		}
-
-		mc := models.Chart{
-			ID:            chartID,
-			Name:          encodedAppName,
-			Repo:          chartRepo,
-			Description:   latestChartMetadata.Description,
-			Home:          latestChartMetadata.Home,
-			Keywords:      latestChartMetadata.Keywords,
-			Maintainers:   maintainers,
-			Sources:       latestChartMetadata.Sources,
-			Icon:          latestChartMetadata.Icon,
-			Category:      latestChartMetadata.Annotations["category"],
-			ChartVersions: []models.ChartVersion{},
-		}
-
-		for _, tag := range allTags {
-			chartVersion, err := ociRegistry.pickChartVersionFrom(appName, tag, allTags)
-			if err != nil {
-				return nil, false, status.Errorf(codes.Internal, "%v", err)
-			}
-			log.Infof("==========>: chart version: %s", common.PrettyPrint(chartVersion))
-
-			mcv := models.ChartVersion{
-				Version:    chartVersion.Version,
-				AppVersion: chartVersion.AppVersion,
-				Created:    chartVersion.Created,
-				Digest:     chartVersion.Digest,
-				URLs:       chartVersion.URLs,
-			}
-			mc.ChartVersions = append(mc.ChartVersions, mcv)
+			return nil, err
 		}
-		charts = append(charts, mc)
-	}
-
-	checksum, err := ociRegistry.checksum()
-	if err != nil {
-		return nil, false, status.Errorf(codes.Internal, "%v", err)
+		charts = append(charts, *mc)
 	}
+	return charts, nil
+}
 
+func (s *repoEventSink) encodeAndSyncOciCharts(ociRegistry *OCIRegistry, checksum string, charts []models.Chart) ([]byte, bool, error) {
 	cacheEntryValue := repoCacheEntryValue{
 		Checksum: checksum,
 		Charts:   charts,
@@ -462,13 +578,13 @@ func (s *repoEventSink) onAddOciRepo(repo sourcev1.HelmRepository) ([]byte, bool
 	// use gob encoding instead of json, it peforms much better
 	var buf bytes.Buffer
 	enc := gob.NewEncoder(&buf)
-	if err = enc.Encode(cacheEntryValue); err != nil {
+	if err := enc.Encode(cacheEntryValue); err != nil {
 		return nil, false, err
 	}
 
 	if s.chartCache != nil {
 		fn := downloadOCIChartFn(ociRegistry)
-		if err = s.chartCache.SyncCharts(charts, fn); err != nil {
+		if err := s.chartCache.SyncCharts(charts, fn); err != nil {
 			return nil, false, err
 		}
 	}
@@ -476,43 +592,93 @@ func (s *repoEventSink) onAddOciRepo(repo sourcev1.HelmRepository) ([]byte, bool
 	return buf.Bytes(), true, nil
 }
 
-func (s *repoEventSink) onModifyOciRepo(key string, oldValue interface{}, repo sourcev1.HelmRepository) ([]byte, bool, error) {
-	log.Infof("+onModifyOciRepo(%s)", common.PrettyPrint(repo))
-	defer log.Info("-onModifyOciRepo")
+func newOciChartModel(ociRegistry *OCIRegistry, chartRepo *models.Repo, appName, chartID string, allTags []string) (*models.Chart, error) {
+	// to be consistent with how we support helm http repos
+	// the chart fields like Desciption, home, sources come from the
+	// most recent chart version
+	// ref https://github.com/vmware-tanzu/kubeapps/blob/11c87926d6cd798af72875d01437d15ae8d85b9a/pkg/helm/index.go#L30
+	log.Infof("==========>: most recent chart version: %s", allTags[0])
+	latestChartVersion, err := ociRegistry.pickChartVersionFrom(appName, allTags[0], allTags)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
 
-	// We should to compare checksums on what's stored in the cache
-	// vs the modified object to see if the contents has really changed before embarking on
-	// an expensive operation
-	cacheEntryUntyped, err := s.onGetRepo(key, oldValue)
+	latestChartMetadata, err := getOCIChartMetadata(ociRegistry, chartID, latestChartVersion)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
-	cacheEntry, ok := cacheEntryUntyped.(repoCacheEntryValue)
-	if !ok {
-		return nil, false, status.Errorf(
-			codes.Internal,
-			"unexpected value found in cache for key [%s]: %v",
-			key, cacheEntryUntyped)
+	maintainers := []chart.Maintainer{}
+	for _, maintainer := range latestChartMetadata.Maintainers {
+		maintainers = append(maintainers, *maintainer)
 	}
 
-	ociRegistry, err := s.newOCIRegistryAndLoginWithRepo(context.Background(), repo)
-	if err != nil {
-		return nil, false, err
+	mc := &models.Chart{
+		ID:            chartID,
+		Name:          url.PathEscape(appName),
+		Repo:          chartRepo,
+		Description:   latestChartMetadata.Description,
+		Home:          latestChartMetadata.Home,
+		Keywords:      latestChartMetadata.Keywords,
+		Maintainers:   maintainers,
+		Sources:       latestChartMetadata.Sources,
+		Icon:          latestChartMetadata.Icon,
+		Category:      latestChartMetadata.Annotations["category"],
+		ChartVersions: []models.ChartVersion{},
 	}
 
-	newChecksum, err := ociRegistry.checksum()
-	if err != nil {
-		return nil, false, err
+	for _, tag := range allTags {
+		chartVersion, err := ociRegistry.pickChartVersionFrom(appName, tag, allTags)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		log.Infof("==========>: chart version: %s", common.PrettyPrint(chartVersion))
+
+		mcv := models.ChartVersion{
+			Version:    chartVersion.Version,
+			AppVersion: chartVersion.AppVersion,
+			Created:    chartVersion.Created,
+			Digest:     chartVersion.Digest,
+			URLs:       chartVersion.URLs,
+		}
+		mc.ChartVersions = append(mc.ChartVersions, mcv)
 	}
+	return mc, nil
+}
 
-	if cacheEntry.Checksum != newChecksum {
-		// TODO (gfichtenholt)
-		return nil, false, status.Errorf(codes.Unimplemented, "TODO")
-	} else {
-		// skip because the content did not change
-		return nil, false, nil
+// a chart is up to date when it comes from the same registry and there is exactly
+// one chart version for each of the tags, in the same order
+func isOciChartUpToDate(chart models.Chart, chartRepo *models.Repo, allTags []string) bool {
+	if chart.Repo == nil || chart.Repo.URL != chartRepo.URL || len(chart.ChartVersions) != len(allTags) {
+		return false
+	}
+	for i, tag := range allTags {
+		if chart.ChartVersions[i].Version != tag {
+			return false
+		}
 	}
+	return true
+}
+
+// returns the charts in oldCharts whose latest version, i.e. the only version
+// kept in the chart cache, is no longer the latest version in newCharts
+func staleOciCharts(oldCharts, newCharts []models.Chart) []models.Chart {
+	latestVersions := map[string]string{}
+	for _, c := range newCharts {
+		if len(c.ChartVersions) > 0 {
+			latestVersions[c.ID] = c.ChartVersions[0].Version
+		}
+	}
+	stale := []models.Chart{}
+	for _, c := range oldCharts {
+		if len(c.ChartVersions) == 0 {
+			continue
+		}
+		if version, ok := latestVersions[c.ID]; !ok || version != c.ChartVersions[0].Version {
+			stale = append(stale, c)
+		}
+	}
+	return stale
 }
 
 //
@@ -527,9 +693,11 @@ type TagList struct {
 }
 
 // Checksum returns the sha256 of the repo by concatenating tags for
-// all repositories within the registry and returning the sha256.
+// all repositories within the registry, along with the url and secretRef
+// of the HelmRepository, so a change of either is not mistaken for an
+// unchanged registry, and returning the sha256.
 // Caveat: Mutated image tags won't be detected as new
-func (r *OCIRegistry) checksum() (string, error) {
+func (r *OCIRegistry) checksum(repo sourcev1.HelmRepository) (string, error) {
 	log.Infof("+checksum()")
 	defer log.Infof("-checksum()")
 	appNames, err := r.listRepositoryNames()
@@ -552,7 +720,25 @@ func (r *OCIRegistry) checksum() (string, error) {
 		tags[appName] = TagList{Name: appName, Tags: tagz}
 	}
 
-	content, err := json.Marshal(tags)
+	return ociRepoChecksum(repo, tags)
+}
+
+// ociRepoChecksum returns the sha256 of the tags of an OCI registry together
+// with the spec of the HelmRepository they were read with
+func ociRepoChecksum(repo sourcev1.HelmRepository, tags map[string]TagList) (string, error) {
+	secretRef := ""
+	if repo.Spec.SecretRef != nil {
+		secretRef = repo.Spec.SecretRef.Name
+	}
+	content, err := json.Marshal(struct {
+		URL       string             `json:"url"`
+		SecretRef string             `json:"secretRef"`
+		Tags      map[string]TagList `json:"tags"`
+	}{
+		URL:       repo.Spec.URL,
+		SecretRef: secretRef,
+		Tags:      tags,
+	})
 	if err != nil {
 		return "", err
 	}
@@ -572,12 +758,12 @@ func (r *OCIRegistry) shortRepoName(fullRepoName string) (string, error) {
 	}
 }
 
-func (s *Server) newOCIRegistryAndLoginWithRepo(ctx context.Context, repoName types.NamespacedName) (*OCIRegistry, error) {
-	repo, err := s.getRepoInCluster(ctx, repoName)
+func (s *Server) newOCIRegistryAndLoginWithRepo(ctx context.Context, cluster string, repoName types.NamespacedName) (*OCIRegistry, error) {
+	repo, err := s.getRepoInCluster(ctx, cluster, repoName)
 	if err != nil {
 		return nil, err
 	} else {
-		sink := s.newRepoEventSink()
+		sink := s.newRepoEventSink(cluster)
 		return sink.newOCIRegistryAndLoginWithRepo(ctx, *repo)
 	}
 }
@@ -622,7 +808,8 @@ func (s *repoEventSink) newOCIRegistryAndLoginWithOptions(registryURL string, lo
 		withOCIGetter(helmGetters),
 		withOCIGetterOptions(getterOpts),
 		withOCIRegistryClient(registryClient),
-		withRegistryCredentialFn(registryCredentialFn))
+		withRegistryCredentialFn(registryCredentialFn),
+		withOCIRepositoryListers(s.ociRepositoryListers))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to parse URL '%s': %v", registryURL, err)
 	}
@@ -0,0 +1,280 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+	orasregistryauthv2 "oras.land/oras-go/v2/registry/remote/auth"
+)
+
+func TestIsOciChartUpToDate(t *testing.T) {
+	chartRepo := &models.Repo{Namespace: "default", Name: "podinfo", URL: "oci://ghcr.io/stefanprodan/charts"}
+	chart := models.Chart{
+		ID:   "podinfo/podinfo",
+		Repo: chartRepo,
+		ChartVersions: []models.ChartVersion{
+			{Version: "6.1.5"},
+			{Version: "6.1.4"},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		chartRepo *models.Repo
+		tags      []string
+		expected  bool
+	}{
+		{
+			name:      "returns true when the tags have not changed",
+			chartRepo: chartRepo,
+			tags:      []string{"6.1.5", "6.1.4"},
+			expected:  true,
+		},
+		{
+			name:      "returns false when a tag has been added",
+			chartRepo: chartRepo,
+			tags:      []string{"6.1.6", "6.1.5", "6.1.4"},
+			expected:  false,
+		},
+		{
+			name:      "returns false when a tag has been replaced",
+			chartRepo: chartRepo,
+			tags:      []string{"6.1.6", "6.1.4"},
+			expected:  false,
+		},
+		{
+			name:      "returns false when the registry url has changed",
+			chartRepo: &models.Repo{Namespace: "default", Name: "podinfo", URL: "oci://example.com/charts"},
+			tags:      []string{"6.1.5", "6.1.4"},
+			expected:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := isOciChartUpToDate(chart, tc.chartRepo, tc.tags), tc.expected; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestOciRepoChecksum(t *testing.T) {
+	newRepo := func(url, secretName string) sourcev1.HelmRepository {
+		repo := sourcev1.HelmRepository{
+			Spec: sourcev1.HelmRepositorySpec{URL: url, Type: sourcev1.HelmRepositoryTypeOCI},
+		}
+		if secretName != "" {
+			repo.Spec.SecretRef = &fluxmeta.LocalObjectReference{Name: secretName}
+		}
+		return repo
+	}
+	tags := map[string]TagList{"podinfo": {Name: "podinfo", Tags: []string{"6.1.5", "6.1.4"}}}
+
+	expected, err := ociRepoChecksum(newRepo("oci://ghcr.io/stefanprodan/charts", ""), tags)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	testCases := []struct {
+		name     string
+		repo     sourcev1.HelmRepository
+		tags     map[string]TagList
+		expected bool
+	}{
+		{
+			name:     "returns the same checksum when nothing has changed",
+			repo:     newRepo("oci://ghcr.io/stefanprodan/charts", ""),
+			tags:     tags,
+			expected: true,
+		},
+		{
+			name:     "returns a different checksum when a tag has been added",
+			repo:     newRepo("oci://ghcr.io/stefanprodan/charts", ""),
+			tags:     map[string]TagList{"podinfo": {Name: "podinfo", Tags: []string{"6.1.6", "6.1.5", "6.1.4"}}},
+			expected: false,
+		},
+		{
+			name:     "returns a different checksum when the url has changed",
+			repo:     newRepo("oci://example.com/stefanprodan/charts", ""),
+			tags:     tags,
+			expected: false,
+		},
+		{
+			name:     "returns a different checksum when the secret ref has changed",
+			repo:     newRepo("oci://ghcr.io/stefanprodan/charts", "ghcr-credentials"),
+			tags:     tags,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checksum, err := ociRepoChecksum(tc.repo, tc.tags)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := checksum == expected, tc.expected; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestStaleOciCharts(t *testing.T) {
+	chartRepo := &models.Repo{Namespace: "default", Name: "podinfo", URL: "oci://ghcr.io/stefanprodan/charts"}
+	newChart := func(id string, versions ...string) models.Chart {
+		chart := models.Chart{ID: id, Repo: chartRepo}
+		for _, v := range versions {
+			chart.ChartVersions = append(chart.ChartVersions, models.ChartVersion{Version: v})
+		}
+		return chart
+	}
+
+	testCases := []struct {
+		name      string
+		oldCharts []models.Chart
+		newCharts []models.Chart
+		expected  []models.Chart
+	}{
+		{
+			name:      "returns no charts when nothing has changed",
+			oldCharts: []models.Chart{newChart("podinfo/podinfo", "6.1.5", "6.1.4")},
+			newCharts: []models.Chart{newChart("podinfo/podinfo", "6.1.5", "6.1.4")},
+			expected:  []models.Chart{},
+		},
+		{
+			name:      "returns no charts when only an older version has been added",
+			oldCharts: []models.Chart{newChart("podinfo/podinfo", "6.1.5")},
+			newCharts: []models.Chart{newChart("podinfo/podinfo", "6.1.5", "6.1.4")},
+			expected:  []models.Chart{},
+		},
+		{
+			name:      "returns the charts whose latest version has changed",
+			oldCharts: []models.Chart{newChart("podinfo/podinfo", "6.1.5"), newChart("podinfo/nginx", "1.0.0")},
+			newCharts: []models.Chart{newChart("podinfo/podinfo", "6.1.6", "6.1.5"), newChart("podinfo/nginx", "1.0.0")},
+			expected:  []models.Chart{newChart("podinfo/podinfo", "6.1.5")},
+		},
+		{
+			name:      "returns the charts that have been removed",
+			oldCharts: []models.Chart{newChart("podinfo/podinfo", "6.1.5"), newChart("podinfo/nginx", "1.0.0")},
+			newCharts: []models.Chart{newChart("podinfo/podinfo", "6.1.5")},
+			expected:  []models.Chart{newChart("podinfo/nginx", "1.0.0")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := staleOciCharts(tc.oldCharts, tc.newCharts), tc.expected; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestNewOCIRepositoryListers(t *testing.T) {
+	testCases := []struct {
+		name          string
+		names         []string
+		expectedTypes []reflect.Type
+		expectedErr   string
+	}{
+		{
+			name:          "returns the docker registry v2 lister by default",
+			names:         nil,
+			expectedTypes: []reflect.Type{reflect.TypeOf(&dockerRegistryApiV2RepositoryLister{})},
+		},
+		{
+			name:  "returns the listers in the configured order",
+			names: []string{HarborRepositoryListerName, GitLabRepositoryListerName, DockerRegistryApiV2RepositoryListerName},
+			expectedTypes: []reflect.Type{
+				reflect.TypeOf(&harborRepositoryLister{}),
+				reflect.TypeOf(&gitlabRepositoryLister{}),
+				reflect.TypeOf(&dockerRegistryApiV2RepositoryLister{}),
+			},
+		},
+		{
+			name:        "returns an error for an unknown lister",
+			names:       []string{HarborRepositoryListerName, "quay"},
+			expectedErr: "unknown OCI repository lister [quay]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listers, err := newOCIRepositoryListers(tc.names)
+			if tc.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+					t.Fatalf("got error: %v, want: %s", err, tc.expectedErr)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			gotTypes := []reflect.Type{}
+			for _, l := range listers {
+				gotTypes = append(gotTypes, reflect.TypeOf(l))
+			}
+			if got, want := gotTypes, tc.expectedTypes; !reflect.DeepEqual(got, want) {
+				t.Errorf("got: %v, want: %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRegisterOCIRepositoryLister(t *testing.T) {
+	name := "test-lister"
+	if err := RegisterOCIRepositoryLister(name, NewHarborRepositoryLister); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer func() {
+		repoListerFactoriesMutex.Lock()
+		delete(repoListerFactories, name)
+		repoListerFactoriesMutex.Unlock()
+	}()
+
+	if listers, err := newOCIRepositoryListers([]string{name}); err != nil {
+		t.Fatalf("%+v", err)
+	} else if len(listers) != 1 {
+		t.Fatalf("expected 1 lister, got: %d", len(listers))
+	}
+
+	if err := RegisterOCIRepositoryLister(HarborRepositoryListerName, NewHarborRepositoryLister); err == nil {
+		t.Errorf("expected an error registering a lister with an existing name")
+	}
+}
+
+// newTestOCIRegistry returns an OCIRegistry for the given path of a local https
+// server, which trusts the certificate of that server
+func newTestOCIRegistry(t *testing.T, ts *httptest.Server, path string, cred orasregistryauthv2.Credential, listers ...OCIRepositoryLister) *OCIRegistry {
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	registry, err := newOCIRegistry(
+		"oci://"+tsURL.Host+path,
+		withRegistryCredentialFn(func(ctx context.Context, reg string) (orasregistryauthv2.Credential, error) {
+			return cred, nil
+		}),
+		withOCIRepositoryListers(listers))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	certPool := x509.NewCertPool()
+	certPool.AddCert(ts.Certificate())
+	registry.tlsConfig = &tls.Config{RootCAs: certPool}
+	return registry
+}
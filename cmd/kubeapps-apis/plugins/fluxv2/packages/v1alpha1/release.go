@@ -10,38 +10,60 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	fluxmeta "github.com/fluxcd/pkg/apis/meta"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/k8sutils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgfilter"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/valuesschema"
+	"github.com/vmware-tanzu/kubeapps/pkg/agent"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	httpclient "github.com/vmware-tanzu/kubeapps/pkg/http-client"
 	"github.com/vmware-tanzu/kubeapps/pkg/tarutil"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	"helm.sh/helm/v3/pkg/strvals"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/retry"
 	log "k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 )
 
+const (
+	// the kinds of resources the values of a release may be taken from
+	valuesFromKindSecret    = "Secret"
+	valuesFromKindConfigMap = "ConfigMap"
+
+	// the value returned in place of each of the values read from a secret
+	redactedValue = "REDACTED"
+)
+
 var (
 	// default reconcile interval is 1 min
 	defaultReconcileInterval = metav1.Duration{Duration: 1 * time.Minute}
 )
 
 // namespace maybe "", in which case releases from all namespaces are returned
-func (s *Server) listReleasesInCluster(ctx context.Context, namespace string) ([]helmv2.HelmRelease, error) {
-	client, err := s.getClient(ctx, namespace)
+func (s *Server) listReleasesInCluster(ctx context.Context, cluster, namespace string) ([]helmv2.HelmRelease, error) {
+	client, err := s.getClient(ctx, cluster, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +83,72 @@ func (s *Server) listReleasesInCluster(ctx context.Context, namespace string) ([
 	}
 }
 
-func (s *Server) getReleaseInCluster(ctx context.Context, key types.NamespacedName) (*helmv2.HelmRelease, error) {
-	client, err := s.getClient(ctx, key.Namespace)
+// watchReleasesInCluster returns a watch for the releases in the given namespace,
+// starting with an added event for each existing release. The watch is
+// re-established from the last received resourceVersion when the API server
+// closes it. namespace maybe "", in which case releases from all namespaces are watched
+func (s *Server) watchReleasesInCluster(ctx context.Context, cluster, namespace string) (watch.Interface, error) {
+	client, err := s.clientGetter.ControllerRuntime(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if watcher, err := k8sutils.NewListWatcher(
+		func(options metav1.ListOptions) (runtime.Object, error) {
+			var relList helmv2.HelmReleaseList
+			err := client.List(ctx, &relList, &ctrlclient.ListOptions{Namespace: namespace, Raw: &options})
+			return &relList, err
+		},
+		func(options metav1.ListOptions) (watch.Interface, error) {
+			var relList helmv2.HelmReleaseList
+			return client.Watch(ctx, &relList, &ctrlclient.ListOptions{Namespace: namespace, Raw: &options})
+		},
+	); err != nil {
+		return nil, statuserror.FromK8sError("watch", "HelmRelease", namespace+"/*", err)
+	} else {
+		return watcher, nil
+	}
+}
+
+// watchEventForRelease returns the watch event for a change to a release, or
+// nil if there is nothing to send, such as when the release is not yet ready.
+func (s *Server) watchEventForRelease(ctx context.Context, cluster string, eventType watch.EventType, rel helmv2.HelmRelease, alreadySent bool) (*corev1.WatchInstalledPackageSummariesResponse, error) {
+	if eventType == watch.Deleted {
+		if !alreadySent {
+			return nil, nil
+		}
+		return &corev1.WatchInstalledPackageSummariesResponse{
+			EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED,
+			InstalledPackageSummary: &corev1.InstalledPackageSummary{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: rel.Namespace,
+						Cluster:   cluster,
+					},
+					Identifier: rel.Name,
+					Plugin:     GetPluginDetail(),
+				},
+				Name: rel.Name,
+			},
+		}, nil
+	}
+
+	summary, err := s.installedPkgSummaryFromRelease(ctx, cluster, rel)
+	if err != nil || summary == nil {
+		return nil, err
+	}
+	responseEventType := corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED
+	if alreadySent {
+		responseEventType = corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED
+	}
+	return &corev1.WatchInstalledPackageSummariesResponse{
+		EventType:               responseEventType,
+		InstalledPackageSummary: summary,
+	}, nil
+}
+
+func (s *Server) getReleaseInCluster(ctx context.Context, cluster string, key types.NamespacedName) (*helmv2.HelmRelease, error) {
+	client, err := s.getClient(ctx, cluster, key.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -74,28 +160,64 @@ func (s *Server) getReleaseInCluster(ctx context.Context, key types.NamespacedNa
 	return &rel, nil
 }
 
-func (s *Server) paginatedInstalledPkgSummaries(ctx context.Context, namespace string, pageSize int32, itemOffset int) ([]*corev1.InstalledPackageSummary, error) {
-	releasesFromCluster, err := s.listReleasesInCluster(ctx, namespace)
+// paginatedInstalledPkgSummaries returns a page of the installed package summaries
+// for the releases and Kustomizations in the given namespace, with the releases
+// first. When filter options are given, only the matching summaries are returned
+// and the item offset counts only matching summaries.
+func (s *Server) paginatedInstalledPkgSummaries(ctx context.Context, cluster, namespace string, filterOptions *corev1.InstalledPackageFilterOptions, pageSize int32, itemOffset int) ([]*corev1.InstalledPackageSummary, error) {
+	releasesFromCluster, err := s.listReleasesInCluster(ctx, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+	kustomizationsFromCluster, err := s.listKustomizationsInCluster(ctx, cluster, namespace)
 	if err != nil {
 		return nil, err
 	}
 
+	// the summaries are only computed for the items of the requested page
+	summaryFns := make([]func() (*corev1.InstalledPackageSummary, error), 0, len(releasesFromCluster)+len(kustomizationsFromCluster))
+	for _, r := range releasesFromCluster {
+		r := r
+		summaryFns = append(summaryFns, func() (*corev1.InstalledPackageSummary, error) {
+			return s.installedPkgSummaryFromRelease(ctx, cluster, r)
+		})
+	}
+	for _, k := range kustomizationsFromCluster {
+		k := k
+		summaryFns = append(summaryFns, func() (*corev1.InstalledPackageSummary, error) {
+			return installedPkgSummaryFromKustomization(cluster, k), nil
+		})
+	}
+
 	installedPkgSummaries := []*corev1.InstalledPackageSummary{}
-	if len(releasesFromCluster) > 0 {
+	if len(summaryFns) > 0 {
+		filtering := !pkgfilter.IsEmpty(filterOptions)
 		startAt := -1
-		if pageSize > 0 {
+		if pageSize > 0 && !filtering {
 			startAt = itemOffset
 		}
+		numMatchesToSkip := 0
+		if pageSize > 0 && filtering {
+			numMatchesToSkip = itemOffset
+		}
 
-		for i, r := range releasesFromCluster {
+		for i, summaryFn := range summaryFns {
 			if startAt <= i {
-				summary, err := s.installedPkgSummaryFromRelease(ctx, r)
+				summary, err := summaryFn()
 				if err != nil {
 					return nil, err
 				} else if summary == nil {
 					// not ready yet
 					continue
 				}
+				if filtering {
+					if !pkgfilter.MatchesInstalledPackageSummary(filterOptions, summary) {
+						continue
+					} else if numMatchesToSkip > 0 {
+						numMatchesToSkip--
+						continue
+					}
+				}
 				installedPkgSummaries = append(installedPkgSummaries, summary)
 				if pageSize > 0 && len(installedPkgSummaries) == int(pageSize) {
 					break
@@ -106,7 +228,7 @@ func (s *Server) paginatedInstalledPkgSummaries(ctx context.Context, namespace s
 	return installedPkgSummaries, nil
 }
 
-func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, rel helmv2.HelmRelease) (*corev1.InstalledPackageSummary, error) {
+func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, cluster string, rel helmv2.HelmRelease) (*corev1.InstalledPackageSummary, error) {
 	// first check if release CR is ready or is in "flux"
 	if !checkReleaseGeneration(rel) {
 		return nil, nil
@@ -137,7 +259,7 @@ func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, rel helmv2.
 
 	repoName := rel.Spec.Chart.Spec.SourceRef.Name
 	repoNamespace := rel.Spec.Chart.Spec.SourceRef.Namespace
-	chartName := rel.Spec.Chart.Spec.Chart
+	chartName := chartNameOfRelease(&rel)
 
 	if repoName != "" && helmChartRef != "" && chartName != "" {
 		parts := strings.Split(helmChartRef, "/")
@@ -146,7 +268,7 @@ func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, rel helmv2.
 		} else {
 			chartKey := types.NamespacedName{Name: parts[1], Namespace: parts[0]}
 			// not important to use the chart cache here, since the tar URL will be from a local cluster
-			if chart, err := s.getChartInCluster(ctx, chartKey); err != nil {
+			if chart, err := s.getChartInCluster(ctx, cluster, chartKey); err != nil {
 				log.Warningf("Failed to get HelmChart [%s] due to: %+v", helmChartRef, err)
 			} else {
 				tarUrl := chart.Status.URL
@@ -177,7 +299,7 @@ func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, rel helmv2.
 		repoNamespace = name.Namespace
 	}
 	repo := types.NamespacedName{Namespace: repoNamespace, Name: repoName}
-	chartFromCache, err := s.getChart(ctx, repo, chartName)
+	chartFromCache, err := s.getChart(ctx, cluster, repo, chartName)
 	if err != nil {
 		log.Warningf("%v", err)
 	} else if chartFromCache != nil && len(chartFromCache.ChartVersions) > 0 {
@@ -192,7 +314,7 @@ func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, rel helmv2.
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
 				Namespace: name.Namespace,
-				Cluster:   s.kubeappsCluster,
+				Cluster:   cluster,
 			},
 			Identifier: name.Name,
 			Plugin:     GetPluginDetail(),
@@ -214,8 +336,8 @@ func (s *Server) installedPkgSummaryFromRelease(ctx context.Context, rel helmv2.
 	}, nil
 }
 
-func (s *Server) installedPackageDetail(ctx context.Context, key types.NamespacedName) (*corev1.InstalledPackageDetail, error) {
-	rel, err := s.getReleaseInCluster(ctx, key)
+func (s *Server) installedPackageDetail(ctx context.Context, cluster string, key types.NamespacedName) (*corev1.InstalledPackageDetail, error) {
+	rel, err := s.getReleaseInCluster(ctx, cluster, key)
 	if err != nil {
 		return nil, err
 	}
@@ -236,8 +358,24 @@ func (s *Server) installedPackageDetail(ctx context.Context, key types.Namespace
 		valuesApplied = string(valuesJson.Raw)
 	}
 
-	// TODO (gfichtenholt) what about ValuesFrom []ValuesReference `json:"valuesFrom,omitempty"`?
-	// ValuesReference maybe a config map or a secret
+	// values may also come from config maps or secrets, in which case the values applied
+	// are the result of merging those with the inline values, same as flux does
+	var customDetail *anypb.Any
+	if len(rel.Spec.ValuesFrom) > 0 {
+		sources := s.readValuesFrom(ctx, cluster, rel)
+		if values, err := effectiveReleaseValues(rel, sources); err != nil {
+			log.Warningf("Failed to merge values of release [%s] due to: %v", key, err)
+		} else if byteArray, err := json.Marshal(values); err != nil {
+			return nil, err
+		} else {
+			valuesApplied = string(byteArray)
+		}
+		if customDetail, err = anypb.New(&v1alpha1.FluxPackageInstallCustomDetail{
+			ValuesFrom: valuesReferencesForDetail(rel.Spec.ValuesFrom, sources),
+		}); err != nil {
+			return nil, err
+		}
+	}
 
 	// this will only be present if install/upgrade succeeded
 	pkgVersion := rel.Status.LastAppliedRevision
@@ -251,10 +389,10 @@ func (s *Server) installedPackageDetail(ctx context.Context, key types.Namespace
 		return nil, err
 	}
 	// per https://github.com/vmware-tanzu/kubeapps/pull/3686#issue-1038093832
-	availablePackageRef.Context.Cluster = s.kubeappsCluster
+	availablePackageRef.Context.Cluster = cluster
 
 	appVersion, postInstallNotes := "", ""
-	rel2, err := s.getReleaseViaHelmApi(ctx, key, rel)
+	rel2, err := s.getReleaseViaHelmApi(ctx, cluster, key, rel)
 	// err maybe NotFound if this object has just been created and flux hasn't had time
 	// to invoke helm layer yet
 	if err == nil && rel != nil {
@@ -273,7 +411,7 @@ func (s *Server) installedPackageDetail(ctx context.Context, key types.Namespace
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
 				Namespace: key.Namespace,
-				Cluster:   s.kubeappsCluster,
+				Cluster:   cluster,
 			},
 			Identifier: key.Name,
 			Plugin:     GetPluginDetail(),
@@ -289,10 +427,11 @@ func (s *Server) installedPackageDetail(ctx context.Context, key types.Namespace
 		AvailablePackageRef:   availablePackageRef,
 		PostInstallationNotes: postInstallNotes,
 		Status:                installedPackageStatus(*rel),
+		CustomDetail:          customDetail,
 	}, nil
 }
 
-func (s *Server) getReleaseViaHelmApi(ctx context.Context, key types.NamespacedName, rel *helmv2.HelmRelease) (*release.Release, error) {
+func (s *Server) getReleaseViaHelmApi(ctx context.Context, cluster string, key types.NamespacedName, rel *helmv2.HelmRelease) (*release.Release, error) {
 	// post installation notes can only be retrieved via helm APIs, flux doesn't do it
 	// see discussion in https://cloud-native.slack.com/archives/CLAJ40HV3/p1629244025187100
 	if s.actionConfigGetter == nil {
@@ -300,7 +439,7 @@ func (s *Server) getReleaseViaHelmApi(ctx context.Context, key types.NamespacedN
 	}
 
 	helmRel := helmReleaseName(key, rel)
-	actionConfig, err := s.actionConfigGetter(ctx, helmRel.Namespace)
+	actionConfig, err := s.actionConfigGetter(ctx, &corev1.Context{Cluster: cluster, Namespace: helmRel.Namespace})
 	if err != nil || actionConfig == nil {
 		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config in namespace [%s] due to: %v", key.Namespace, err)
 	}
@@ -315,16 +454,193 @@ func (s *Server) getReleaseViaHelmApi(ctx context.Context, key types.NamespacedN
 	return release, nil
 }
 
-func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePackageReference, targetName types.NamespacedName, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string) (*corev1.InstalledPackageReference, error) {
+// getReleaseHistoryViaHelmApi returns the revisions of the helm release created by
+// flux for the given HelmRelease, ordered from the most recent to the oldest.
+func (s *Server) getReleaseHistoryViaHelmApi(ctx context.Context, cluster string, key types.NamespacedName, rel *helmv2.HelmRelease) ([]*release.Release, error) {
+	if s.actionConfigGetter == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Server is not configured with actionConfigGetter")
+	}
+
+	helmRel := helmReleaseName(key, rel)
+	actionConfig, err := s.actionConfigGetter(ctx, &corev1.Context{Cluster: cluster, Namespace: helmRel.Namespace})
+	if err != nil || actionConfig == nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config in namespace [%s] due to: %v", key.Namespace, err)
+	}
+	releases, err := agent.GetReleaseHistory(actionConfig, helmRel.Name)
+	if err != nil {
+		if err == driver.ErrReleaseNotFound {
+			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release [%s] in namespace [%s]", helmRel, key.Namespace)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to run Helm History action for release [%s] in namespace [%s]: %v", helmRel, key.Namespace, err)
+	}
+	return releases, nil
+}
+
+// installedPackageRevisions returns the revisions of the helm release created by flux
+// for the given HelmRelease, from its helm history, i.e. the same revisions that
+// rollbackRelease accepts, ordered from the most recent to the oldest.
+func (s *Server) installedPackageRevisions(ctx context.Context, cluster string, key types.NamespacedName) ([]*corev1.InstalledPackageRevision, error) {
+	rel, err := s.getReleaseInCluster(ctx, cluster, key)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := s.getReleaseHistoryViaHelmApi(ctx, cluster, key, rel)
+	if status.Code(err) == codes.NotFound {
+		// flux has not created the helm release yet
+		return []*corev1.InstalledPackageRevision{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	valuesFrom, err := s.valuesFromKeys(ctx, cluster, rel)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	revisions := make([]*corev1.InstalledPackageRevision, 0, len(releases))
+	for _, r := range releases {
+		revision, err := installedPackageRevisionFromRelease(r, valuesFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to create installed package revision from release: %v", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// installedPackageRevisionFromRelease returns the revision for a release of the helm
+// history. The values of the revision are its inline values only, see inlineValues.
+func installedPackageRevisionFromRelease(r *release.Release, valuesFrom map[string]interface{}) (*corev1.InstalledPackageRevision, error) {
+	revision := &corev1.InstalledPackageRevision{
+		ReleaseRevision: int32(r.Version),
+	}
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		revision.Version = &corev1.PackageAppVersion{
+			PkgVersion: r.Chart.Metadata.Version,
+			AppVersion: r.Chart.Metadata.AppVersion,
+		}
+	}
+	if r.Info != nil {
+		revision.Status = &corev1.InstalledPackageStatus{
+			Ready:      r.Info.Status == release.StatusDeployed,
+			Reason:     statusReasonForHelmStatus(r.Info.Status),
+			UserReason: r.Info.Status.String(),
+		}
+		if !r.Info.LastDeployed.IsZero() {
+			revision.Updated = timestamppb.New(r.Info.LastDeployed.Time)
+		}
+	}
+	if values := inlineValues(r.Config, valuesFrom); len(values) > 0 {
+		byteArray, err := json.Marshal(values)
+		if err != nil {
+			return nil, err
+		}
+		revision.ValuesApplied = string(byteArray)
+	}
+	return revision, nil
+}
+
+func statusReasonForHelmStatus(s release.Status) corev1.InstalledPackageStatus_StatusReason {
+	switch s {
+	case release.StatusDeployed:
+		return corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED
+	case release.StatusFailed:
+		return corev1.InstalledPackageStatus_STATUS_REASON_FAILED
+	case release.StatusPendingInstall, release.StatusPendingRollback, release.StatusPendingUpgrade, release.StatusUninstalling:
+		return corev1.InstalledPackageStatus_STATUS_REASON_PENDING
+	}
+	// Both StatusUninstalled and StatusSuperseded will be unknown/unspecified.
+	return corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED
+}
+
+// rollbackRelease rolls back a HelmRelease to a revision of its helm history. Rather
+// than using the helm rollback action, which flux would undo on the next
+// reconciliation, the HelmRelease is updated with the chart version and the inline
+// values of the revision. The references to config maps and secrets are kept as they
+// are, so they remain the source of the values they set, and the chart version is
+// constrained with the default upgrade policy, as for updates.
+func (s *Server) rollbackRelease(ctx context.Context, cluster string, packageRef *corev1.InstalledPackageReference, revision int) (*corev1.InstalledPackageReference, error) {
+	key := types.NamespacedName{Name: packageRef.Identifier, Namespace: packageRef.Context.Namespace}
+
+	rel, err := s.getReleaseInCluster(ctx, cluster, key)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := s.getReleaseHistoryViaHelmApi(ctx, cluster, key, rel)
+	if err != nil {
+		return nil, err
+	}
+	var target *release.Release
+	for _, r := range releases {
+		if r.Version == revision {
+			target = r
+			break
+		}
+	}
+	if target == nil || target.Chart == nil || target.Chart.Metadata == nil {
+		return nil, status.Errorf(codes.NotFound, "Unable to find revision [%d] of release [%s]", revision, key)
+	}
+
+	valuesFrom, err := s.valuesFromKeys(ctx, cluster, rel)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	var valuesJSON *v1.JSON
+	if values := inlineValues(target.Config, valuesFrom); len(values) > 0 {
+		byteArray, err := json.Marshal(values)
+		if err != nil {
+			return nil, err
+		}
+		valuesJSON = &v1.JSON{Raw: byteArray}
+	}
+
+	versionExpr, err := pkgutils.VersionConstraintWithUpgradePolicy(
+		target.Chart.Metadata.Version, s.pluginConfig.DefaultUpgradePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	// As for updates, rollbacks of releases pending reconciliation are queued behind
+	// the current reconciliation by the helm-controller.
+	rel, err = s.updateReleaseInCluster(ctx, cluster, key, func(rel *helmv2.HelmRelease) error {
+		rel.Spec.Chart.Spec.Version = versionExpr
+		rel.Spec.Values = valuesJSON
+
+		// get rid of the status field, since now there will be a new reconciliation
+		// process and the current status no longer applies.
+		rel.Status = helmv2.HelmReleaseStatus{}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.V(4).Infof("Rolled back release: %s", common.PrettyPrint(rel))
+
+	return &corev1.InstalledPackageReference{
+		Context: &corev1.Context{
+			Namespace: packageRef.Context.Namespace,
+			Cluster:   cluster,
+		},
+		Identifier: packageRef.Identifier,
+		Plugin:     GetPluginDetail(),
+	}, nil
+}
+
+func (s *Server) newRelease(ctx context.Context, cluster string, packageRef *corev1.AvailablePackageReference, targetName types.NamespacedName, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string, customDetail *anypb.Any) (*corev1.InstalledPackageReference, error) {
 	repoName, chartName, err := pkgutils.SplitPackageIdentifier(packageRef.Identifier)
 	if err != nil {
 		return nil, err
 	}
 
 	repo := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: repoName}
-	chart, err := s.getChart(ctx, repo, chartName)
+	chart, err := s.getChart(ctx, cluster, repo, chartName)
 	if err != nil {
 		return nil, err
+	} else if chart == nil {
+		return nil, status.Errorf(codes.NotFound, "chart [%s] not found", packageRef.Identifier)
 	}
 
 	var values map[string]interface{}
@@ -336,6 +652,17 @@ func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePac
 		}
 	}
 
+	var valuesFrom []helmv2.ValuesReference
+	if customDetail != nil {
+		if valuesFrom, err = valuesFromCustomDetail(customDetail); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.validateValues(ctx, cluster, packageRef, chart, versionRef.GetVersion(), valuesString); err != nil {
+		return nil, err
+	}
+
 	// Calculate the version constraints
 	versionExpr := versionRef.GetVersion()
 	if versionExpr != "" {
@@ -346,7 +673,7 @@ func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePac
 		}
 	}
 
-	fluxRelease, err := s.newFluxHelmRelease(chart, targetName, versionExpr, reconcile, values)
+	fluxRelease, err := s.newFluxHelmRelease(chart, targetName, versionExpr, reconcile, values, valuesFrom)
 	if err != nil {
 		return nil, err
 	}
@@ -354,7 +681,7 @@ func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePac
 	// per https://github.com/vmware-tanzu/kubeapps/pull/3640#issuecomment-949315105
 	// the helm release CR to also be created in the target namespace (where the helm
 	// release itself is currently created)
-	client, err := s.getClient(ctx, targetName.Namespace)
+	client, err := s.getClient(ctx, cluster, targetName.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -366,127 +693,243 @@ func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePac
 	return &corev1.InstalledPackageReference{
 		Context: &corev1.Context{
 			Namespace: targetName.Namespace,
-			Cluster:   s.kubeappsCluster,
+			Cluster:   cluster,
 		},
 		Identifier: targetName.Name,
 		Plugin:     GetPluginDetail(),
 	}, nil
 }
 
-func (s *Server) updateRelease(ctx context.Context, packageRef *corev1.InstalledPackageReference, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string) (*corev1.InstalledPackageReference, error) {
+func (s *Server) updateRelease(ctx context.Context, cluster string, packageRef *corev1.InstalledPackageReference, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string, customDetail *anypb.Any) (*corev1.InstalledPackageReference, error) {
 	key := types.NamespacedName{Name: packageRef.Identifier, Namespace: packageRef.Context.Namespace}
 
-	rel, err := s.getReleaseInCluster(ctx, key)
-	if err != nil {
-		return nil, err
-	}
-
-	// TODO (gfichtenholt): there is an intermittent issue
-	// rpc error: code = Internal desc = unable to update the HelmRelease
-	// 'test-12-i7a4/my-podinfo-12' due to 'Operation cannot be fulfilled on
-	// helmreleases.helm.toolkit.fluxcd.io "my-podinfo-12": the object has been
-	// modified; please apply your changes to the latest version and try again'
-	// the problem is
-	//  1) we get a CR then
-	//  2) do some modifications of this CR
-	//  3) call Update() with this CR.
-	// Every once in a while there the CR gets updated (by flux) between (1) and (3)
-	// and we get this error.
-	// I think one way to fix it would be to implement a fixed number of retries in
-	// flux plugin with exponential back-off.
-	// Another solution might be to push the decision all the way to the end user to retry
-	// the Update operation
-
-	// As Michael and I agreed 4/12/2022, initially we'll disallow updates to pending releases
-	// to simplify the initial case, though we may implement support later. Updates to
-	// non-pending releases  (i.e. success or failed status) are allowed
-	_, reason, _ := isHelmReleaseReady(*rel)
-	if reason == corev1.InstalledPackageStatus_STATUS_REASON_PENDING {
-		return nil, status.Errorf(codes.Internal, "updates to helm releases pending reconciliation are not supported")
-	}
-
-	versionExpr := versionRef.GetVersion()
-	if versionExpr != "" {
-		versionExpr, err = pkgutils.VersionConstraintWithUpgradePolicy(
-			versionRef.GetVersion(), s.pluginConfig.DefaultUpgradePolicy)
-		if err != nil {
-			return nil, err
+	// Updates to releases pending reconciliation are allowed: the new spec results
+	// in a new generation of the HelmRelease, which the helm-controller reconciles
+	// once it is done with the current one, i.e. the update is queued behind it.
+	rel, err := s.updateReleaseInCluster(ctx, cluster, key, func(rel *helmv2.HelmRelease) error {
+		if versionRef.GetVersion() != "" {
+			versionExpr, err := pkgutils.VersionConstraintWithUpgradePolicy(
+				versionRef.GetVersion(), s.pluginConfig.DefaultUpgradePolicy)
+			if err != nil {
+				return err
+			}
+			rel.Spec.Chart.Spec.Version = versionExpr
+		} else {
+			rel.Spec.Chart.Spec.Version = ""
 		}
-		rel.Spec.Chart.Spec.Version = versionExpr
-	} else {
-		rel.Spec.Chart.Spec.Version = ""
-	}
 
-	if valuesString != "" {
-		// could be JSON or YAML
-		var values map[string]interface{}
-		if err = yaml.Unmarshal([]byte(valuesString), &values); err != nil {
-			return nil, err
-		}
-		byteArray, err := json.Marshal(values)
-		if err != nil {
-			return nil, err
-		}
-		rel.Spec.Values = &v1.JSON{Raw: byteArray}
-	} else {
-		rel.Spec.Values = nil
-	}
+		if valuesString != "" {
+			packageRef, err := installedPackageAvailablePackageRef(rel)
+			if err != nil {
+				return err
+			}
+			repoName, chartName, err := pkgutils.SplitPackageIdentifier(packageRef.Identifier)
+			if err != nil {
+				return err
+			}
+			chart, err := s.getChart(ctx, cluster, types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: repoName}, chartName)
+			if err != nil {
+				return err
+			}
+			if err = s.validateValues(ctx, cluster, packageRef, chart, versionRef.GetVersion(), valuesString); err != nil {
+				return err
+			}
 
-	setInterval, setServiceAccount := false, false
-	if reconcile != nil {
-		if reconcile.Interval != "" {
-			reconcileInterval, err := pkgutils.ToDuration(reconcile.Interval)
+			// could be JSON or YAML
+			var values map[string]interface{}
+			if err := yaml.Unmarshal([]byte(valuesString), &values); err != nil {
+				return err
+			}
+			byteArray, err := json.Marshal(values)
 			if err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "the reconciliation interval is invalid: %v", err)
+				return err
 			}
-			rel.Spec.Interval = *reconcileInterval
-			setInterval = true
+			rel.Spec.Values = &v1.JSON{Raw: byteArray}
+		} else {
+			rel.Spec.Values = nil
 		}
-		if reconcile.ServiceAccountName != "" {
-			rel.Spec.ServiceAccountName = reconcile.ServiceAccountName
-			setServiceAccount = true
+
+		// unlike the inline values, the references to config maps and secrets are kept
+		// as they are unless the request says otherwise, since clients that are not
+		// aware of them would otherwise remove them on every update
+		if customDetail != nil {
+			valuesFrom, err := valuesFromCustomDetail(customDetail)
+			if err != nil {
+				return err
+			}
+			rel.Spec.ValuesFrom = valuesFrom
 		}
 
-		rel.Spec.Suspend = reconcile.Suspend
-	}
+		setInterval, setServiceAccount := false, false
+		if reconcile != nil {
+			if reconcile.Interval != "" {
+				reconcileInterval, err := pkgutils.ToDuration(reconcile.Interval)
+				if err != nil {
+					return status.Errorf(codes.InvalidArgument, "the reconciliation interval is invalid: %v", err)
+				}
+				rel.Spec.Interval = *reconcileInterval
+				setInterval = true
+			}
+			if reconcile.ServiceAccountName != "" {
+				rel.Spec.ServiceAccountName = reconcile.ServiceAccountName
+				setServiceAccount = true
+			}
 
-	if !setInterval {
-		// interval is a required field
-		rel.Spec.Interval = defaultReconcileInterval
-	}
-	if !setServiceAccount {
-		rel.Spec.ServiceAccountName = ""
-	}
+			rel.Spec.Suspend = reconcile.Suspend
+		}
 
-	// get rid of the status field, since now there will be a new reconciliation
-	// process and the current status no longer applies. metadata and spec I want
-	// to keep, as they may have had added labels and/or annotations and/or
-	// even other changes made by the user.
-	rel.Status = helmv2.HelmReleaseStatus{}
+		if !setInterval {
+			// interval is a required field
+			rel.Spec.Interval = defaultReconcileInterval
+		}
+		if !setServiceAccount {
+			rel.Spec.ServiceAccountName = ""
+		}
 
-	client, err := s.getClient(ctx, packageRef.Context.Namespace)
+		// get rid of the status field, since now there will be a new reconciliation
+		// process and the current status no longer applies. metadata and spec I want
+		// to keep, as they may have had added labels and/or annotations and/or
+		// even other changes made by the user.
+		rel.Status = helmv2.HelmReleaseStatus{}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = client.Update(ctx, rel); err != nil {
-		return nil, statuserror.FromK8sError("update", "HelmRelease", key.String(), err)
-	}
-
 	log.V(4).Infof("Updated release: %s", common.PrettyPrint(rel))
 
 	return &corev1.InstalledPackageReference{
 		Context: &corev1.Context{
 			Namespace: packageRef.Context.Namespace,
-			Cluster:   s.kubeappsCluster,
+			Cluster:   cluster,
 		},
 		Identifier: packageRef.Identifier,
 		Plugin:     GetPluginDetail(),
 	}, nil
 }
 
-func (s *Server) deleteRelease(ctx context.Context, packageRef *corev1.InstalledPackageReference) error {
-	client, err := s.getClient(ctx, packageRef.Context.Namespace)
+// updateReleaseInCluster applies the given changes to the latest version of a
+// HelmRelease and updates it. Flux updates the status of a HelmRelease while
+// reconciling it, so when the HelmRelease has been modified in the meantime,
+// the changes are applied again to the then latest version.
+// validateValues validates the values against the values schema of the chart
+// version to be installed, that is the latest version of the chart satisfying
+// the given version or constraint, which is fetched through the chart cache.
+// Nothing is validated without values or without such a version, leaving the
+// helm-controller to report the error.
+func (s *Server) validateValues(ctx context.Context, cluster string, packageRef *corev1.AvailablePackageReference, chart *models.Chart, version, values string) error {
+	if values == "" || chart == nil {
+		return nil
+	}
+	chartVersion, err := latestChartVersionSatisfying(chart, version)
+	if err != nil || chartVersion == "" {
+		return err
+	}
+	pkgDetail, err := s.availableChartDetail(ctx, cluster, packageRef, chartVersion)
+	if err != nil {
+		return err
+	}
+	defaults := map[string]interface{}{}
+	if err = yaml.Unmarshal([]byte(pkgDetail.DefaultValues), &defaults); err != nil {
+		return status.Errorf(codes.Internal, "unable to parse the default values of chart [%s]: %v", packageRef.Identifier, err)
+	}
+	return valuesschema.Validate(values, defaults, []byte(pkgDetail.ValuesSchema))
+}
+
+// latestChartVersionSatisfying returns the latest version of the chart which
+// satisfies the given version or constraint, or the latest version of the chart
+// if none is given.
+func latestChartVersionSatisfying(chart *models.Chart, version string) (string, error) {
+	if version == "" {
+		if len(chart.ChartVersions) == 0 {
+			return "", nil
+		}
+		return chart.ChartVersions[0].Version, nil
+	}
+	constraint, err := semver.NewConstraint(version)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid version [%s]: %v", version, err)
+	}
+	for _, cv := range chart.ChartVersions {
+		if v, err := semver.NewVersion(cv.Version); err == nil && constraint.Check(v) {
+			return cv.Version, nil
+		}
+	}
+	return "", nil
+}
+
+func (s *Server) updateReleaseInCluster(ctx context.Context, cluster string, key types.NamespacedName, applyChanges func(rel *helmv2.HelmRelease) error) (*helmv2.HelmRelease, error) {
+	client, err := s.getClient(ctx, cluster, key.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var rel helmv2.HelmRelease
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		rel = helmv2.HelmRelease{}
+		if err := client.Get(ctx, key, &rel); err != nil {
+			return statuserror.FromK8sError("get", "HelmRelease", key.String(), err)
+		}
+		if err := applyChanges(&rel); err != nil {
+			return err
+		}
+		if err := client.Update(ctx, &rel); err != nil {
+			if errors.IsConflict(err) {
+				// retried
+				return err
+			}
+			return statuserror.FromK8sError("update", "HelmRelease", key.String(), err)
+		}
+		return nil
+	})
+	if errors.IsConflict(err) {
+		return nil, statuserror.FromK8sError("update", "HelmRelease", key.String(), err)
+	} else if err != nil {
+		return nil, err
+	}
+	return &rel, nil
+}
+
+// setReleaseSuspended suspends or resumes the reconciliation of a HelmRelease
+func (s *Server) setReleaseSuspended(ctx context.Context, cluster string, packageRef *corev1.InstalledPackageReference, suspend bool) (*helmv2.HelmRelease, error) {
+	key := types.NamespacedName{Name: packageRef.Identifier, Namespace: packageRef.Context.Namespace}
+	rel, err := s.updateReleaseInCluster(ctx, cluster, key, func(rel *helmv2.HelmRelease) error {
+		rel.Spec.Suspend = suspend
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.V(4).Infof("Set suspend of release [%s] to [%t]", key, suspend)
+	return rel, nil
+}
+
+// reconcileRelease requests that the helm-controller reconciles the HelmRelease
+// now, rather than at the next interval, which flux does whenever the value of
+// the reconcile request annotation changes.
+func (s *Server) reconcileRelease(ctx context.Context, cluster string, packageRef *corev1.InstalledPackageReference) (*helmv2.HelmRelease, error) {
+	key := types.NamespacedName{Name: packageRef.Identifier, Namespace: packageRef.Context.Namespace}
+	rel, err := s.updateReleaseInCluster(ctx, cluster, key, func(rel *helmv2.HelmRelease) error {
+		// flux ignores reconcile requests for suspended resources
+		if rel.Spec.Suspend {
+			return status.Errorf(codes.FailedPrecondition, "the HelmRelease [%s] is suspended", key)
+		}
+		if rel.Annotations == nil {
+			rel.Annotations = map[string]string{}
+		}
+		rel.Annotations[fluxmeta.ReconcileRequestAnnotation] = time.Now().Format(time.RFC3339Nano)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.V(4).Infof("Requested reconciliation of release: [%s]", key)
+	return rel, nil
+}
+
+func (s *Server) deleteRelease(ctx context.Context, cluster string, packageRef *corev1.InstalledPackageReference) error {
+	client, err := s.getClient(ctx, cluster, packageRef.Context.Namespace)
 	if err != nil {
 		return err
 	}
@@ -511,7 +954,17 @@ func (s *Server) deleteRelease(ctx context.Context, packageRef *corev1.Installed
 // 2. metadata.namespace, where this HelmRelease CRD will exist, same as (3) below
 //    per https://github.com/vmware-tanzu/kubeapps/pull/3640#issuecomment-949315105
 // 3. spec.targetNamespace, where flux will install any artifacts from the release
-func (s *Server) newFluxHelmRelease(chart *models.Chart, targetName types.NamespacedName, versionExpr string, reconcile *corev1.ReconciliationOptions, values map[string]interface{}) (*helmv2.HelmRelease, error) {
+func (s *Server) newFluxHelmRelease(chart *models.Chart, targetName types.NamespacedName, versionExpr string, reconcile *corev1.ReconciliationOptions, values map[string]interface{}, valuesFrom []helmv2.ValuesReference) (*helmv2.HelmRelease, error) {
+	// charts may also come from GitRepositories and Buckets
+	sourceKind := sourcev1.HelmRepositoryKind
+	if kind := chartSourceKindForRepoType(chart.Repo.Type); kind != nil {
+		sourceKind = kind.kind
+	}
+	chartPath, err := chartPathInSource(chart)
+	if err != nil {
+		return nil, err
+	}
+
 	fluxRelease := &helmv2.HelmRelease{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      targetName.Name,
@@ -520,10 +973,10 @@ func (s *Server) newFluxHelmRelease(chart *models.Chart, targetName types.Namesp
 		Spec: helmv2.HelmReleaseSpec{
 			Chart: helmv2.HelmChartTemplate{
 				Spec: helmv2.HelmChartTemplateSpec{
-					Chart: chart.Name,
+					Chart: chartPath,
 					SourceRef: helmv2.CrossNamespaceObjectReference{
 						Name:      chart.Repo.Name,
-						Kind:      sourcev1.HelmRepositoryKind,
+						Kind:      sourceKind,
 						Namespace: chart.Repo.Namespace,
 					},
 				},
@@ -555,6 +1008,9 @@ func (s *Server) newFluxHelmRelease(chart *models.Chart, targetName types.Namesp
 		}
 		fluxRelease.Spec.Values = &v1.JSON{Raw: byteArray}
 	}
+	if len(valuesFrom) > 0 {
+		fluxRelease.Spec.ValuesFrom = valuesFrom
+	}
 
 	// required fields, without which flux controller will fail to create the CRD
 	fluxRelease.Spec.Interval = reconcileInterval
@@ -647,7 +1103,7 @@ func installedPackageAvailablePackageRef(rel *helmv2.HelmRelease) (*corev1.Avail
 	if repoName == "" {
 		return nil, status.Errorf(codes.Internal, "missing required field spec.chart.spec.sourceRef.name")
 	}
-	chartName := rel.Spec.Chart.Spec.Chart
+	chartName := chartNameOfRelease(rel)
 	if chartName == "" {
 		return nil, status.Errorf(codes.Internal, "missing required field spec.chart.spec.chart")
 	}
@@ -694,3 +1150,192 @@ func checkReleaseGeneration(rel helmv2.HelmRelease) bool {
 	observedGeneration := rel.Status.ObservedGeneration
 	return generation > 0 && generation == observedGeneration
 }
+
+// valuesFromCustomDetail returns the references to config maps and secrets the
+// values of a release are taken from, as given in the custom detail of a request
+func valuesFromCustomDetail(customDetail *anypb.Any) ([]helmv2.ValuesReference, error) {
+	detail := &v1alpha1.FluxPackageInstallCustomDetail{}
+	if err := customDetail.UnmarshalTo(detail); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "custom detail is not a valid FluxPackageInstallCustomDetail: %v", err)
+	}
+
+	valuesFrom := []helmv2.ValuesReference{}
+	for i, ref := range detail.GetValuesFrom() {
+		if ref.GetKind() != valuesFromKindSecret && ref.GetKind() != valuesFromKindConfigMap {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"valuesFrom[%d]: kind must be either %s or %s, got: [%s]",
+				i, valuesFromKindSecret, valuesFromKindConfigMap, ref.GetKind())
+		} else if ref.GetName() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "valuesFrom[%d]: name is required", i)
+		}
+		valuesFrom = append(valuesFrom, helmv2.ValuesReference{
+			Kind:       ref.GetKind(),
+			Name:       ref.GetName(),
+			ValuesKey:  ref.GetValuesKey(),
+			TargetPath: ref.GetTargetPath(),
+			Optional:   ref.GetOptional(),
+		})
+	}
+	return valuesFrom, nil
+}
+
+// readValuesFrom returns the values currently stored in each of the config maps and
+// secrets referenced by the release, in the same order. The values of a reference are
+// empty if the resource or key doesn't exist or cannot be read by the caller. The
+// values of secrets are redacted, since they are returned to the caller
+func (s *Server) readValuesFrom(ctx context.Context, cluster string, rel *helmv2.HelmRelease) []string {
+	sources := make([]string, len(rel.Spec.ValuesFrom))
+	typedClient, err := s.clientGetter.Typed(ctx, cluster)
+	if err != nil {
+		log.Warningf("Failed to get client to read values of release [%s/%s] due to: %v", rel.Namespace, rel.Name, err)
+		return sources
+	}
+
+	for i, ref := range rel.Spec.ValuesFrom {
+		switch ref.Kind {
+		case valuesFromKindSecret:
+			if secret, err := typedClient.CoreV1().Secrets(rel.Namespace).Get(ctx, ref.Name, metav1.GetOptions{}); err != nil {
+				log.Warningf("Failed to get secret [%s/%s] due to: %v", rel.Namespace, ref.Name, err)
+			} else if data, ok := secret.Data[ref.GetValuesKey()]; ok {
+				sources[i] = redactValues(string(data), ref)
+			}
+		case valuesFromKindConfigMap:
+			if configMap, err := typedClient.CoreV1().ConfigMaps(rel.Namespace).Get(ctx, ref.Name, metav1.GetOptions{}); err != nil {
+				log.Warningf("Failed to get config map [%s/%s] due to: %v", rel.Namespace, ref.Name, err)
+			} else if data, ok := configMap.Data[ref.GetValuesKey()]; ok {
+				sources[i] = data
+			}
+		default:
+			log.Warningf("Unsupported kind of values reference: [%s]", ref.Kind)
+		}
+	}
+	return sources
+}
+
+// redactValues returns the given values with each leaf value replaced by
+// redactedValue, so only the keys set by a secret are known. The value of a
+// reference with a target path is a single value, which is replaced as a whole
+func redactValues(data string, ref helmv2.ValuesReference) string {
+	if ref.TargetPath != "" {
+		return redactedValue
+	}
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(data), &values); err != nil {
+		log.Warningf("Failed to read values from %s [%s] due to: %v", ref.Kind, ref.Name, err)
+		return ""
+	}
+	redacted, err := yaml.Marshal(redactLeaves(values))
+	if err != nil {
+		log.Warningf("Failed to redact values from %s [%s] due to: %v", ref.Kind, ref.Name, err)
+		return ""
+	}
+	return string(redacted)
+}
+
+func redactLeaves(values map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if m, ok := v.(map[string]interface{}); ok {
+			out[k] = redactLeaves(m)
+		} else {
+			out[k] = redactedValue
+		}
+	}
+	return out
+}
+
+// effectiveReleaseValues merges the values read from the config maps and secrets
+// referenced by the release, in order, followed by the inline values, the same way
+// flux helm-controller does. Empty sources are skipped
+// ref https://github.com/fluxcd/helm-controller/blob/v0.22.1/controllers/helmrelease_controller.go
+func effectiveReleaseValues(rel *helmv2.HelmRelease, sources []string) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	for i, ref := range rel.Spec.ValuesFrom {
+		if i >= len(sources) || sources[i] == "" {
+			continue
+		}
+		if ref.TargetPath != "" {
+			// the value is expected to be a single flat value, set at the given path
+			if err := strvals.ParseInto(fmt.Sprintf("%s=%s", ref.TargetPath, strings.TrimSpace(sources[i])), result); err != nil {
+				return nil, fmt.Errorf("unable to merge value from %s [%s] at path [%s]: %v", ref.Kind, ref.Name, ref.TargetPath, err)
+			}
+		} else {
+			values := map[string]interface{}{}
+			if err := yaml.Unmarshal([]byte(sources[i]), &values); err != nil {
+				return nil, fmt.Errorf("unable to read values from %s [%s]: %v", ref.Kind, ref.Name, err)
+			}
+			result = mergeValues(result, values)
+		}
+	}
+	return mergeValues(result, rel.GetValues()), nil
+}
+
+// valuesFromKeys returns the values of the config maps and secrets referenced by the
+// release, merged the same way flux helm-controller does. The values of secrets are
+// redacted: only the keys set by the references are of use.
+func (s *Server) valuesFromKeys(ctx context.Context, cluster string, rel *helmv2.HelmRelease) (map[string]interface{}, error) {
+	sources := s.readValuesFrom(ctx, cluster, rel)
+	refsOnly := rel.DeepCopy()
+	refsOnly.Spec.Values = nil
+	return effectiveReleaseValues(refsOnly, sources)
+}
+
+// inlineValues returns the values applied by a helm release without the keys set by
+// the config maps and secrets referenced by the HelmRelease, i.e. the values flux
+// took from the inline values of the HelmRelease. Inline values overriding the keys
+// of a reference cannot be told apart from the values of the reference, so they are
+// left out too, rather than copying the values of secrets in plain text
+func inlineValues(values, valuesFrom map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		fromV, ok := valuesFrom[k]
+		if !ok {
+			out[k] = v
+			continue
+		}
+		vm, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if fromM, ok := fromV.(map[string]interface{}); ok {
+			if nested := inlineValues(vm, fromM); len(nested) > 0 {
+				out[k] = nested
+			}
+		}
+	}
+	return out
+}
+
+// mergeValues merges b into a, with the values in b taking precedence, recursing
+// into nested maps
+func mergeValues(a, b map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(a))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		if bv, ok := v.(map[string]interface{}); ok {
+			if av, ok := out[k].(map[string]interface{}); ok {
+				out[k] = mergeValues(av, bv)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+func valuesReferencesForDetail(valuesFrom []helmv2.ValuesReference, sources []string) []*v1alpha1.ValuesReference {
+	refs := []*v1alpha1.ValuesReference{}
+	for i, ref := range valuesFrom {
+		refs = append(refs, &v1alpha1.ValuesReference{
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+			ValuesKey:  ref.ValuesKey,
+			TargetPath: ref.TargetPath,
+			Optional:   ref.Optional,
+			Values:     sources[i],
+		})
+	}
+	return refs
+}
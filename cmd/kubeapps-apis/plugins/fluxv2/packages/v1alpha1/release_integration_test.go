@@ -114,7 +114,7 @@ func TestKindClusterCreateInstalledPackage(t *testing.T) {
 			testName:           "wrong cluster",
 			repoUrl:            podinfo_repo_url,
 			request:            create_request_wrong_cluster,
-			expectedStatusCode: codes.Unimplemented,
+			expectedStatusCode: codes.InvalidArgument,
 		},
 		{
 			testName:           "target namespace does not exist",
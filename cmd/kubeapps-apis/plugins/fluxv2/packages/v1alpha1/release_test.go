@@ -15,19 +15,27 @@ import (
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	fluxmeta "github.com/fluxcd/pkg/apis/meta"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	redismock "github.com/go-redis/redismock/v8"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	fluxplugin "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/resourcerefs/resourcerefstest"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"helm.sh/helm/v3/pkg/release"
+	apiv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	typfake "k8s.io/client-go/kubernetes/fake"
 )
 
 type testSpecGetInstalledPackages struct {
@@ -133,6 +141,25 @@ func TestGetInstalledPackageSummariesWithoutPagination(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "returns only installed packages matching the filter options",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{Namespace: ""},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					Query: "airflow",
+				},
+			},
+			existingObjs: []testSpecGetInstalledPackages{
+				redis_existing_spec_completed,
+				airflow_existing_spec_completed,
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					airflow_summary_installed,
+				},
+			},
+		},
 		{
 			name: "returns installed package with semver constraint expression",
 			request: &corev1.GetInstalledPackageSummariesRequest{
@@ -378,6 +405,8 @@ type helmReleaseStub struct {
 	notes        string
 	manifest     string
 	status       release.Status
+	version      int
+	values       map[string]interface{}
 }
 
 func TestGetInstalledPackageDetail(t *testing.T) {
@@ -493,6 +522,249 @@ func TestGetInstalledPackageDetail(t *testing.T) {
 	}
 }
 
+func TestGetInstalledPackageRevisions(t *testing.T) {
+	redis_existing_stubs_history := []helmReleaseStub{
+		{
+			name:         "my-redis",
+			namespace:    "test",
+			chartVersion: "14.3.4",
+			status:       release.StatusSuperseded,
+			version:      1,
+			values:       map[string]interface{}{"replicaCount": float64(1)},
+		},
+		{
+			name:         "my-redis",
+			namespace:    "test",
+			chartVersion: "14.4.0",
+			status:       release.StatusDeployed,
+			version:      2,
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		request            *corev1.GetInstalledPackageRevisionsRequest
+		existingK8sObjs    testSpecGetInstalledPackages
+		existingHelmStubs  []helmReleaseStub
+		expectedStatusCode codes.Code
+		expectedResponse   *corev1.GetInstalledPackageRevisionsResponse
+	}{
+		{
+			name: "returns the revisions of the helm release history",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: my_redis_ref,
+			},
+			existingK8sObjs:    redis_existing_spec_completed,
+			existingHelmStubs:  redis_existing_stubs_history,
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageRevisionsResponse{
+				Revisions: []*corev1.InstalledPackageRevision{
+					{
+						ReleaseRevision: 2,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "14.4.0",
+							AppVersion: "1.2.3",
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Ready:      true,
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+							UserReason: "deployed",
+						},
+					},
+					{
+						ReleaseRevision: 1,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "14.3.4",
+							AppVersion: "1.2.3",
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED,
+							UserReason: "superseded",
+						},
+						ValuesApplied: `{"replicaCount":1}`,
+					},
+				},
+			},
+		},
+		{
+			name: "returns no revisions if flux has not created the helm release yet",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: my_redis_ref,
+			},
+			existingK8sObjs:    redis_existing_spec_pending,
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageRevisionsResponse{
+				Revisions: []*corev1.InstalledPackageRevision{},
+			},
+		},
+		{
+			name: "returns a 404 if the installed package is not found",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: installedRef("dontworrybehappy", "namespace-1"),
+			},
+			existingK8sObjs:    redis_existing_spec_completed,
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			charts, repos, cleanup := newChartsAndReleases(t, []testSpecGetInstalledPackages{tc.existingK8sObjs})
+			defer cleanup()
+			actionConfig := newHelmActionConfig(t, tc.existingK8sObjs.releaseNamespace, tc.existingHelmStubs)
+			s, _, err := newServerWithChartsAndReleases(t, actionConfig, charts, repos)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			response, err := s.GetInstalledPackageRevisions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// We don't need to check anything else for non-OK codes.
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			opts := cmpopts.IgnoreUnexported(
+				corev1.GetInstalledPackageRevisionsResponse{},
+				corev1.InstalledPackageRevision{},
+				corev1.InstalledPackageStatus{},
+				corev1.PackageAppVersion{},
+				timestamppb.Timestamp{})
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
+func TestRollbackInstalledPackage(t *testing.T) {
+	redis_existing_stubs_history := []helmReleaseStub{
+		{
+			name:         "my-redis",
+			namespace:    "test",
+			chartVersion: "14.3.4",
+			status:       release.StatusSuperseded,
+			version:      1,
+			values:       map[string]interface{}{"replicaCount": float64(1)},
+		},
+		{
+			name:         "my-redis",
+			namespace:    "test",
+			chartVersion: "14.4.0",
+			status:       release.StatusDeployed,
+			version:      2,
+		},
+	}
+
+	testCases := []struct {
+		name                    string
+		request                 *corev1.RollbackInstalledPackageRequest
+		existingK8sObjs         testSpecGetInstalledPackages
+		defaultUpgradePolicyStr string
+		expectedStatusCode      codes.Code
+		expectedVersion         string
+		expectedValues          *v1.JSON
+	}{
+		{
+			name: "rolls back to the chart version and inline values of the revision",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: my_redis_ref,
+				ReleaseRevision:     1,
+			},
+			existingK8sObjs:    redis_existing_spec_completed,
+			expectedStatusCode: codes.OK,
+			expectedVersion:    "14.3.4",
+			expectedValues:     &v1.JSON{Raw: []byte(`{"replicaCount": 1}`)},
+		},
+		{
+			name: "rolls back with the version constraint of the default upgrade policy",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: my_redis_ref,
+				ReleaseRevision:     1,
+			},
+			existingK8sObjs:         redis_existing_spec_completed,
+			defaultUpgradePolicyStr: "minor",
+			expectedStatusCode:      codes.OK,
+			expectedVersion:         ">=14.3.4 <15.0.0",
+			expectedValues:          &v1.JSON{Raw: []byte(`{"replicaCount": 1}`)},
+		},
+		{
+			name: "rollback of a release pending reconciliation is queued",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: my_redis_ref,
+				ReleaseRevision:     2,
+			},
+			existingK8sObjs:    redis_existing_spec_pending,
+			expectedStatusCode: codes.OK,
+			expectedVersion:    "14.4.0",
+		},
+		{
+			name: "returns a 404 if the revision is not found",
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: my_redis_ref,
+				ReleaseRevision:     3,
+			},
+			existingK8sObjs:    redis_existing_spec_completed,
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			charts, releases, cleanup := newChartsAndReleases(t, []testSpecGetInstalledPackages{tc.existingK8sObjs})
+			defer cleanup()
+			actionConfig := newHelmActionConfig(t, tc.existingK8sObjs.releaseNamespace, redis_existing_stubs_history)
+			s, _, err := newServerWithChartsAndReleases(t, actionConfig, charts, releases)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if tc.defaultUpgradePolicyStr != "" {
+				policy, err := pkgutils.UpgradePolicyFromString(tc.defaultUpgradePolicyStr)
+				if err != nil {
+					t.Fatal(err)
+				}
+				s.pluginConfig.DefaultUpgradePolicy = policy
+			}
+
+			_, err = s.RollbackInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// We don't need to check anything else for non-OK codes.
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			key := types.NamespacedName{
+				Namespace: my_redis_ref.Context.Namespace,
+				Name:      my_redis_ref.Identifier,
+			}
+			ctx := context.Background()
+			var actualRel helmv2.HelmRelease
+			if ctrlClient, err := s.clientGetter.ControllerRuntime(ctx, s.kubeappsCluster); err != nil {
+				t.Fatal(err)
+			} else if err = ctrlClient.Get(ctx, key, &actualRel); err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := actualRel.Spec.Chart.Spec.Version, tc.expectedVersion; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			compareJSON(t, tc.expectedValues, actualRel.Spec.Values)
+			if got, want := actualRel.Status, (helmv2.HelmReleaseStatus{}); !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
 type testSpecCreateInstalledPackage struct {
 	repoName      string
 	repoNamespace string
@@ -508,6 +780,8 @@ func TestCreateInstalledPackage(t *testing.T) {
 		expectedResponse        *corev1.CreateInstalledPackageResponse
 		expectedRelease         *helmv2.HelmRelease
 		defaultUpgradePolicyStr string
+		// the chart whose values schema the values are validated against
+		validatedChart *testSpecChartWithFile
 	}{
 		{
 			name:    "create package (simple)",
@@ -556,6 +830,7 @@ func TestCreateInstalledPackage(t *testing.T) {
 			expectedStatusCode: codes.OK,
 			expectedResponse:   create_installed_package_resp_my_podinfo,
 			expectedRelease:    flux_helm_release_values,
+			validatedChart:     &podinfo_6_0_0_chart_spec,
 		},
 		{
 			name:    "create package (values YAML override)",
@@ -568,6 +843,40 @@ func TestCreateInstalledPackage(t *testing.T) {
 			expectedStatusCode: codes.OK,
 			expectedResponse:   create_installed_package_resp_my_podinfo,
 			expectedRelease:    flux_helm_release_values,
+			validatedChart:     &podinfo_6_0_0_chart_spec,
+		},
+		{
+			name:    "create package (values from)",
+			request: create_package_values_from_req,
+			existingObjs: testSpecCreateInstalledPackage{
+				repoName:      "podinfo",
+				repoNamespace: "namespace-1",
+				repoIndex:     testYaml("podinfo-index.yaml"),
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse:   create_installed_package_resp_my_podinfo,
+			expectedRelease:    flux_helm_release_values_from,
+		},
+		{
+			name:    "returns invalid argument for values from an unsupported kind",
+			request: create_package_values_from_bad_kind_req,
+			existingObjs: testSpecCreateInstalledPackage{
+				repoName:      "podinfo",
+				repoNamespace: "namespace-1",
+				repoIndex:     testYaml("podinfo-index.yaml"),
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:    "returns invalid argument for values not satisfying the values schema",
+			request: create_package_values_not_satisfying_schema_req,
+			existingObjs: testSpecCreateInstalledPackage{
+				repoName:      "bitnami-1",
+				repoNamespace: "namespace-1",
+				repoIndex:     testYaml("redis-many-versions.yaml"),
+			},
+			expectedStatusCode: codes.InvalidArgument,
+			validatedChart:     &redis_charts_spec[0],
 		},
 		{
 			name:    "create with specific version (upgrade policy none)",
@@ -632,7 +941,12 @@ func TestCreateInstalledPackage(t *testing.T) {
 			}
 			defer ts.Close()
 
-			s, mock, err := newSimpleServerWithRepos(t, []sourcev1.HelmRepository{*repo})
+			var charts []testSpecChartWithUrl
+			if tc.validatedChart != nil {
+				// the values are validated against a chart from the chart cache
+				charts = []testSpecChartWithUrl{}
+			}
+			s, mock, err := newServerWithRepos(t, []sourcev1.HelmRepository{*repo}, charts, nil)
 			if err != nil {
 				t.Fatalf("%+v", err)
 			}
@@ -643,6 +957,9 @@ func TestCreateInstalledPackage(t *testing.T) {
 			}
 
 			mock.ExpectGet(redisKey).SetVal(string(bytes))
+			if tc.validatedChart != nil {
+				s.redisMockExpectGetChartForValues(t, mock, *repo, *tc.validatedChart)
+			}
 
 			if tc.defaultUpgradePolicyStr != "" {
 				policy, err := pkgutils.UpgradePolicyFromString(tc.defaultUpgradePolicyStr)
@@ -710,6 +1027,8 @@ func TestUpdateInstalledPackage(t *testing.T) {
 		expectedResponse        *corev1.UpdateInstalledPackageResponse
 		expectedRelease         *helmv2.HelmRelease
 		defaultUpgradePolicyStr string
+		// the chart whose values schema the values are validated against
+		validatedChart *testSpecChartWithFile
 	}{
 		{
 			name: "update package (simple)",
@@ -763,6 +1082,7 @@ func TestUpdateInstalledPackage(t *testing.T) {
 				InstalledPackageRef: my_redis_ref,
 			},
 			expectedRelease: flux_helm_release_updated_2,
+			validatedChart:  &redis_charts_spec[0],
 		},
 		{
 			name: "update package (values YAML override)",
@@ -776,6 +1096,17 @@ func TestUpdateInstalledPackage(t *testing.T) {
 				InstalledPackageRef: my_redis_ref,
 			},
 			expectedRelease: flux_helm_release_updated_2,
+			validatedChart:  &redis_charts_spec[0],
+		},
+		{
+			name: "returns invalid argument for values not satisfying the values schema",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: my_redis_ref,
+				Values:              "{\"architecture\": 1}",
+			},
+			existingK8sObjs:    &redis_existing_spec_completed,
+			expectedStatusCode: codes.InvalidArgument,
+			validatedChart:     &redis_charts_spec[0],
 		},
 		{
 			name: "update package (default upgrade policy major)",
@@ -826,15 +1157,19 @@ func TestUpdateInstalledPackage(t *testing.T) {
 			defaultUpgradePolicyStr: "patch",
 		},
 		{
-			name: "update package pending reconciliation errors",
+			name: "update package pending reconciliation is queued",
 			request: &corev1.UpdateInstalledPackageRequest{
 				InstalledPackageRef: my_redis_ref,
 				PkgVersionReference: &corev1.VersionReference{
-					Version: "14.4.0",
+					Version: ">14.4.0",
 				},
 			},
 			existingK8sObjs:    &redis_existing_spec_pending,
-			expectedStatusCode: codes.Internal,
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: my_redis_ref,
+			},
+			expectedRelease: flux_helm_release_updated_1,
 		},
 
 		// test case update installed package that has failed reconciliation will be done
@@ -849,10 +1184,29 @@ func TestUpdateInstalledPackage(t *testing.T) {
 			}
 			charts, releases, cleanup := newChartsAndReleases(t, existingObjs)
 			defer cleanup()
-			s, mock, err := newServerWithChartsAndReleases(t, nil, charts, releases)
+			var repos []sourcev1.HelmRepository
+			if tc.validatedChart != nil {
+				// the values are validated against a chart of the repo from the chart cache
+				ts, repo, err := newRepoWithIndex(
+					tc.existingK8sObjs.repoIndex, tc.existingK8sObjs.repoName, tc.existingK8sObjs.repoNamespace, nil, "")
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				defer ts.Close()
+				repos = append(repos, *repo)
+			}
+			s, mock, err := newServerWithReposChartsAndReleases(t, nil, repos, charts, releases)
 			if err != nil {
 				t.Fatalf("%+v", err)
 			}
+			if tc.validatedChart != nil {
+				redisKey, bytes, err := s.redisKeyValueForRepo(repos[0])
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				mock.ExpectGet(redisKey).SetVal(string(bytes))
+				s.redisMockExpectGetChartForValues(t, mock, repos[0], *tc.validatedChart)
+			}
 
 			if tc.defaultUpgradePolicyStr != "" {
 				policy, err := pkgutils.UpgradePolicyFromString(tc.defaultUpgradePolicyStr)
@@ -913,6 +1267,71 @@ func TestUpdateInstalledPackage(t *testing.T) {
 	}
 }
 
+func TestSuspendResumeReconcileInstalledPackage(t *testing.T) {
+	charts, releases, cleanup := newChartsAndReleases(t, []testSpecGetInstalledPackages{redis_existing_spec_completed})
+	defer cleanup()
+	s, _, err := newServerWithChartsAndReleases(t, nil, charts, releases)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	ctx := context.Background()
+	key := types.NamespacedName{
+		Namespace: my_redis_ref.Context.Namespace,
+		Name:      my_redis_ref.Identifier,
+	}
+	getRelease := func() helmv2.HelmRelease {
+		ctrlClient, err := s.clientGetter.ControllerRuntime(ctx, s.kubeappsCluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var actualRel helmv2.HelmRelease
+		if err = ctrlClient.Get(ctx, key, &actualRel); err != nil {
+			t.Fatal(err)
+		}
+		return actualRel
+	}
+
+	suspendResp, err := s.SuspendInstalledPackage(ctx, &fluxplugin.SuspendInstalledPackageRequest{InstalledPackageRef: my_redis_ref})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !suspendResp.Suspended || !getRelease().Spec.Suspend {
+		t.Errorf("expected release [%s] to be suspended", key)
+	}
+	if got, want := suspendResp.Status.Reason, corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED; got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	// flux does not reconcile suspended releases
+	_, err = s.ReconcileInstalledPackage(ctx, &fluxplugin.ReconcileInstalledPackageRequest{InstalledPackageRef: my_redis_ref})
+	if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+
+	resumeResp, err := s.ResumeInstalledPackage(ctx, &fluxplugin.ResumeInstalledPackageRequest{InstalledPackageRef: my_redis_ref})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if resumeResp.Suspended || getRelease().Spec.Suspend {
+		t.Errorf("expected release [%s] not to be suspended", key)
+	}
+
+	if _, err = s.ReconcileInstalledPackage(ctx, &fluxplugin.ReconcileInstalledPackageRequest{InstalledPackageRef: my_redis_ref}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if _, ok := getRelease().Annotations[fluxmeta.ReconcileRequestAnnotation]; !ok {
+		t.Errorf("expected the annotation %q on release [%s]", fluxmeta.ReconcileRequestAnnotation, key)
+	}
+
+	_, err = s.SuspendInstalledPackage(ctx, &fluxplugin.SuspendInstalledPackageRequest{
+		InstalledPackageRef: installedRef("not-a-valid-identifier", "default"),
+	})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
+
 func TestDeleteInstalledPackage(t *testing.T) {
 	testCases := []struct {
 		name               string
@@ -1124,6 +1543,28 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 	}
 }
 
+// redisMockExpectGetChartForValues expects the given chart of the repo, whose values
+// schema the values are validated against, to be fetched from the chart cache.
+func (s *Server) redisMockExpectGetChartForValues(t *testing.T, mock redismock.ClientMock, repo sourcev1.HelmRepository, chart testSpecChartWithFile) {
+	tarGzBytes, err := ioutil.ReadFile(chart.tgzFile)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		w.Write(tarGzBytes)
+	}))
+	defer ts.Close()
+
+	key, err := s.caches[KubeappsCluster].chartCache.KeyFor(repo.Namespace, repo.Name+"/"+chart.name, chart.revision)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err = redisMockExpectGetFromChartCache(mock, key, ts.URL, nil); err != nil {
+		t.Fatalf("%+v", err)
+	}
+}
+
 func newChartsAndReleases(t *testing.T, existingK8sObjs []testSpecGetInstalledPackages) (charts []sourcev1.HelmChart, releases []helmv2.HelmRelease, cleanup func()) {
 	httpServers := []*httptest.Server{}
 	cleanup = func() {
@@ -1234,6 +1675,169 @@ func compareActualVsExpectedGetInstalledPackageDetailResponse(t *testing.T, actu
 	compareJSONStrings(t, expectedResp.InstalledPackageDetail.ValuesApplied, actualResp.InstalledPackageDetail.ValuesApplied)
 }
 
+func TestEffectiveReleaseValues(t *testing.T) {
+	testCases := []struct {
+		name       string
+		valuesFrom []helmv2.ValuesReference
+		values     *v1.JSON
+		sources    []string
+		expected   map[string]interface{}
+	}{
+		{
+			name:       "merges values from references in order",
+			valuesFrom: []helmv2.ValuesReference{{Kind: "ConfigMap", Name: "a"}, {Kind: "Secret", Name: "b"}},
+			sources:    []string{"ui:\n  message: a\n  color: red\nreplicaCount: 1", "ui:\n  message: b"},
+			expected: map[string]interface{}{
+				"ui":           map[string]interface{}{"message": "b", "color": "red"},
+				"replicaCount": float64(1),
+			},
+		},
+		{
+			name:       "inline values take precedence over references",
+			valuesFrom: []helmv2.ValuesReference{{Kind: "ConfigMap", Name: "a"}},
+			values:     &v1.JSON{Raw: []byte(`{"ui": {"message": "inline"}}`)},
+			sources:    []string{"ui:\n  message: a\n  color: red"},
+			expected: map[string]interface{}{
+				"ui": map[string]interface{}{"message": "inline", "color": "red"},
+			},
+		},
+		{
+			name:       "sets the value of a reference with a target path",
+			valuesFrom: []helmv2.ValuesReference{{Kind: "Secret", Name: "a", ValuesKey: "message", TargetPath: "ui.message"}},
+			sources:    []string{"from a secret\n"},
+			expected: map[string]interface{}{
+				"ui": map[string]interface{}{"message": "from a secret"},
+			},
+		},
+		{
+			name:       "skips references with no values",
+			valuesFrom: []helmv2.ValuesReference{{Kind: "ConfigMap", Name: "a", Optional: true}, {Kind: "ConfigMap", Name: "b"}},
+			sources:    []string{"", "replicaCount: 2"},
+			expected: map[string]interface{}{
+				"replicaCount": float64(2),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rel := &helmv2.HelmRelease{
+				Spec: helmv2.HelmReleaseSpec{
+					ValuesFrom: tc.valuesFrom,
+					Values:     tc.values,
+				},
+			}
+			values, err := effectiveReleaseValues(rel, tc.sources)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := values, tc.expected; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestInlineValues(t *testing.T) {
+	testCases := []struct {
+		name       string
+		values     map[string]interface{}
+		valuesFrom map[string]interface{}
+		expected   map[string]interface{}
+	}{
+		{
+			name:     "returns all the values when there are no references",
+			values:   map[string]interface{}{"ui": map[string]interface{}{"message": "a"}, "replicaCount": float64(1)},
+			expected: map[string]interface{}{"ui": map[string]interface{}{"message": "a"}, "replicaCount": float64(1)},
+		},
+		{
+			name: "leaves out the keys set by references",
+			values: map[string]interface{}{
+				"ui":   map[string]interface{}{"message": "a", "color": "red"},
+				"auth": map[string]interface{}{"password": "s3cr3t"},
+			},
+			valuesFrom: map[string]interface{}{
+				"ui":   map[string]interface{}{"color": "REDACTED"},
+				"auth": "REDACTED",
+			},
+			expected: map[string]interface{}{
+				"ui": map[string]interface{}{"message": "a"},
+			},
+		},
+		{
+			name:       "leaves out the maps left empty",
+			values:     map[string]interface{}{"auth": map[string]interface{}{"password": "s3cr3t"}},
+			valuesFrom: map[string]interface{}{"auth": map[string]interface{}{"password": "REDACTED"}},
+			expected:   map[string]interface{}{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := inlineValues(tc.values, tc.valuesFrom), tc.expected; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestReadValuesFrom(t *testing.T) {
+	typedClient := typfake.NewSimpleClientset(
+		&apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo-values", Namespace: "test"},
+			Data:       map[string]string{"values.yaml": "ui:\n  color: red\n"},
+		},
+		&apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo-secret", Namespace: "test"},
+			Data: map[string][]byte{
+				"values.yaml": []byte("auth:\n  password: s3cr3t\n  users:\n  - admin\n"),
+				"message":     []byte("a secret message"),
+			},
+		},
+	)
+	s := &Server{
+		clientGetter: func(context.Context, string) (clientgetter.ClientInterfaces, error) {
+			return clientgetter.NewBuilder().WithTyped(typedClient).Build(), nil
+		},
+	}
+	rel := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-podinfo", Namespace: "test"},
+		Spec: helmv2.HelmReleaseSpec{
+			ValuesFrom: []helmv2.ValuesReference{
+				{Kind: "ConfigMap", Name: "podinfo-values"},
+				{Kind: "Secret", Name: "podinfo-secret"},
+				{Kind: "Secret", Name: "podinfo-secret", ValuesKey: "message", TargetPath: "ui.message"},
+				{Kind: "Secret", Name: "missing", Optional: true},
+			},
+		},
+	}
+
+	sources := s.readValuesFrom(context.Background(), KubeappsCluster, rel)
+
+	expectedSources := []string{
+		"ui:\n  color: red\n",
+		"auth:\n  password: REDACTED\n  users: REDACTED\n",
+		"REDACTED",
+		"",
+	}
+	if got, want := sources, expectedSources; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// the values applied show the keys set by the secrets, but not their values
+	values, err := effectiveReleaseValues(rel, sources)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expectedValues := map[string]interface{}{
+		"ui":   map[string]interface{}{"color": "red", "message": "REDACTED"},
+		"auth": map[string]interface{}{"password": "REDACTED", "users": "REDACTED"},
+	}
+	if got, want := values, expectedValues; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
 func newRelease(name string, namespace string, spec *helmv2.HelmReleaseSpec, status *helmv2.HelmReleaseStatus) helmv2.HelmRelease {
 	helmRelease := helmv2.HelmRelease{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1255,3 +1859,139 @@ func newRelease(name string, namespace string, spec *helmv2.HelmReleaseSpec, sta
 	}
 	return helmRelease
 }
+
+// fakeWatchInstalledPackageSummariesServer sends the events of the stream on a channel.
+type fakeWatchInstalledPackageSummariesServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *corev1.WatchInstalledPackageSummariesResponse
+}
+
+func (s *fakeWatchInstalledPackageSummariesServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchInstalledPackageSummariesServer) Send(response *corev1.WatchInstalledPackageSummariesResponse) error {
+	s.responses <- response
+	return nil
+}
+
+func TestWatchInstalledPackageSummaries(t *testing.T) {
+	newRelease := func(name, resourceVersion string, generation, observedGeneration int64) *helmv2.HelmRelease {
+		return &helmv2.HelmRelease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "test",
+				Generation:      generation,
+				ResourceVersion: resourceVersion,
+			},
+			Spec: helmv2.HelmReleaseSpec{
+				Chart: helmv2.HelmChartTemplate{
+					Spec: helmv2.HelmChartTemplateSpec{
+						Chart: name,
+					},
+				},
+			},
+			Status: helmv2.HelmReleaseStatus{
+				ObservedGeneration: observedGeneration,
+			},
+		}
+	}
+	type watchEvent struct {
+		EventType  corev1.WatchInstalledPackageSummariesResponse_EventType
+		Identifier string
+	}
+
+	s, _, err := newServerWithChartsAndReleases(t, nil, nil, []helmv2.HelmRelease{
+		*newRelease("my-redis", "1", 1, 1),
+		// not sent until the release has been reconciled
+		*newRelease("my-airflow", "1", 2, 1),
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	ctrlClient, err := s.clientGetter.ControllerRuntime(context.Background(), s.kubeappsCluster)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	ww, ok := ctrlClient.(*withWatchWrapper)
+	if !ok {
+		t.Fatalf("Could not cast %T to: *withWatchWrapper", ctrlClient)
+	}
+	// the watcher of the repositories cache, if any
+	repoWatcher := ww.watcher
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeWatchInstalledPackageSummariesServer{
+		ctx:       ctx,
+		responses: make(chan *corev1.WatchInstalledPackageSummariesResponse, 10),
+	}
+	errCh := make(chan error)
+	go func() {
+		errCh <- s.WatchInstalledPackageSummaries(&corev1.WatchInstalledPackageSummariesRequest{
+			Context: &corev1.Context{Namespace: "test"},
+		}, stream)
+	}()
+
+	nextEvent := func() watchEvent {
+		select {
+		case response := <-stream.responses:
+			return watchEvent{
+				EventType:  response.GetEventType(),
+				Identifier: response.GetInstalledPackageSummary().GetInstalledPackageRef().GetIdentifier(),
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for a watch event")
+			return watchEvent{}
+		}
+	}
+
+	// Only the ready release is sent as added.
+	if got, want := nextEvent(), (watchEvent{corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED, "my-redis"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// wait until the releases are being watched, after the repositories
+	var watcher *watch.RaceFreeFakeWatcher
+	for i := 0; i < 50 && (watcher == nil || watcher == repoWatcher); i++ {
+		watcher = ww.watcher
+		time.Sleep(100 * time.Millisecond)
+	}
+	if watcher == nil || watcher == repoWatcher {
+		t.Fatalf("timed out waiting for the watch of releases")
+	}
+
+	// Once reconciled, the release is sent as added.
+	watcher.Modify(newRelease("my-airflow", "2", 2, 2))
+	if got, want := nextEvent(), (watchEvent{corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED, "my-airflow"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// A change of a release already sent is sent as modified, once reconciled.
+	watcher.Modify(newRelease("my-redis", "3", 2, 1))
+	watcher.Modify(newRelease("my-redis", "4", 2, 2))
+	if got, want := nextEvent(), (watchEvent{corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED, "my-redis"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// The deletion of a release never sent is not sent.
+	watcher.Delete(newRelease("my-other", "5", 1, 0))
+	watcher.Delete(newRelease("my-airflow", "6", 2, 2))
+	if got, want := nextEvent(), (watchEvent{corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED, "my-airflow"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the watch to end")
+	}
+	if len(stream.responses) > 0 {
+		t.Errorf("unexpected events: %+v", <-stream.responses)
+	}
+}
@@ -27,11 +27,13 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
 	log "k8s.io/klog/v2"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -42,6 +44,10 @@ const (
 	fluxHelmRepositories   = "helmrepositories"
 	fluxHelmRepositoryList = "HelmRepositoryList"
 	redactedString         = "REDACTED"
+
+	// flux has no notion of a repository scope, so kubeapps records it in this
+	// annotation. Repositories without it are visible from every namespace
+	namespaceScopedAnnotation = "kubeapps.dev/namespace-scoped"
 )
 
 var (
@@ -51,12 +57,17 @@ var (
 
 // returns a list of HelmRepositories from all namespaces (cluster-wide), excluding
 // the ones that the caller has no read access to
-func (s *Server) listReposInAllNamespaces(ctx context.Context) ([]sourcev1.HelmRepository, error) {
+func (s *Server) listReposInAllNamespaces(ctx context.Context, cluster string) ([]sourcev1.HelmRepository, error) {
+	caches, err := s.cachesFor(cluster)
+	if err != nil {
+		return nil, err
+	}
+
 	// the actual List(...) call will be executed in the context of
 	// kubeapps-internal-kubeappsapis service account
 	// ref https://github.com/vmware-tanzu/kubeapps/issues/4390 for explanation
 	backgroundCtx := context.Background()
-	client, err := s.serviceAccountClientGetter.ControllerRuntime(backgroundCtx)
+	client, err := caches.serviceAccountClientGetter.ControllerRuntime(backgroundCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +84,7 @@ func (s *Server) listReposInAllNamespaces(ctx context.Context) ([]sourcev1.HelmR
 		allowedNamespaces := sets.String{}
 		gvr := common.GetRepositoriesGvr()
 		for ns := range namespaces {
-			if ok, err := s.hasAccessToNamespace(ctx, gvr, ns); err == nil && ok {
+			if ok, err := s.hasAccessToNamespace(ctx, cluster, gvr, ns); err == nil && ok {
 				allowedNamespaces.Insert(ns)
 			} else if err != nil {
 				return nil, err
@@ -89,13 +100,13 @@ func (s *Server) listReposInAllNamespaces(ctx context.Context) ([]sourcev1.HelmR
 	}
 }
 
-func (s *Server) getRepoInCluster(ctx context.Context, key types.NamespacedName) (*sourcev1.HelmRepository, error) {
+func (s *Server) getRepoInCluster(ctx context.Context, cluster string, key types.NamespacedName) (*sourcev1.HelmRepository, error) {
 	// unlike List(), there is no need to execute Get() in the context of
 	// kubeapps-internal-kubeappsapis service account and then filter out results based on
 	// whether or not the caller hasAccessToNamespace(). We can just pass the caller
 	// context into Get() and if the caller isn't allowed, Get will raise an error, which is what we
 	// want
-	client, err := s.getClient(ctx, key.Namespace)
+	client, err := s.getClient(ctx, cluster, key.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -107,11 +118,7 @@ func (s *Server) getRepoInCluster(ctx context.Context, key types.NamespacedName)
 }
 
 // regexp expressions are used for matching actual names against expected patters
-func (s *Server) filterReadyReposByName(repoList []sourcev1.HelmRepository, match []string) (sets.String, error) {
-	if s.repoCache == nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "server cache has not been properly initialized")
-	}
-
+func (s *Server) filterReadyReposByName(repoCache *cache.NamespacedResourceWatcherCache, repoList []sourcev1.HelmRepository, match []string) (sets.String, error) {
 	resultKeys := sets.String{}
 	for r := range repoList {
 		repo := repoList[r] // avoid implicit memory aliasing
@@ -138,7 +145,7 @@ func (s *Server) filterReadyReposByName(repoList []sourcev1.HelmRepository, matc
 			matched = true
 		}
 		if matched {
-			resultKeys.Insert(s.repoCache.KeyForNamespacedName(*name))
+			resultKeys.Insert(repoCache.KeyForNamespacedName(*name))
 		}
 	}
 	return resultKeys, nil
@@ -146,20 +153,28 @@ func (s *Server) filterReadyReposByName(repoList []sourcev1.HelmRepository, matc
 
 // Notes:
 // 1. with flux, an available package may be from a repo in any namespace accessible to the caller
-// 2. can't rely on cache as a real source of truth for key names
+// 2. namespace-scoped repos are only included when namespace is either their own namespace
+//    or "", which stands for all namespaces
+// 3. can't rely on cache as a real source of truth for key names
 //    because redis may evict cache entries due to memory pressure to make room for new ones
-func (s *Server) getChartsForRepos(ctx context.Context, match []string) (map[string][]models.Chart, error) {
-	repoList, err := s.listReposInAllNamespaces(ctx)
+func (s *Server) getChartsForRepos(ctx context.Context, cluster, namespace string, match []string) (map[string][]models.Chart, error) {
+	caches, err := s.cachesFor(cluster)
 	if err != nil {
 		return nil, err
 	}
 
-	repoNames, err := s.filterReadyReposByName(repoList, match)
+	repoList, err := s.listReposInAllNamespaces(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
+	repoList = filterReposVisibleInNamespace(repoList, namespace)
 
-	chartsUntyped, err := s.repoCache.GetForMultiple(repoNames)
+	repoNames, err := s.filterReadyReposByName(caches.repoCache, repoList, match)
+	if err != nil {
+		return nil, err
+	}
+
+	chartsUntyped, err := caches.repoCache.GetForMultiple(repoNames)
 	if err != nil {
 		return nil, err
 	}
@@ -179,27 +194,32 @@ func (s *Server) getChartsForRepos(ctx context.Context, match []string) (map[str
 			chartsTyped[key] = typedValue.Charts
 		}
 	}
+
+	// also include the charts from GitRepositories and Buckets
+	chartsFromSources, err := s.getChartsForSources(ctx, cluster, namespace, match)
+	if err != nil {
+		return nil, err
+	}
+	for key, charts := range chartsFromSources {
+		chartsTyped[key] = charts
+	}
 	return chartsTyped, nil
 }
 
-func (s *Server) httpClientOptionsForRepo(ctx context.Context, repoName types.NamespacedName) (*common.HttpClientOptions, error) {
-	repo, err := s.getRepoInCluster(ctx, repoName)
+func (s *Server) httpClientOptionsForRepo(ctx context.Context, cluster string, repoName types.NamespacedName) (*common.HttpClientOptions, error) {
+	repo, err := s.getRepoInCluster(ctx, cluster, repoName)
 	if err != nil {
 		return nil, err
 	}
-	sink := s.newRepoEventSink()
+	sink := s.newRepoEventSink(cluster)
 	return sink.httpClientOptionsForRepo(ctx, *repo)
 }
 
-func (s *Server) newRepo(ctx context.Context, request *corev1.AddPackageRepositoryRequest) (*corev1.PackageRepositoryReference, error) {
+func (s *Server) newRepo(ctx context.Context, cluster string, request *corev1.AddPackageRepositoryRequest) (*corev1.PackageRepositoryReference, error) {
 	if request.Name == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "no request Name provided")
 	}
 
-	if request.GetNamespaceScoped() {
-		return nil, status.Errorf(codes.Unimplemented, "namespaced-scoped repositories are not supported")
-	}
-
 	typ := request.GetType()
 	if typ != "helm" && typ != "oci" {
 		return nil, status.Errorf(codes.Unimplemented, "repository type [%s] not supported", typ)
@@ -218,7 +238,7 @@ func (s *Server) newRepo(ctx context.Context, request *corev1.AddPackageReposito
 	var secret *apiv1.Secret
 	var err error
 	if s.pluginConfig.UserManagedSecrets {
-		if secret, err = s.validateUserManagedRepoSecret(ctx, name, tlsConfig, auth); err != nil {
+		if secret, err = s.validateUserManagedRepoSecret(ctx, cluster, name, tlsConfig, auth); err != nil {
 			return nil, err
 		}
 	} else {
@@ -226,7 +246,7 @@ func (s *Server) newRepo(ctx context.Context, request *corev1.AddPackageReposito
 		// but then I need to set the owner reference on this secret to the repo. In has to be done
 		// in that order because to set an owner ref you need object (i.e. repo) UID, which you only get
 		// once the object's been created
-		if secret, err = s.createKubeappsManagedRepoSecret(ctx, name, tlsConfig, auth); err != nil {
+		if secret, err = s.createKubeappsManagedRepoSecret(ctx, cluster, name, tlsConfig, auth); err != nil {
 			return nil, err
 		}
 	}
@@ -234,22 +254,22 @@ func (s *Server) newRepo(ctx context.Context, request *corev1.AddPackageReposito
 	passCredentials := auth != nil && auth.PassCredentials
 	interval := request.GetInterval()
 
-	if fluxRepo, err := newFluxHelmRepo(name, typ, url, interval, secret, passCredentials); err != nil {
+	if fluxRepo, err := newFluxHelmRepo(name, typ, url, interval, secret, passCredentials, request.GetNamespaceScoped()); err != nil {
 		return nil, err
-	} else if client, err := s.getClient(ctx, name.Namespace); err != nil {
+	} else if client, err := s.getClient(ctx, cluster, name.Namespace); err != nil {
 		return nil, err
 	} else if err = client.Create(ctx, fluxRepo); err != nil {
 		return nil, statuserror.FromK8sError("create", "HelmRepository", name.String(), err)
 	} else {
 		if !s.pluginConfig.UserManagedSecrets {
-			if err = s.setOwnerReferencesForRepoSecret(ctx, secret, fluxRepo); err != nil {
+			if err = s.setOwnerReferencesForRepoSecret(ctx, cluster, secret, fluxRepo); err != nil {
 				return nil, err
 			}
 		}
 		return &corev1.PackageRepositoryReference{
 			Context: &corev1.Context{
 				Namespace: fluxRepo.Namespace,
-				Cluster:   s.kubeappsCluster,
+				Cluster:   cluster,
 			},
 			Identifier: fluxRepo.Name,
 			Plugin:     GetPluginDetail(),
@@ -257,10 +277,10 @@ func (s *Server) newRepo(ctx context.Context, request *corev1.AddPackageReposito
 	}
 }
 
-func (s *Server) repoDetail(ctx context.Context, repoRef *corev1.PackageRepositoryReference) (*corev1.PackageRepositoryDetail, error) {
+func (s *Server) repoDetail(ctx context.Context, cluster string, repoRef *corev1.PackageRepositoryReference) (*corev1.PackageRepositoryDetail, error) {
 	key := types.NamespacedName{Namespace: repoRef.Context.Namespace, Name: repoRef.Identifier}
 
-	repo, err := s.getRepoInCluster(ctx, key)
+	repo, err := s.getRepoInCluster(ctx, cluster, key)
 	if err != nil {
 		return nil, err
 	}
@@ -272,7 +292,7 @@ func (s *Server) repoDetail(ctx context.Context, repoRef *corev1.PackageReposito
 		if s == nil || s.clientGetter == nil {
 			return nil, status.Errorf(codes.Internal, "unexpected state in clientGetterHolder instance")
 		}
-		typedClient, err := s.clientGetter.Typed(ctx, s.kubeappsCluster)
+		typedClient, err := s.clientGetter.Typed(ctx, cluster)
 		if err != nil {
 			return nil, err
 		}
@@ -304,7 +324,7 @@ func (s *Server) repoDetail(ctx context.Context, repoRef *corev1.PackageReposito
 		PackageRepoRef: &corev1.PackageRepositoryReference{
 			Context: &corev1.Context{
 				Namespace: repo.Namespace,
-				Cluster:   s.kubeappsCluster,
+				Cluster:   cluster,
 			},
 			Identifier: repo.Name,
 			Plugin:     GetPluginDetail(),
@@ -312,7 +332,7 @@ func (s *Server) repoDetail(ctx context.Context, repoRef *corev1.PackageReposito
 		Name: repo.Name,
 		// TODO (gfichtenholt) Flux HelmRepository CR doesn't have a designated field for description
 		Description:     "",
-		NamespaceScoped: false,
+		NamespaceScoped: isRepoNamespaceScoped(*repo),
 		Type:            typ,
 		Url:             repo.Spec.URL,
 		Interval:        pkgutils.FromDuration(&repo.Spec.Interval),
@@ -323,12 +343,12 @@ func (s *Server) repoDetail(ctx context.Context, repoRef *corev1.PackageReposito
 	}, nil
 }
 
-func (s *Server) repoSummaries(ctx context.Context, namespace string) ([]*corev1.PackageRepositorySummary, error) {
+func (s *Server) repoSummaries(ctx context.Context, cluster, namespace string) ([]*corev1.PackageRepositorySummary, error) {
 	summaries := []*corev1.PackageRepositorySummary{}
 	var repos []sourcev1.HelmRepository
 	var err error
 	if namespace == apiv1.NamespaceAll {
-		if repos, err = s.listReposInAllNamespaces(ctx); err != nil {
+		if repos, err = s.listReposInAllNamespaces(ctx, cluster); err != nil {
 			return nil, err
 		}
 	} else {
@@ -338,7 +358,7 @@ func (s *Server) repoSummaries(ctx context.Context, namespace string) ([]*corev1
 		// error should be raised, as opposed to returning an empty list with no error
 		var repoList sourcev1.HelmRepositoryList
 		var client ctrlclient.Client
-		if client, err = s.getClient(ctx, namespace); err != nil {
+		if client, err = s.getClient(ctx, cluster, namespace); err != nil {
 			return nil, err
 		} else if err = client.List(ctx, &repoList); err != nil {
 			return nil, statuserror.FromK8sError("list", "HelmRepository", "", err)
@@ -355,7 +375,7 @@ func (s *Server) repoSummaries(ctx context.Context, namespace string) ([]*corev1
 			PackageRepoRef: &corev1.PackageRepositoryReference{
 				Context: &corev1.Context{
 					Namespace: repo.Namespace,
-					Cluster:   s.kubeappsCluster,
+					Cluster:   cluster,
 				},
 				Identifier: repo.Name,
 				Plugin:     GetPluginDetail(),
@@ -363,7 +383,7 @@ func (s *Server) repoSummaries(ctx context.Context, namespace string) ([]*corev1
 			Name: repo.Name,
 			// TODO (gfichtenholt) Flux HelmRepository CR doesn't have a designated field for description
 			Description:     "",
-			NamespaceScoped: false,
+			NamespaceScoped: isRepoNamespaceScoped(repo),
 			Type:            typ,
 			Url:             repo.Spec.URL,
 			Status:          repoStatus(repo),
@@ -375,6 +395,7 @@ func (s *Server) repoSummaries(ctx context.Context, namespace string) ([]*corev1
 
 func (s *Server) validateUserManagedRepoSecret(
 	ctx context.Context,
+	cluster string,
 	repoName types.NamespacedName,
 	tlsConfig *corev1.PackageRepositoryTlsConfig,
 	auth *corev1.PackageRepositoryAuth) (*apiv1.Secret, error) {
@@ -412,7 +433,7 @@ func (s *Server) validateUserManagedRepoSecret(
 	var secret *apiv1.Secret
 	if secretRef != "" {
 		// check that the specified secret exists
-		if typedClient, err := s.clientGetter.Typed(ctx, s.kubeappsCluster); err != nil {
+		if typedClient, err := s.clientGetter.Typed(ctx, cluster); err != nil {
 			return nil, err
 		} else if secret, err = typedClient.CoreV1().Secrets(repoName.Namespace).Get(ctx, secretRef, metav1.GetOptions{}); err != nil {
 			return nil, statuserror.FromK8sError("get", "secret", secretRef, err)
@@ -452,6 +473,7 @@ func (s *Server) validateUserManagedRepoSecret(
 
 func (s *Server) createKubeappsManagedRepoSecret(
 	ctx context.Context,
+	cluster string,
 	repoName types.NamespacedName,
 	tlsConfig *corev1.PackageRepositoryTlsConfig,
 	auth *corev1.PackageRepositoryAuth) (*apiv1.Secret, error) {
@@ -463,7 +485,7 @@ func (s *Server) createKubeappsManagedRepoSecret(
 
 	if secret != nil {
 		// create a secret first, if applicable
-		if typedClient, err := s.clientGetter.Typed(ctx, s.kubeappsCluster); err != nil {
+		if typedClient, err := s.clientGetter.Typed(ctx, cluster); err != nil {
 			return nil, err
 		} else if secret, err = typedClient.CoreV1().Secrets(repoName.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
 			return nil, statuserror.FromK8sError("create", "secret", secret.GetName(), err)
@@ -480,11 +502,12 @@ func (s *Server) createKubeappsManagedRepoSecret(
 // see https://github.com/vmware-tanzu/kubeapps/pull/4630#discussion_r861446394 for details
 func (s *Server) setOwnerReferencesForRepoSecret(
 	ctx context.Context,
+	cluster string,
 	secret *apiv1.Secret,
 	repo *sourcev1.HelmRepository) error {
 
 	if repo.Spec.SecretRef != nil && secret != nil {
-		if typedClient, err := s.clientGetter.Typed(ctx, s.kubeappsCluster); err != nil {
+		if typedClient, err := s.clientGetter.Typed(ctx, cluster); err != nil {
 			return err
 		} else {
 			secretsInterface := typedClient.CoreV1().Secrets(repo.Namespace)
@@ -513,6 +536,7 @@ func (s *Server) setOwnerReferencesForRepoSecret(
 
 func (s *Server) updateKubeappsManagedRepoSecret(
 	ctx context.Context,
+	cluster string,
 	repoName types.NamespacedName,
 	tlsConfig *corev1.PackageRepositoryTlsConfig,
 	auth *corev1.PackageRepositoryAuth,
@@ -525,7 +549,7 @@ func (s *Server) updateKubeappsManagedRepoSecret(
 		return nil, false, nil
 	}
 
-	typedClient, err := s.clientGetter.Typed(ctx, s.kubeappsCluster)
+	typedClient, err := s.clientGetter.Typed(ctx, cluster)
 	if err != nil {
 		return nil, false, err
 	}
@@ -559,9 +583,9 @@ func (s *Server) updateKubeappsManagedRepoSecret(
 	return secret, true, nil
 }
 
-func (s *Server) updateRepo(ctx context.Context, repoRef *corev1.PackageRepositoryReference, url string, interval string, tlsConfig *corev1.PackageRepositoryTlsConfig, auth *corev1.PackageRepositoryAuth) (*corev1.PackageRepositoryReference, error) {
+func (s *Server) updateRepo(ctx context.Context, cluster string, repoRef *corev1.PackageRepositoryReference, url string, interval string, tlsConfig *corev1.PackageRepositoryTlsConfig, auth *corev1.PackageRepositoryAuth) (*corev1.PackageRepositoryReference, error) {
 	key := types.NamespacedName{Namespace: repoRef.GetContext().GetNamespace(), Name: repoRef.GetIdentifier()}
-	repo, err := s.getRepoInCluster(ctx, key)
+	repo, err := s.getRepoInCluster(ctx, cluster, key)
 	if err != nil {
 		return nil, err
 	}
@@ -599,12 +623,12 @@ func (s *Server) updateRepo(ctx context.Context, repoRef *corev1.PackageReposito
 	var secret *apiv1.Secret
 	var updateRepoSecret bool
 	if s.pluginConfig.UserManagedSecrets {
-		if secret, err = s.validateUserManagedRepoSecret(ctx, key, tlsConfig, auth); err != nil {
+		if secret, err = s.validateUserManagedRepoSecret(ctx, cluster, key, tlsConfig, auth); err != nil {
 			return nil, err
 		}
 	} else {
 		if secret, updateRepoSecret, err = s.updateKubeappsManagedRepoSecret(
-			ctx, key, tlsConfig, auth, repo.Spec.SecretRef); err != nil {
+			ctx, cluster, key, tlsConfig, auth, repo.Spec.SecretRef); err != nil {
 			return nil, err
 		}
 	}
@@ -622,16 +646,17 @@ func (s *Server) updateRepo(ctx context.Context, repoRef *corev1.PackageReposito
 	// get rid of the status field, since now there will be a new reconciliation
 	// process and the current status no longer applies. metadata and spec I want
 	// to keep, as they may have had added labels and/or annotations and/or
-	// even other changes made by the user.
+	// even other changes made by the user. That includes namespaceScopedAnnotation,
+	// i.e. the scope of a repository does not change on update
 	repo.Status = sourcev1.HelmRepositoryStatus{}
 
-	if client, err := s.getClient(ctx, key.Namespace); err != nil {
+	if client, err := s.getClient(ctx, cluster, key.Namespace); err != nil {
 		return nil, err
 	} else if err = client.Update(ctx, repo); err != nil {
 		return nil, statuserror.FromK8sError("update", "HelmRepository", key.String(), err)
 	} else if updateRepoSecret && secret != nil {
 		// new secret => will need to set the owner
-		if err = s.setOwnerReferencesForRepoSecret(ctx, secret, repo); err != nil {
+		if err = s.setOwnerReferencesForRepoSecret(ctx, cluster, secret, repo); err != nil {
 			return nil, err
 		}
 	}
@@ -641,15 +666,15 @@ func (s *Server) updateRepo(ctx context.Context, repoRef *corev1.PackageReposito
 	return &corev1.PackageRepositoryReference{
 		Context: &corev1.Context{
 			Namespace: key.Namespace,
-			Cluster:   s.kubeappsCluster,
+			Cluster:   cluster,
 		},
 		Identifier: key.Name,
 		Plugin:     GetPluginDetail(),
 	}, nil
 }
 
-func (s *Server) deleteRepo(ctx context.Context, repoRef *corev1.PackageRepositoryReference) error {
-	client, err := s.getClient(ctx, repoRef.Context.Namespace)
+func (s *Server) deleteRepo(ctx context.Context, cluster string, repoRef *corev1.PackageRepositoryReference) error {
+	client, err := s.getClient(ctx, cluster, repoRef.Context.Namespace)
 	if err != nil {
 		return err
 	}
@@ -672,12 +697,106 @@ func (s *Server) deleteRepo(ctx context.Context, repoRef *corev1.PackageReposito
 	}
 }
 
+// refreshRepo requests that the source-controller reconciles the repository
+// now, rather than at the next interval.
+func (s *Server) refreshRepo(ctx context.Context, cluster string, repoRef *corev1.PackageRepositoryReference) (*corev1.PackageRepositoryReference, error) {
+	repo, err := s.reconcileRepo(ctx, cluster, repoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.PackageRepositoryReference{
+		Context: &corev1.Context{
+			Namespace: repo.Namespace,
+			Cluster:   cluster,
+		},
+		Identifier: repo.Name,
+		Plugin:     GetPluginDetail(),
+	}, nil
+}
+
+// reconcileRepo requests that the source-controller reconciles the repository
+// now, rather than at the next interval, which flux does whenever the value of
+// the reconcile request annotation changes.
+func (s *Server) reconcileRepo(ctx context.Context, cluster string, repoRef *corev1.PackageRepositoryReference) (*sourcev1.HelmRepository, error) {
+	key := types.NamespacedName{Namespace: repoRef.GetContext().GetNamespace(), Name: repoRef.GetIdentifier()}
+	repo, err := s.updateRepoInCluster(ctx, cluster, key, func(repo *sourcev1.HelmRepository) error {
+		// flux ignores reconcile requests for suspended resources
+		if repo.Spec.Suspend {
+			return status.Errorf(codes.FailedPrecondition, "the HelmRepository [%s] is suspended", key)
+		}
+		if repo.Annotations == nil {
+			repo.Annotations = map[string]string{}
+		}
+		repo.Annotations[fluxmeta.ReconcileRequestAnnotation] = time.Now().Format(time.RFC3339Nano)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.V(4).Infof("Requested reconciliation of repository: [%s]", key)
+	return repo, nil
+}
+
+// setRepoSuspended suspends or resumes the reconciliation of a repository
+func (s *Server) setRepoSuspended(ctx context.Context, cluster string, repoRef *corev1.PackageRepositoryReference, suspend bool) (*sourcev1.HelmRepository, error) {
+	key := types.NamespacedName{Namespace: repoRef.GetContext().GetNamespace(), Name: repoRef.GetIdentifier()}
+	repo, err := s.updateRepoInCluster(ctx, cluster, key, func(repo *sourcev1.HelmRepository) error {
+		repo.Spec.Suspend = suspend
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.V(4).Infof("Set suspend of repository [%s] to [%t]", key, suspend)
+	return repo, nil
+}
+
+// updateRepoInCluster applies the given changes to the latest version of a
+// HelmRepository and updates it, applying them again to the then latest version
+// when the source-controller has modified the HelmRepository in the meantime.
+func (s *Server) updateRepoInCluster(ctx context.Context, cluster string, key types.NamespacedName, applyChanges func(repo *sourcev1.HelmRepository) error) (*sourcev1.HelmRepository, error) {
+	client, err := s.getClient(ctx, cluster, key.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var repo sourcev1.HelmRepository
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		repo = sourcev1.HelmRepository{}
+		if err := client.Get(ctx, key, &repo); err != nil {
+			return statuserror.FromK8sError("get", "HelmRepository", key.String(), err)
+		}
+		if err := applyChanges(&repo); err != nil {
+			return err
+		}
+		if err := client.Update(ctx, &repo); err != nil {
+			if errors.IsConflict(err) {
+				// retried
+				return err
+			}
+			return statuserror.FromK8sError("update", "HelmRepository", key.String(), err)
+		}
+		return nil
+	})
+	if errors.IsConflict(err) {
+		return nil, statuserror.FromK8sError("update", "HelmRepository", key.String(), err)
+	} else if err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
 //
 // implements plug-in specific cache-related functionality
 //
 type repoEventSink struct {
 	clientGetter clientgetter.BackgroundClientGetterFunc
 	chartCache   *cache.ChartCache // chartCache maybe nil only in unit tests
+	// ociRepositoryListers maybe nil, in which case the default lister is used
+	ociRepositoryListers []OCIRepositoryLister
 }
 
 // this is what we store in the cache for each cached repo
@@ -901,7 +1020,7 @@ func (s *repoEventSink) onDeleteRepo(key string) (bool, error) {
 	if s.chartCache != nil {
 		if name, err := s.fromKey(key); err != nil {
 			return false, err
-		} else if err := s.chartCache.DeleteChartsForRepo(name); err != nil {
+		} else if err := s.chartCache.DeleteChartsForRepo(repoTypeForKey(key), name); err != nil {
 			return false, err
 		}
 	}
@@ -921,10 +1040,12 @@ func (s *repoEventSink) onResync() error {
 // quite come up with with a more elegant alternative right now
 func (s *repoEventSink) fromKey(key string) (*types.NamespacedName, error) {
 	parts := strings.Split(key, cache.KeySegmentsSeparator)
-	if len(parts) != 3 || parts[0] != fluxHelmRepositories || len(parts[1]) == 0 || len(parts[2]) == 0 {
+	// the same sink is used for the caches of HelmRepositories, GitRepositories and Buckets
+	kinds := sets.NewString(fluxHelmRepositories, fluxGitRepositories, fluxBuckets)
+	if len(parts) != 4 || !kinds.Has(parts[0]) || len(parts[2]) == 0 || len(parts[3]) == 0 {
 		return nil, status.Errorf(codes.Internal, "invalid key [%s]", key)
 	}
-	return &types.NamespacedName{Namespace: parts[1], Name: parts[2]}, nil
+	return &types.NamespacedName{Namespace: parts[2], Name: parts[3]}, nil
 }
 
 func (s *repoEventSink) getRepoSecret(ctx context.Context, repo sourcev1.HelmRepository) (*apiv1.Secret, error) {
@@ -1042,7 +1163,8 @@ func newFluxHelmRepo(
 	url string,
 	interval string,
 	secret *apiv1.Secret,
-	passCredentials bool) (*sourcev1.HelmRepository, error) {
+	passCredentials bool,
+	namespaceScoped bool) (*sourcev1.HelmRepository, error) {
 	pollInterval := defaultPollInterval
 	if interval != "" {
 		if duration, err := pkgutils.ToDuration(interval); err != nil {
@@ -1072,9 +1194,34 @@ func newFluxHelmRepo(
 	if passCredentials {
 		fluxRepo.Spec.PassCredentials = true
 	}
+	if namespaceScoped {
+		fluxRepo.Annotations = map[string]string{
+			namespaceScopedAnnotation: "true",
+		}
+	}
 	return fluxRepo, nil
 }
 
+func isRepoNamespaceScoped(repo sourcev1.HelmRepository) bool {
+	return repo.GetAnnotations()[namespaceScopedAnnotation] == "true"
+}
+
+// returns the repos whose charts may be seen from the given namespace, i.e. all of
+// the repos that are not namespace-scoped plus the namespace-scoped ones in that
+// namespace. An empty namespace stands for all namespaces
+func filterReposVisibleInNamespace(repoList []sourcev1.HelmRepository, namespace string) []sourcev1.HelmRepository {
+	if namespace == apiv1.NamespaceAll {
+		return repoList
+	}
+	items := []sourcev1.HelmRepository{}
+	for _, repo := range repoList {
+		if !isRepoNamespaceScoped(repo) || repo.GetNamespace() == namespace {
+			items = append(items, repo)
+		}
+	}
+	return items
+}
+
 // this func is only used with kubeapps-managed secrets
 func newSecretFromTlsConfigAndAuth(repoName types.NamespacedName,
 	tlsConfig *corev1.PackageRepositoryTlsConfig,
@@ -20,6 +20,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	fluxplugin "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,10 +33,11 @@ import (
 )
 
 type testSpecGetAvailablePackageSummaries struct {
-	name      string
-	namespace string
-	url       string
-	index     string
+	name            string
+	namespace       string
+	url             string
+	index           string
+	namespaceScoped bool
 }
 
 func TestGetAvailablePackageSummariesWithoutPagination(t *testing.T) {
@@ -115,6 +117,50 @@ func TestGetAvailablePackageSummariesWithoutPagination(t *testing.T) {
 				AvailablePackageSummaries: append(valid_index_package_summaries, cert_manager_summary),
 			},
 		},
+		{
+			name: "it does not return fluxv2 packages from a namespace-scoped repo in another namespace",
+			repos: []testSpecGetAvailablePackageSummaries{
+				{
+					name:      "bitnami-1",
+					namespace: "default",
+					url:       "https://example.repo.com/charts",
+					index:     testYaml("valid-index.yaml"),
+				},
+				{
+					name:            "jetstack-1",
+					namespace:       "ns1",
+					url:             "https://charts.jetstack.io",
+					index:           testYaml("jetstack-index.yaml"),
+					namespaceScoped: true,
+				},
+			},
+			request: &corev1.GetAvailablePackageSummariesRequest{Context: &corev1.Context{Namespace: "non-default"}},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: valid_index_package_summaries,
+			},
+		},
+		{
+			name: "it returns fluxv2 packages from a namespace-scoped repo in the request namespace",
+			repos: []testSpecGetAvailablePackageSummaries{
+				{
+					name:      "bitnami-1",
+					namespace: "default",
+					url:       "https://example.repo.com/charts",
+					index:     testYaml("valid-index.yaml"),
+				},
+				{
+					name:            "jetstack-1",
+					namespace:       "ns1",
+					url:             "https://charts.jetstack.io",
+					index:           testYaml("jetstack-index.yaml"),
+					namespaceScoped: true,
+				},
+			},
+			request: &corev1.GetAvailablePackageSummariesRequest{Context: &corev1.Context{Namespace: "ns1"}},
+			expectedResponse: &corev1.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: append(valid_index_package_summaries, cert_manager_summary),
+			},
+		},
 		{
 			name: "uses a filter based on existing repo",
 			repos: []testSpecGetAvailablePackageSummaries{
@@ -380,17 +426,20 @@ func TestGetAvailablePackageSummariesWithoutPagination(t *testing.T) {
 			},
 		},
 		{
-			name: "it returns an error if a cluster other than the kubeapps cluster is specified",
+			name: "it returns an error if a cluster for which the plugin is not available is specified",
 			request: &corev1.GetAvailablePackageSummariesRequest{Context: &corev1.Context{
 				Cluster: "not-kubeapps-cluster",
 			}},
-			expectedErrorCode: codes.Unimplemented,
+			expectedErrorCode: codes.InvalidArgument,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			repos := []sourcev1.HelmRepository{}
+			// repos that are namespace-scoped to a namespace other than that of
+			// the request are not read from the cache
+			visibleRepos := []sourcev1.HelmRepository{}
 
 			for _, rs := range tc.repos {
 				ts2, repo, err := newRepoWithIndex(rs.index, rs.name, rs.namespace, nil, "")
@@ -398,7 +447,14 @@ func TestGetAvailablePackageSummariesWithoutPagination(t *testing.T) {
 					t.Fatalf("%+v", err)
 				}
 				defer ts2.Close()
+				if rs.namespaceScoped {
+					repo.Annotations = map[string]string{namespaceScopedAnnotation: "true"}
+				}
 				repos = append(repos, *repo)
+				requestNamespace := tc.request.GetContext().GetNamespace()
+				if !rs.namespaceScoped || requestNamespace == "" || requestNamespace == rs.namespace {
+					visibleRepos = append(visibleRepos, *repo)
+				}
 			}
 
 			// the index.yaml will contain links to charts but for the purposes
@@ -408,7 +464,7 @@ func TestGetAvailablePackageSummariesWithoutPagination(t *testing.T) {
 				t.Fatalf("error instantiating the server: %v", err)
 			}
 
-			if err = s.redisMockExpectGetFromRepoCache(mock, tc.request.FilterOptions, repos...); err != nil {
+			if err = s.redisMockExpectGetFromRepoCache(mock, tc.request.FilterOptions, visibleRepos...); err != nil {
 				t.Fatalf("%v", err)
 			}
 
@@ -672,14 +728,14 @@ func TestGetAvailablePackageSummaryAfterRepoIndexUpdate(t *testing.T) {
 			if err != nil {
 				t.Fatalf("%+v", err)
 			}
-			s.repoCache.ExpectAdd(key)
+			s.caches[KubeappsCluster].repoCache.ExpectAdd(key)
 
 			if err = ctrlClient.Update(ctx, &repo); err != nil {
 				// unlike dynamic.Interface.Update, client.Update will update an object in k8s
 				// and an Modified event will be fired
 				t.Fatal(err)
 			}
-			s.repoCache.WaitUntilForgotten(key)
+			s.caches[KubeappsCluster].repoCache.WaitUntilForgotten(key)
 
 			if err = mock.ExpectationsWereMet(); err != nil {
 				t.Fatalf("%v", err)
@@ -793,12 +849,12 @@ func TestGetAvailablePackageSummaryAfterFluxHelmRepoDelete(t *testing.T) {
 
 		chartCacheKeys := []string{}
 		for _, c := range chartsInCache {
-			chartCacheKeys = append(chartCacheKeys, fmt.Sprintf("helmcharts:%s:%s/%s", repoName.Namespace, repoName.Name, c))
+			chartCacheKeys = append(chartCacheKeys, fmt.Sprintf("helmcharts:%s:%s:%s/%s", KubeappsCluster, repoName.Namespace, repoName.Name, c))
 		}
 
-		s.repoCache.ExpectAdd(repoKey)
+		s.caches[KubeappsCluster].repoCache.ExpectAdd(repoKey)
 		for _, k := range chartCacheKeys {
-			s.chartCache.ExpectAdd(k)
+			s.caches[KubeappsCluster].chartCache.ExpectAdd(k)
 		}
 
 		ctx := context.Background()
@@ -808,9 +864,9 @@ func TestGetAvailablePackageSummaryAfterFluxHelmRepoDelete(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		s.repoCache.WaitUntilForgotten(repoKey)
+		s.caches[KubeappsCluster].repoCache.WaitUntilForgotten(repoKey)
 		for _, k := range chartCacheKeys {
-			s.chartCache.WaitUntilForgotten(k)
+			s.caches[KubeappsCluster].chartCache.WaitUntilForgotten(k)
 		}
 
 		if err = mock.ExpectationsWereMet(); err != nil {
@@ -875,7 +931,7 @@ func TestGetAvailablePackageSummaryAfterCacheResync(t *testing.T) {
 			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1, opt2))
 		}
 
-		resyncCh, err := s.repoCache.ExpectResync()
+		resyncCh, err := s.caches[KubeappsCluster].repoCache.ExpectResync()
 		if err != nil {
 			t.Fatalf("%v", err)
 		}
@@ -895,14 +951,14 @@ func TestGetAvailablePackageSummaryAfterCacheResync(t *testing.T) {
 		<-resyncCh
 
 		// set up expectations
-		mock.ExpectFlushDB().SetVal("OK")
+		redisMockExpectResync(mock, false)
 		if _, _, err := s.redisMockSetValueForRepo(mock, *repo, nil); err != nil {
 			t.Fatalf("%+v", err)
 		}
 
 		// tell server its okay to proceed
 		resyncCh <- 0
-		s.repoCache.WaitUntilResyncComplete()
+		s.caches[KubeappsCluster].repoCache.WaitUntilResyncComplete()
 
 		if err = mock.ExpectationsWereMet(); err != nil {
 			t.Fatalf("%v", err)
@@ -966,7 +1022,7 @@ func TestGetAvailablePackageSummariesAfterCacheResyncQueueNotIdle(t *testing.T)
 			repos = append(repos, repo)
 		}
 
-		s.repoCache.ExpectAdd(keysInOrder[0])
+		s.caches[KubeappsCluster].repoCache.ExpectAdd(keysInOrder[0])
 
 		ctrlClient, watcher, err := ctrlClientAndWatcher(t, s)
 		if err != nil {
@@ -984,12 +1040,12 @@ func TestGetAvailablePackageSummariesAfterCacheResyncQueueNotIdle(t *testing.T)
 
 		go func() {
 			// wait until the first of the added repos have been fully processed
-			s.repoCache.WaitUntilForgotten(keysInOrder[0])
+			s.caches[KubeappsCluster].repoCache.WaitUntilForgotten(keysInOrder[0])
 
 			// pretty delicate dance between the server and the client below using
 			// bi-directional channels in order to make sure the right expectations
 			// are set at the right time.
-			resyncCh, err := s.repoCache.ExpectResync()
+			resyncCh, err := s.caches[KubeappsCluster].repoCache.ExpectResync()
 			if err != nil {
 				t.Errorf("%v", err)
 			}
@@ -1002,7 +1058,7 @@ func TestGetAvailablePackageSummariesAfterCacheResyncQueueNotIdle(t *testing.T)
 			if len == 0 {
 				t.Errorf("ERROR: Expected non-empty repo work queue!")
 			} else {
-				mock.ExpectFlushDB().SetVal("OK")
+				redisMockExpectResync(mock, false)
 				// *SOME* of the repos have already been cached into redis at this point
 				// via the repo cache backround worker triggered by the Add event in the
 				// main goroutine. Those SET calls will need to be repeated due to
@@ -1013,7 +1069,7 @@ func TestGetAvailablePackageSummariesAfterCacheResyncQueueNotIdle(t *testing.T)
 				}
 				// now we can signal to the server it's ok to proceed
 				resyncCh <- 0
-				s.repoCache.WaitUntilResyncComplete()
+				s.caches[KubeappsCluster].repoCache.WaitUntilResyncComplete()
 				// we do ClearExpect() here to avoid things like
 				// "there is a remaining expectation which was not matched:
 				// [get helmrepositories:default:bitnami-4]"
@@ -1099,7 +1155,7 @@ func TestGetAvailablePackageSummariesAfterCacheResyncQueueIdle(t *testing.T) {
 		}
 		redisMockSetValueForRepo(mock, key, byteArray, nil)
 
-		s.repoCache.ExpectAdd(key)
+		s.caches[KubeappsCluster].repoCache.ExpectAdd(key)
 
 		ctrlClient, watcher, err := ctrlClientAndWatcher(t, s)
 		if err != nil {
@@ -1112,12 +1168,12 @@ func TestGetAvailablePackageSummariesAfterCacheResyncQueueIdle(t *testing.T) {
 
 		go func() {
 			// wait until the first of the added repos have been fully processed
-			s.repoCache.WaitUntilForgotten(key)
+			s.caches[KubeappsCluster].repoCache.WaitUntilForgotten(key)
 
 			// pretty delicate dance between the server and the client below using
 			// bi-directional channels in order to make sure the right expectations
 			// are set at the right time.
-			resyncCh, err := s.repoCache.ExpectResync()
+			resyncCh, err := s.caches[KubeappsCluster].repoCache.ExpectResync()
 			if err != nil {
 				t.Errorf("%v", err)
 			}
@@ -1130,11 +1186,11 @@ func TestGetAvailablePackageSummariesAfterCacheResyncQueueIdle(t *testing.T) {
 			if len != 0 {
 				t.Errorf("ERROR: Expected empty repo work queue!")
 			} else {
-				mock.ExpectFlushDB().SetVal("OK")
+				redisMockExpectResync(mock, false)
 				redisMockSetValueForRepo(mock, key, byteArray, nil)
 				// now we can signal to the server it's ok to proceed
 				resyncCh <- 0
-				s.repoCache.WaitUntilResyncComplete()
+				s.caches[KubeappsCluster].repoCache.WaitUntilResyncComplete()
 			}
 			done <- 0
 		}()
@@ -1206,9 +1262,11 @@ func TestAddPackageRepository(t *testing.T) {
 			statusCode: codes.InvalidArgument,
 		},
 		{
-			name:       "returns error if namespaced scoped",
-			request:    add_repo_req_1,
-			statusCode: codes.Unimplemented,
+			name:             "package repository namespace scoped",
+			request:          add_repo_req_1,
+			expectedResponse: add_repo_expected_resp,
+			expectedRepo:     &add_repo_6,
+			statusCode:       codes.OK,
 		},
 		{
 			name:       "returns error if wrong repository type",
@@ -1463,6 +1521,7 @@ func TestGetPackageRepositoryDetail(t *testing.T) {
 		expectedStatusCode codes.Code
 		expectedResponse   *corev1.GetPackageRepositoryDetailResponse
 		userManagedSecrets bool
+		namespaceScoped    bool
 	}{
 		{
 			name:               "get package repository detail simplest case",
@@ -1473,6 +1532,16 @@ func TestGetPackageRepositoryDetail(t *testing.T) {
 			expectedStatusCode: codes.OK,
 			expectedResponse:   get_repo_detail_resp_1,
 		},
+		{
+			name:               "get package repository detail of namespace-scoped repository",
+			repoIndex:          testYaml("valid-index.yaml"),
+			repoName:           "repo-1",
+			repoNamespace:      "namespace-1",
+			request:            get_repo_detail_req_1,
+			expectedStatusCode: codes.OK,
+			expectedResponse:   get_repo_detail_resp_17,
+			namespaceScoped:    true,
+		},
 		{
 			name:               "fails with NotFound when wrong identifier",
 			repoIndex:          testYaml("valid-index.yaml"),
@@ -1498,12 +1567,12 @@ func TestGetPackageRepositoryDetail(t *testing.T) {
 			expectedStatusCode: codes.InvalidArgument,
 		},
 		{
-			name:               "it returns an error status if cluster is not the global/kubeapps one",
+			name:               "it returns an error status if the plugin is not available for the cluster",
 			repoIndex:          testYaml("valid-index.yaml"),
 			repoName:           "repo-1",
 			repoNamespace:      "namespace-1",
 			request:            get_repo_detail_req_5,
-			expectedStatusCode: codes.Unimplemented,
+			expectedStatusCode: codes.InvalidArgument,
 		},
 		{
 			name:          "it returns package repository detail with TLS cert aurthority",
@@ -1656,6 +1725,9 @@ func TestGetPackageRepositoryDetail(t *testing.T) {
 				repo1 := newRepo(tc.repoName, tc.repoNamespace, repoSpec, repoStatus)
 				repo = &repo1
 			}
+			if tc.namespaceScoped {
+				repo.Annotations = map[string]string{namespaceScopedAnnotation: "true"}
+			}
 
 			// the index.yaml will contain links to charts but for the purposes
 			// of this test they do not matter
@@ -2285,6 +2357,14 @@ func redisMockSetValueForRepo(mock redismock.ClientMock, key string, newValue, o
 	mock.ExpectInfo("memory").SetVal("used_memory_rss_human:NA\r\nmaxmemory_human:NA")
 }
 
+// the keys of the caches are deleted on resync, only for the cluster being resynced
+func redisMockExpectResync(mock redismock.ClientMock, withChartCache bool) {
+	mock.ExpectScan(0, fmt.Sprintf("%s:%s:*", fluxHelmRepositories, KubeappsCluster), 0).SetVal([]string{}, 0)
+	if withChartCache {
+		mock.ExpectScan(0, fmt.Sprintf("%s:%s:*", "helmcharts", KubeappsCluster), 0).SetVal([]string{}, 0)
+	}
+}
+
 func (s *Server) redisKeyValueForRepo(r sourcev1.HelmRepository) (key string, byteArray []byte, err error) {
 	cg := func(ctx context.Context) (clientgetter.ClientInterfaces, error) {
 		return s.clientGetter(ctx, s.kubeappsCluster)
@@ -2328,8 +2408,8 @@ func redisKeyForRepoNamespacedName(name types.NamespacedName) (string, error) {
 	// redis convention on key format
 	// https://redis.io/topics/data-types-intro
 	// Try to stick with a schema. For instance "object-type:id" is a good idea, as in "user:1000".
-	// We will use "helmrepository:ns:repoName"
-	return fmt.Sprintf("%s:%s:%s", fluxHelmRepositories, name.Namespace, name.Name), nil
+	// We will use "helmrepositories:cluster:ns:repoName"
+	return fmt.Sprintf("%s:%s:%s:%s", fluxHelmRepositories, KubeappsCluster, name.Namespace, name.Name), nil
 }
 
 func newRepoWithIndex(repoIndex, repoName, repoNamespace string, replaceUrls map[string]string, secretRef string) (*httptest.Server, *sourcev1.HelmRepository, error) {
@@ -2387,3 +2467,133 @@ func newRepoWithIndex(repoIndex, repoName, repoNamespace string, replaceUrls map
 	repo := newRepo(repoName, repoNamespace, repoSpec, repoStatus)
 	return ts, &repo, nil
 }
+
+func TestRefreshPackageRepository(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            *corev1.RefreshPackageRepositoryRequest
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "refresh repository",
+			request: &corev1.RefreshPackageRepositoryRequest{
+				PackageRepoRef: repoRefInReq("repo-1", "namespace-1"),
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns not found if package repo doesn't exist",
+			request: &corev1.RefreshPackageRepositoryRequest{
+				PackageRepoRef: repoRefInReq("repo-kaka", "namespace-kaka"),
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts, repo, err := newRepoWithIndex(testYaml("valid-index.yaml"), "repo-1", "namespace-1", nil, "")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			defer ts.Close()
+
+			s, _, err := newServerWithRepos(t, []sourcev1.HelmRepository{*repo}, nil, nil)
+			if err != nil {
+				t.Fatalf("error instantiating the server: %v", err)
+			}
+
+			ctx := context.Background()
+			_, err = s.RefreshPackageRepository(ctx, tc.request)
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.expectedStatusCode == codes.OK {
+				// check the reconciliation of the repository was requested
+				ctrlClient, err := s.clientGetter.ControllerRuntime(ctx, s.kubeappsCluster)
+				if err != nil {
+					t.Fatal(err)
+				}
+				nsname := types.NamespacedName{
+					Namespace: tc.request.PackageRepoRef.Context.Namespace,
+					Name:      tc.request.PackageRepoRef.Identifier,
+				}
+				var actualRepo sourcev1.HelmRepository
+				if err = ctrlClient.Get(ctx, nsname, &actualRepo); err != nil {
+					t.Fatal(err)
+				}
+				if _, ok := actualRepo.Annotations[fluxmeta.ReconcileRequestAnnotation]; !ok {
+					t.Errorf("expected the annotation %q on repository [%s]", fluxmeta.ReconcileRequestAnnotation, nsname)
+				}
+			}
+		})
+	}
+}
+
+func TestSuspendResumeReconcilePackageRepository(t *testing.T) {
+	ts, repo, err := newRepoWithIndex(testYaml("valid-index.yaml"), "repo-1", "namespace-1", nil, "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer ts.Close()
+
+	s, _, err := newServerWithRepos(t, []sourcev1.HelmRepository{*repo}, nil, nil)
+	if err != nil {
+		t.Fatalf("error instantiating the server: %v", err)
+	}
+
+	ctx := context.Background()
+	repoRef := repoRefInReq("repo-1", "namespace-1")
+	nsname := types.NamespacedName{Namespace: "namespace-1", Name: "repo-1"}
+	getRepo := func() sourcev1.HelmRepository {
+		ctrlClient, err := s.clientGetter.ControllerRuntime(ctx, s.kubeappsCluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var actualRepo sourcev1.HelmRepository
+		if err = ctrlClient.Get(ctx, nsname, &actualRepo); err != nil {
+			t.Fatal(err)
+		}
+		return actualRepo
+	}
+
+	suspendResp, err := s.SuspendPackageRepository(ctx, &fluxplugin.SuspendPackageRepositoryRequest{PackageRepoRef: repoRef})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !suspendResp.Suspended || !getRepo().Spec.Suspend {
+		t.Errorf("expected repository [%s] to be suspended", nsname)
+	}
+	if got, want := suspendResp.Status.Reason, corev1.PackageRepositoryStatus_STATUS_REASON_SUCCESS; got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	// flux does not reconcile suspended repositories
+	_, err = s.ReconcilePackageRepository(ctx, &fluxplugin.ReconcilePackageRepositoryRequest{PackageRepoRef: repoRef})
+	if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+
+	resumeResp, err := s.ResumePackageRepository(ctx, &fluxplugin.ResumePackageRepositoryRequest{PackageRepoRef: repoRef})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if resumeResp.Suspended || getRepo().Spec.Suspend {
+		t.Errorf("expected repository [%s] not to be suspended", nsname)
+	}
+
+	if _, err = s.ReconcilePackageRepository(ctx, &fluxplugin.ReconcilePackageRepositoryRequest{PackageRepoRef: repoRef}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if _, ok := getRepo().Annotations[fluxmeta.ReconcileRequestAnnotation]; !ok {
+		t.Errorf("expected the annotation %q on repository [%s]", fluxmeta.ReconcileRequestAnnotation, nsname)
+	}
+
+	_, err = s.SuspendPackageRepository(ctx, &fluxplugin.SuspendPackageRepositoryRequest{
+		PackageRepoRef: repoRefInReq("repo-kaka", "namespace-kaka"),
+	})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
@@ -10,11 +10,13 @@ import (
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"helm.sh/helm/v3/pkg/action"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
@@ -26,6 +28,7 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/resourcerefs"
+	"github.com/vmware-tanzu/kubeapps/pkg/kube"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	log "k8s.io/klog/v2"
@@ -36,6 +39,8 @@ import (
 var _ corev1.PackagesServiceServer = (*Server)(nil)
 var _ corev1.RepositoriesServiceServer = (*Server)(nil)
 
+type helmActionConfigGetter func(ctx context.Context, pkgContext *corev1.Context) (*action.Configuration, error)
+
 // Server implements the fluxv2 packages v1alpha1 interface.
 type Server struct {
 	v1alpha1.UnimplementedFluxV2PackagesServiceServer
@@ -49,98 +54,198 @@ type Server struct {
 	// It is meant for in-band interactions (i.e. in the context of a caller)
 	// with k8s API server
 	clientGetter clientgetter.ClientGetterFunc
+
+	actionConfigGetter helmActionConfigGetter
+
+	// caches of the flux resources of each cluster the plugin can be used with,
+	// keyed by cluster name
+	caches map[string]*clusterCaches
+
+	pluginConfig *common.FluxPluginConfig
+
+	// the listers used to find the repositories of OCI registries, as chosen
+	// in the plugin configuration
+	ociRepositoryListers []OCIRepositoryLister
+}
+
+// clusterCaches are the caches of the repositories and charts of one cluster,
+// which are kept up to date in the background
+type clusterCaches struct {
 	// for interactions with k8s API server in the context of
-	// kubeapps-internal-kubeappsapis service account
+	// kubeapps-internal-kubeappsapis service account or, for additional
+	// clusters, the service token configured for the cluster
 	serviceAccountClientGetter clientgetter.BackgroundClientGetterFunc
 
-	actionConfigGetter clientgetter.HelmActionConfigGetterFunc
-
 	repoCache  *cache.NamespacedResourceWatcherCache
 	chartCache *cache.ChartCache
-
-	pluginConfig *common.FluxPluginConfig
+	// caches of the GitRepositories and Buckets marked for kubeapps, keyed by the type
+	// of repo of their charts. Either may be missing, e.g. in unit tests
+	sourceCaches map[string]*cache.NamespacedResourceWatcherCache
 }
 
 // NewServer returns a Server automatically configured with a function to obtain
 // the k8s client config.
-func NewServer(configGetter core.KubernetesConfigGetter, kubeappsCluster string, stopCh <-chan struct{}, pluginConfigPath string) (*Server, error) {
+func NewServer(configGetter core.KubernetesConfigGetter, clustersConfig kube.ClustersConfig, stopCh <-chan struct{}, pluginConfigPath string) (*Server, error) {
+	kubeappsCluster := clustersConfig.KubeappsClusterName
 	log.Infof("+fluxv2 NewServer(kubeappsCluster: [%v], pluginConfigPath: [%s]",
 		kubeappsCluster, pluginConfigPath)
 
-	if redisCli, err := common.NewRedisClientFromEnv(stopCh); err != nil {
+	var err error
+	pluginConfig := common.NewDefaultPluginConfig()
+	if pluginConfigPath != "" {
+		pluginConfig, err = common.ParsePluginConfig(pluginConfigPath)
+		if err != nil {
+			log.Fatalf("%s", err)
+		}
+		log.Infof("+fluxv2 using custom config: [%v]", *pluginConfig)
+	} else {
+		log.Info("+fluxv2 using default config since pluginConfigPath is empty")
+	}
+
+	storage, err := newCacheStorage(pluginConfig, stopCh)
+	if err != nil {
 		return nil, err
-	} else if chartCache, err := cache.NewChartCache("chartCache", redisCli, stopCh); err != nil {
+	}
+
+	ociRepositoryListers, err := newOCIRepositoryListers(pluginConfig.OCIRepositoryListers)
+	if err != nil {
 		return nil, err
-	} else {
-		pluginConfig := common.NewDefaultPluginConfig()
-		if pluginConfigPath != "" {
-			pluginConfig, err = common.ParsePluginConfig(pluginConfigPath)
-			if err != nil {
-				log.Fatalf("%s", err)
-			}
-			log.Infof("+fluxv2 using custom config: [%v]", *pluginConfig)
+	}
+
+	// register the GitOps Toolkit schema definitions
+	scheme := runtime.NewScheme()
+	err = sourcev1.AddToScheme(scheme)
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+	err = helmv2.AddToScheme(scheme)
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+
+	// the cluster on which Kubeapps is installed may not be one of the configured
+	// clusters, but is always watched
+	caches := map[string]*clusterCaches{}
+	if caches[kubeappsCluster], err = newClusterCaches(
+		configGetter, clustersConfig, kubeappsCluster, scheme, storage, ociRepositoryListers, stopCh); err != nil {
+		return nil, err
+	}
+	for cluster := range clustersConfig.Clusters {
+		if _, ok := caches[cluster]; ok {
+			continue
+		}
+		// additional clusters are optional, e.g. flux may not be installed on all of
+		// them, so a failure here only means the plugin can't be used with that cluster
+		if c, err := newClusterCaches(
+			configGetter, clustersConfig, cluster, scheme, storage, ociRepositoryListers, stopCh); err != nil {
+			log.Warningf("+fluxv2 the plugin will not be available for cluster [%s] due to: %v", cluster, err)
 		} else {
-			log.Info("+fluxv2 using default config since pluginConfigPath is empty")
+			caches[cluster] = c
 		}
+	}
 
-		// register the GitOps Toolkit schema definitions
-		scheme := runtime.NewScheme()
-		err = sourcev1.AddToScheme(scheme)
-		if err != nil {
-			log.Fatalf("%s", err)
-		}
-		err = helmv2.AddToScheme(scheme)
+	return &Server{
+		clientGetter: clientgetter.NewClientGetter(
+			configGetter, clientgetter.Options{Scheme: scheme}),
+		actionConfigGetter: func(ctx context.Context, pkgContext *corev1.Context) (*action.Configuration, error) {
+			cluster := pkgContext.GetCluster()
+			if cluster == "" {
+				cluster = kubeappsCluster
+			}
+			fn := clientgetter.NewHelmActionConfigGetter(configGetter, cluster)
+			return fn(ctx, pkgContext.GetNamespace())
+		},
+		caches:               caches,
+		kubeappsCluster:      kubeappsCluster,
+		pluginConfig:         pluginConfig,
+		ociRepositoryListers: ociRepositoryListers,
+	}, nil
+}
+
+// newCacheStorage returns the storage for the caches of all clusters, as chosen
+// in the plugin configuration
+func newCacheStorage(pluginConfig *common.FluxPluginConfig, stopCh <-chan struct{}) (cache.Storage, error) {
+	switch pluginConfig.CacheBackend {
+	case common.CacheBackendMemory:
+		log.Infof("+fluxv2 using in-memory cache of up to [%d] bytes", pluginConfig.CacheMaxMemoryBytes)
+		return cache.NewMemoryStorage(pluginConfig.CacheMaxMemoryBytes)
+	case common.CacheBackendRedis, "":
+		redisCli, err := common.NewRedisClientFromEnv(stopCh)
 		if err != nil {
-			log.Fatalf("%s", err)
+			return nil, err
 		}
+		return cache.NewRedisStorage(redisCli)
+	default:
+		return nil, fmt.Errorf("unsupported cache backend: %q", pluginConfig.CacheBackend)
+	}
+}
 
-		backgroundClientGetter := clientgetter.NewBackgroundClientGetter(
-			configGetter, clientgetter.Options{Scheme: scheme})
+// newClusterCaches creates the caches of the repositories and charts of the
+// given cluster, which share the storage with those of other clusters
+func newClusterCaches(configGetter core.KubernetesConfigGetter, clustersConfig kube.ClustersConfig, cluster string, scheme *runtime.Scheme, storage cache.Storage, ociRepositoryListers []OCIRepositoryLister, stopCh <-chan struct{}) (*clusterCaches, error) {
+	chartCache, err := cache.NewChartCache(fmt.Sprintf("chartCache-%s", cluster), cluster, storage, stopCh)
+	if err != nil {
+		return nil, err
+	}
 
-		s := repoEventSink{
-			clientGetter: backgroundClientGetter,
-			chartCache:   chartCache,
-		}
-		repoCacheConfig := cache.NamespacedResourceWatcherCacheConfig{
-			Gvr:          common.GetRepositoriesGvr(),
-			ClientGetter: s.clientGetter,
-			OnAddFunc:    s.onAddRepo,
-			OnModifyFunc: s.onModifyRepo,
-			OnGetFunc:    s.onGetRepo,
-			OnDeleteFunc: s.onDeleteRepo,
-			OnResyncFunc: s.onResync,
-			NewObjFunc:   func() ctrlclient.Object { return &sourcev1.HelmRepository{} },
-			NewListFunc:  func() ctrlclient.ObjectList { return &sourcev1.HelmRepositoryList{} },
-			ListItemsFunc: func(ol ctrlclient.ObjectList) []ctrlclient.Object {
-				if hl, ok := ol.(*sourcev1.HelmRepositoryList); !ok {
-					log.Errorf("Expected: *sourcev1.HelmRepositoryList, got: %s", reflect.TypeOf(ol))
-					return nil
-				} else {
-					ret := make([]ctrlclient.Object, len(hl.Items))
-					for i, hr := range hl.Items {
-						ret[i] = hr.DeepCopy()
-					}
-					return ret
+	backgroundClientGetter := clientgetter.NewBackgroundClientGetterForCluster(
+		configGetter, clustersConfig, cluster, clientgetter.Options{Scheme: scheme})
+
+	s := repoEventSink{
+		clientGetter:         backgroundClientGetter,
+		chartCache:           chartCache,
+		ociRepositoryListers: ociRepositoryListers,
+	}
+	repoCacheConfig := cache.NamespacedResourceWatcherCacheConfig{
+		Gvr:          common.GetRepositoriesGvr(),
+		Cluster:      cluster,
+		ClientGetter: s.clientGetter,
+		OnAddFunc:    s.onAddRepo,
+		OnModifyFunc: s.onModifyRepo,
+		OnGetFunc:    s.onGetRepo,
+		OnDeleteFunc: s.onDeleteRepo,
+		OnResyncFunc: s.onResync,
+		NewObjFunc:   func() ctrlclient.Object { return &sourcev1.HelmRepository{} },
+		NewListFunc:  func() ctrlclient.ObjectList { return &sourcev1.HelmRepositoryList{} },
+		ListItemsFunc: func(ol ctrlclient.ObjectList) []ctrlclient.Object {
+			if hl, ok := ol.(*sourcev1.HelmRepositoryList); !ok {
+				log.Errorf("Expected: *sourcev1.HelmRepositoryList, got: %s", reflect.TypeOf(ol))
+				return nil
+			} else {
+				ret := make([]ctrlclient.Object, len(hl.Items))
+				for i, hr := range hl.Items {
+					ret[i] = hr.DeepCopy()
 				}
-			},
-		}
-		if repoCache, err := cache.NewNamespacedResourceWatcherCache(
-			"repoCache", repoCacheConfig, redisCli, stopCh, false); err != nil {
-			return nil, err
+				return ret
+			}
+		},
+	}
+	repoCache, err := cache.NewNamespacedResourceWatcherCache(
+		fmt.Sprintf("repoCache-%s", cluster), repoCacheConfig, storage, stopCh, false)
+	if err != nil {
+		chartCache.Shutdown()
+		return nil, err
+	}
+
+	// charts from GitRepositories and Buckets are optional, so a failure here only
+	// means those charts are not available
+	sourceCaches := map[string]*cache.NamespacedResourceWatcherCache{}
+	for _, kind := range chartSourceKinds {
+		if sourceCache, err := cache.NewNamespacedResourceWatcherCache(
+			fmt.Sprintf("%sCache-%s", kind.repoType, cluster),
+			newChartSourceCacheConfig(cluster, kind, s), storage, stopCh, false); err != nil {
+			log.Warningf("+fluxv2 charts from %s sources will not be available for cluster [%s] due to: %v", kind.kind, cluster, err)
 		} else {
-			return &Server{
-				clientGetter: clientgetter.NewClientGetter(
-					configGetter, clientgetter.Options{Scheme: scheme}),
-				serviceAccountClientGetter: backgroundClientGetter,
-				actionConfigGetter: clientgetter.NewHelmActionConfigGetter(
-					configGetter, kubeappsCluster),
-				repoCache:       repoCache,
-				chartCache:      chartCache,
-				kubeappsCluster: kubeappsCluster,
-				pluginConfig:    pluginConfig,
-			}, nil
+			sourceCaches[kind.repoType] = sourceCache
 		}
 	}
+
+	return &clusterCaches{
+		serviceAccountClientGetter: backgroundClientGetter,
+		repoCache:                  repoCache,
+		chartCache:                 chartCache,
+		sourceCaches:               sourceCaches,
+	}, nil
 }
 
 // ===== general note on error handling ========
@@ -166,12 +271,9 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 
 	// grpc compiles in getters for you which automatically return a default (empty) struct
 	// if the pointer was nil
-	cluster := request.GetContext().GetCluster()
-	if request != nil && cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.Context.Cluster: [%v]",
-			request.Context.Cluster)
+	cluster, err := s.resolveCluster(request.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
 	}
 
 	itemOffset, err := paginate.ItemOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
@@ -179,7 +281,8 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 		return nil, err
 	}
 
-	charts, err := s.getChartsForRepos(ctx, request.GetFilterOptions().GetRepositories())
+	charts, err := s.getChartsForRepos(
+		ctx, cluster, request.GetContext().GetNamespace(), request.GetFilterOptions().GetRepositories())
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +296,7 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 
 	// per https://github.com/vmware-tanzu/kubeapps/pull/3686#issue-1038093832
 	for _, summary := range packageSummaries {
-		summary.AvailablePackageRef.Context.Cluster = s.kubeappsCluster
+		summary.AvailablePackageRef.Context.Cluster = cluster
 	}
 
 	// Only return a next page token if the request was for pagination and
@@ -228,15 +331,12 @@ func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.
 		return nil, status.Errorf(codes.InvalidArgument, "AvailablePackageReference is missing required 'namespace' field")
 	}
 
-	cluster := packageRef.Context.Cluster
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.AvailablePackageRef.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(packageRef.Context.Cluster)
+	if err != nil {
+		return nil, err
 	}
 
-	pkgDetail, err := s.availableChartDetail(ctx, request.GetAvailablePackageRef(), request.GetPkgVersion())
+	pkgDetail, err := s.availableChartDetail(ctx, cluster, request.GetAvailablePackageRef(), request.GetPkgVersion())
 	if err != nil {
 		return nil, err
 	}
@@ -264,12 +364,9 @@ func (s *Server) GetAvailablePackageVersions(ctx context.Context, request *corev
 		return nil, status.Errorf(codes.InvalidArgument, "required context or identifier not provided")
 	}
 
-	cluster := packageRef.Context.Cluster
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.AvailablePackageRef.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(packageRef.Context.Cluster)
+	if err != nil {
+		return nil, err
 	}
 
 	repoName, chartName, err := pkgutils.SplitPackageIdentifier(packageRef.Identifier)
@@ -279,7 +376,7 @@ func (s *Server) GetAvailablePackageVersions(ctx context.Context, request *corev
 
 	log.Infof("Requesting chart [%s] in namespace [%s]", chartName, namespace)
 	repo := types.NamespacedName{Namespace: namespace, Name: repoName}
-	chart, err := s.getChart(ctx, repo, chartName)
+	chart, err := s.getChart(ctx, cluster, repo, chartName)
 	if err != nil {
 		return nil, err
 	} else if chart != nil {
@@ -302,17 +399,14 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 		return nil, err
 	}
 
-	cluster := request.GetContext().GetCluster()
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(request.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
 	}
 
 	pageSize := request.GetPaginationOptions().GetPageSize()
 	installedPkgSummaries, err := s.paginatedInstalledPkgSummaries(
-		ctx, request.GetContext().GetNamespace(), pageSize, itemOffset)
+		ctx, cluster, request.GetContext().GetNamespace(), request.GetFilterOptions(), pageSize, itemOffset)
 	if err != nil {
 		return nil, err
 	}
@@ -331,6 +425,63 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 	return response, nil
 }
 
+// WatchInstalledPackageSummaries streams changes to the installed packages managed
+// by the 'fluxv2' plugin by watching the HelmRelease CRs
+func (s *Server) WatchInstalledPackageSummaries(request *corev1.WatchInstalledPackageSummariesRequest, stream corev1.PackagesService_WatchInstalledPackageSummariesServer) error {
+	log.Infof("+fluxv2 WatchInstalledPackageSummaries [%v]", request)
+
+	cluster, err := s.resolveCluster(request.GetContext().GetCluster())
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	watcher, err := s.watchReleasesInCluster(ctx, cluster, request.GetContext().GetNamespace())
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	// releases are only sent once ready, so we track the releases already sent
+	// to distinguish new releases from updated ones
+	sentReleases := map[types.NamespacedName]bool{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return status.Errorf(codes.Unavailable, "the watch of HelmReleases ended unexpectedly")
+			}
+			if event.Type == watch.Error {
+				return status.Errorf(codes.Internal, "error while watching HelmReleases: %v", event.Object)
+			}
+			rel, ok := event.Object.(*helmv2.HelmRelease)
+			if !ok {
+				continue
+			}
+			key := types.NamespacedName{Namespace: rel.Namespace, Name: rel.Name}
+			response, err := s.watchEventForRelease(ctx, cluster, event.Type, *rel, sentReleases[key])
+			if err != nil {
+				return err
+			} else if response == nil {
+				continue
+			}
+			if response.EventType == corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED {
+				delete(sentReleases, key)
+			} else {
+				sentReleases[key] = true
+			}
+			if err = stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // GetInstalledPackageDetail returns the package metadata managed by the 'fluxv2' plugin
 func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.GetInstalledPackageDetailRequest) (*corev1.GetInstalledPackageDetailResponse, error) {
 	log.Infof("+fluxv2 GetInstalledPackageDetail [%v]", request)
@@ -345,16 +496,19 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 		return nil, status.Errorf(codes.InvalidArgument, "InstalledPackageReference is missing required 'namespace' field")
 	}
 
-	cluster := packageRef.Context.GetCluster()
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.InstalledPackageRef.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(packageRef.Context.GetCluster())
+	if err != nil {
+		return nil, err
 	}
 
-	key := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: packageRef.Identifier}
-	pkgDetail, err := s.installedPackageDetail(ctx, key)
+	var pkgDetail *corev1.InstalledPackageDetail
+	if name, ok := kustomizationNameFromIdentifier(packageRef.Identifier); ok {
+		key := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: name}
+		pkgDetail, err = s.kustomizationDetail(ctx, cluster, key)
+	} else {
+		key := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: packageRef.Identifier}
+		pkgDetail, err = s.installedPackageDetail(ctx, cluster, key)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -375,12 +529,9 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 	if packageRef.GetContext().GetNamespace() == "" || packageRef.GetIdentifier() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "required context or identifier not provided")
 	}
-	cluster := packageRef.GetContext().GetCluster()
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.AvailablePackageRef.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(packageRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
 	}
 	if request.Name == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "no request Name provided")
@@ -388,23 +539,30 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 	if request.TargetContext == nil || request.TargetContext.Namespace == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "no request TargetContext namespace provided")
 	}
-	cluster = request.TargetContext.GetCluster()
-	if cluster != "" && cluster != s.kubeappsCluster {
+	if request.DryRun {
+		return nil, status.Errorf(codes.Unimplemented, "not supported yet: request.DryRun")
+	}
+	// a HelmRelease can only refer to a HelmRepository in its own cluster
+	if targetCluster, err := s.resolveCluster(request.TargetContext.GetCluster()); err != nil {
+		return nil, err
+	} else if targetCluster != cluster {
 		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.TargetContext.Cluster: [%v]",
-			request.TargetContext.Cluster)
+			codes.InvalidArgument,
+			"request.TargetContext.Cluster: [%v] differs from request.AvailablePackageRef.Context.Cluster: [%v]",
+			targetCluster, cluster)
 	}
 
 	name := types.NamespacedName{Name: request.Name, Namespace: request.TargetContext.Namespace}
 
 	if installedRef, err := s.newRelease(
 		ctx,
+		cluster,
 		request.AvailablePackageRef,
 		name,
 		request.PkgVersionReference,
 		request.ReconciliationOptions,
-		request.Values); err != nil {
+		request.Values,
+		request.CustomDetail); err != nil {
 		return nil, err
 	} else {
 		return &corev1.CreateInstalledPackageResponse{
@@ -422,20 +580,36 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 	}
 
 	installedPackageRef := request.InstalledPackageRef
-	cluster := installedPackageRef.GetContext().GetCluster()
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.installedPackageRef.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(installedPackageRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+	if request.DryRun {
+		return nil, status.Errorf(codes.Unimplemented, "not supported yet: request.DryRun")
+	}
+
+	if name, ok := kustomizationNameFromIdentifier(installedPackageRef.Identifier); ok {
+		if request.PkgVersionReference != nil || request.Values != "" || request.CustomDetail != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "only the reconciliation options of a Kustomization may be updated")
+		}
+		key := types.NamespacedName{Namespace: installedPackageRef.GetContext().GetNamespace(), Name: name}
+		if installedRef, err := s.updateKustomization(ctx, cluster, key, request.ReconciliationOptions); err != nil {
+			return nil, err
+		} else {
+			return &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: installedRef,
+			}, nil
+		}
 	}
 
 	if installedRef, err := s.updateRelease(
 		ctx,
+		cluster,
 		installedPackageRef,
 		request.PkgVersionReference,
 		request.ReconciliationOptions,
-		request.Values); err != nil {
+		request.Values,
+		request.CustomDetail); err != nil {
 		return nil, err
 	} else {
 		return &corev1.UpdateInstalledPackageResponse{
@@ -453,15 +627,18 @@ func (s *Server) DeleteInstalledPackage(ctx context.Context, request *corev1.Del
 	}
 
 	installedPackageRef := request.InstalledPackageRef
-	cluster := installedPackageRef.GetContext().GetCluster()
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.installedPackageRef.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(installedPackageRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.deleteRelease(ctx, request.InstalledPackageRef); err != nil {
+	if name, ok := kustomizationNameFromIdentifier(installedPackageRef.Identifier); ok {
+		key := types.NamespacedName{Namespace: installedPackageRef.GetContext().GetNamespace(), Name: name}
+		err = s.deleteKustomization(ctx, cluster, key)
+	} else {
+		err = s.deleteRelease(ctx, cluster, request.InstalledPackageRef)
+	}
+	if err != nil {
 		return nil, err
 	} else {
 		return &corev1.DeleteInstalledPackageResponse{}, nil
@@ -475,19 +652,42 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 	identifier := pkgRef.GetIdentifier()
 	log.InfoS("+fluxv2 GetInstalledPackageResourceRefs", "cluster", pkgRef.GetContext().GetCluster(), "namespace", pkgRef.GetContext().GetNamespace(), "id", identifier)
 
+	cluster, err := s.resolveCluster(pkgRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := kustomizationNameFromIdentifier(identifier); ok {
+		key := types.NamespacedName{Namespace: pkgRef.Context.Namespace, Name: name}
+		if refs, err := s.kustomizationResourceRefs(ctx, cluster, key); err != nil {
+			return nil, err
+		} else {
+			return &corev1.GetInstalledPackageResourceRefsResponse{
+				Context: &corev1.Context{
+					Cluster:   cluster,
+					Namespace: key.Namespace,
+				},
+				ResourceRefs: refs,
+			}, nil
+		}
+	}
+
 	key := types.NamespacedName{Namespace: pkgRef.Context.Namespace, Name: identifier}
-	rel, err := s.getReleaseInCluster(ctx, key)
+	rel, err := s.getReleaseInCluster(ctx, cluster, key)
 	if err != nil {
 		return nil, err
 	}
 	hrName := helmReleaseName(key, rel)
-	refs, err := resourcerefs.GetInstalledPackageResourceRefs(ctx, hrName, s.actionConfigGetter)
+	actionConfigGetter := func(ctx context.Context, namespace string) (*action.Configuration, error) {
+		return s.actionConfigGetter(ctx, &corev1.Context{Cluster: cluster, Namespace: namespace})
+	}
+	refs, err := resourcerefs.GetInstalledPackageResourceRefs(ctx, hrName, actionConfigGetter)
 	if err != nil {
 		return nil, err
 	} else {
 		return &corev1.GetInstalledPackageResourceRefsResponse{
 			Context: &corev1.Context{
-				Cluster: s.kubeappsCluster,
+				Cluster: cluster,
 				// TODO (gfichtenholt) it is not specifically called out in the spec why there is a
 				// need for a Context in the response and MORE imporantly what the value of Namespace
 				// field should be. In particular, there is use case when Flux Helm Release in
@@ -503,6 +703,201 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 	}
 }
 
+// GetInstalledPackageRevisions returns the revisions of an installed package
+// from the history of the helm release created by flux.
+func (s *Server) GetInstalledPackageRevisions(ctx context.Context, request *corev1.GetInstalledPackageRevisionsRequest) (*corev1.GetInstalledPackageRevisionsResponse, error) {
+	log.Infof("+fluxv2 GetInstalledPackageRevisions [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+
+	installedPackageRef := request.InstalledPackageRef
+	cluster, err := s.resolveCluster(installedPackageRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := kustomizationNameFromIdentifier(installedPackageRef.Identifier); ok {
+		return nil, status.Errorf(codes.Unimplemented, "not supported yet: revisions of Kustomizations")
+	}
+
+	key := types.NamespacedName{Namespace: installedPackageRef.GetContext().GetNamespace(), Name: installedPackageRef.GetIdentifier()}
+	if revisions, err := s.installedPackageRevisions(ctx, cluster, key); err != nil {
+		return nil, err
+	} else {
+		return &corev1.GetInstalledPackageRevisionsResponse{
+			Revisions: revisions,
+		}, nil
+	}
+}
+
+// RollbackInstalledPackage rolls back an installed package by updating the
+// HelmRelease to the chart version and inline values of a previous revision.
+func (s *Server) RollbackInstalledPackage(ctx context.Context, request *corev1.RollbackInstalledPackageRequest) (*corev1.RollbackInstalledPackageResponse, error) {
+	log.Infof("+fluxv2 RollbackInstalledPackage [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+
+	installedPackageRef := request.InstalledPackageRef
+	cluster, err := s.resolveCluster(installedPackageRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := kustomizationNameFromIdentifier(installedPackageRef.Identifier); ok {
+		return nil, status.Errorf(codes.Unimplemented, "not supported yet: rollbacks of Kustomizations")
+	}
+
+	if installedRef, err := s.rollbackRelease(ctx, cluster, installedPackageRef, int(request.GetReleaseRevision())); err != nil {
+		return nil, err
+	} else {
+		return &corev1.RollbackInstalledPackageResponse{
+			InstalledPackageRef: installedRef,
+		}, nil
+	}
+}
+
+// SuspendInstalledPackage suspends the reconciliation of an installed package
+// until it is resumed.
+func (s *Server) SuspendInstalledPackage(ctx context.Context, request *v1alpha1.SuspendInstalledPackageRequest) (*v1alpha1.SuspendInstalledPackageResponse, error) {
+	log.Infof("+fluxv2 SuspendInstalledPackage [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+
+	installedPackageRef := request.InstalledPackageRef
+	cluster, err := s.resolveCluster(installedPackageRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := kustomizationNameFromIdentifier(installedPackageRef.Identifier); ok {
+		key := types.NamespacedName{Namespace: installedPackageRef.GetContext().GetNamespace(), Name: name}
+		if k, err := s.setKustomizationSuspended(ctx, cluster, key, true); err != nil {
+			return nil, err
+		} else {
+			return &v1alpha1.SuspendInstalledPackageResponse{
+				Status:    kustomizationInstalledPackageStatus(k),
+				Suspended: k.Spec.Suspend,
+			}, nil
+		}
+	}
+
+	if rel, err := s.setReleaseSuspended(ctx, cluster, installedPackageRef, true); err != nil {
+		return nil, err
+	} else {
+		return &v1alpha1.SuspendInstalledPackageResponse{
+			Status:    installedPackageStatus(*rel),
+			Suspended: rel.Spec.Suspend,
+		}, nil
+	}
+}
+
+// ResumeInstalledPackage resumes the reconciliation of a suspended installed package.
+func (s *Server) ResumeInstalledPackage(ctx context.Context, request *v1alpha1.ResumeInstalledPackageRequest) (*v1alpha1.ResumeInstalledPackageResponse, error) {
+	log.Infof("+fluxv2 ResumeInstalledPackage [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+
+	installedPackageRef := request.InstalledPackageRef
+	cluster, err := s.resolveCluster(installedPackageRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := kustomizationNameFromIdentifier(installedPackageRef.Identifier); ok {
+		key := types.NamespacedName{Namespace: installedPackageRef.GetContext().GetNamespace(), Name: name}
+		if k, err := s.setKustomizationSuspended(ctx, cluster, key, false); err != nil {
+			return nil, err
+		} else {
+			return &v1alpha1.ResumeInstalledPackageResponse{
+				Status:    kustomizationInstalledPackageStatus(k),
+				Suspended: k.Spec.Suspend,
+			}, nil
+		}
+	}
+
+	if rel, err := s.setReleaseSuspended(ctx, cluster, installedPackageRef, false); err != nil {
+		return nil, err
+	} else {
+		return &v1alpha1.ResumeInstalledPackageResponse{
+			Status:    installedPackageStatus(*rel),
+			Suspended: rel.Spec.Suspend,
+		}, nil
+	}
+}
+
+// ReconcileInstalledPackage requests the reconciliation of an installed package
+// now, rather than at the next reconciliation interval.
+func (s *Server) ReconcileInstalledPackage(ctx context.Context, request *v1alpha1.ReconcileInstalledPackageRequest) (*v1alpha1.ReconcileInstalledPackageResponse, error) {
+	log.Infof("+fluxv2 ReconcileInstalledPackage [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+
+	installedPackageRef := request.InstalledPackageRef
+	cluster, err := s.resolveCluster(installedPackageRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := kustomizationNameFromIdentifier(installedPackageRef.Identifier); ok {
+		key := types.NamespacedName{Namespace: installedPackageRef.GetContext().GetNamespace(), Name: name}
+		if k, err := s.reconcileKustomization(ctx, cluster, key); err != nil {
+			return nil, err
+		} else {
+			return &v1alpha1.ReconcileInstalledPackageResponse{
+				Status:    kustomizationInstalledPackageStatus(k),
+				Suspended: k.Spec.Suspend,
+			}, nil
+		}
+	}
+
+	if rel, err := s.reconcileRelease(ctx, cluster, installedPackageRef); err != nil {
+		return nil, err
+	} else {
+		return &v1alpha1.ReconcileInstalledPackageResponse{
+			Status:    installedPackageStatus(*rel),
+			Suspended: rel.Spec.Suspend,
+		}, nil
+	}
+}
+
+// CreateKustomization creates an installed package for the manifests at a path
+// of a GitRepository or OCIRepository, i.e. a flux Kustomization.
+func (s *Server) CreateKustomization(ctx context.Context, request *v1alpha1.CreateKustomizationRequest) (*v1alpha1.CreateKustomizationResponse, error) {
+	log.Infof("+fluxv2 CreateKustomization [%v]", request)
+
+	if request == nil || request.SourceRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request SourceRef provided")
+	}
+	if request.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "no request Name provided")
+	}
+	if request.TargetContext == nil || request.TargetContext.Namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "no request TargetContext namespace provided")
+	}
+	cluster, err := s.resolveCluster(request.TargetContext.GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if installedRef, err := s.createKustomization(ctx, cluster, request); err != nil {
+		return nil, err
+	} else {
+		return &v1alpha1.CreateKustomizationResponse{
+			InstalledPackageRef: installedRef,
+		}, nil
+	}
+}
+
 func (s *Server) AddPackageRepository(ctx context.Context, request *corev1.AddPackageRepositoryRequest) (*corev1.AddPackageRepositoryResponse, error) {
 	log.Infof("+fluxv2 AddPackageRepository [%v]", request)
 	if request == nil {
@@ -511,15 +906,12 @@ func (s *Server) AddPackageRepository(ctx context.Context, request *corev1.AddPa
 	if request.Context == nil || request.Context.Namespace == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "no request Context namespace provided")
 	}
-	cluster := request.GetContext().GetCluster()
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.Context.Cluster: [%v]",
-			request.Context.Cluster)
+	cluster, err := s.resolveCluster(request.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
 	}
 
-	if repoRef, err := s.newRepo(ctx, request); err != nil {
+	if repoRef, err := s.newRepo(ctx, cluster, request); err != nil {
 		return nil, err
 	} else {
 		return &corev1.AddPackageRepositoryResponse{PackageRepoRef: repoRef}, nil
@@ -539,15 +931,12 @@ func (s *Server) GetPackageRepositoryDetail(ctx context.Context, request *corev1
 		return nil, status.Errorf(codes.InvalidArgument, "PackageRepositoryReference is missing required namespace")
 	}
 
-	cluster := repoRef.Context.Cluster
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.PackageRepoRef.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(repoRef.Context.Cluster)
+	if err != nil {
+		return nil, err
 	}
 
-	repoDetail, err := s.repoDetail(ctx, repoRef)
+	repoDetail, err := s.repoDetail(ctx, cluster, repoRef)
 	if err != nil {
 		return nil, err
 	}
@@ -560,15 +949,12 @@ func (s *Server) GetPackageRepositoryDetail(ctx context.Context, request *corev1
 // GetPackageRepositorySummaries returns the package repositories managed by the 'fluxv2' plugin
 func (s *Server) GetPackageRepositorySummaries(ctx context.Context, request *corev1.GetPackageRepositorySummariesRequest) (*corev1.GetPackageRepositorySummariesResponse, error) {
 	log.Infof("+fluxv2 GetPackageRepositorySummaries [%v]", request)
-	cluster := request.GetContext().GetCluster()
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(request.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
 	}
 
-	if summaries, err := s.repoSummaries(ctx, request.GetContext().GetNamespace()); err != nil {
+	if summaries, err := s.repoSummaries(ctx, cluster, request.GetContext().GetNamespace()); err != nil {
 		return nil, err
 	} else {
 		return &corev1.GetPackageRepositorySummariesResponse{
@@ -585,15 +971,12 @@ func (s *Server) UpdatePackageRepository(ctx context.Context, request *corev1.Up
 	}
 
 	repoRef := request.PackageRepoRef
-	cluster := repoRef.GetContext().GetCluster()
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.packageRepoRef.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(repoRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
 	}
 
-	if responseRef, err := s.updateRepo(ctx, repoRef, request.Url, request.Interval, request.TlsConfig, request.Auth); err != nil {
+	if responseRef, err := s.updateRepo(ctx, cluster, repoRef, request.Url, request.Interval, request.TlsConfig, request.Auth); err != nil {
 		return nil, err
 	} else {
 		return &corev1.UpdatePackageRepositoryResponse{
@@ -610,21 +993,119 @@ func (s *Server) DeletePackageRepository(ctx context.Context, request *corev1.De
 	}
 
 	repoRef := request.PackageRepoRef
-	cluster := repoRef.GetContext().GetCluster()
-	if cluster != "" && cluster != s.kubeappsCluster {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.packageRepoRef.Context.Cluster: [%v]",
-			cluster)
+	cluster, err := s.resolveCluster(repoRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.deleteRepo(ctx, repoRef); err != nil {
+	if err := s.deleteRepo(ctx, cluster, repoRef); err != nil {
 		return nil, err
 	} else {
 		return &corev1.DeletePackageRepositoryResponse{}, nil
 	}
 }
 
+// RefreshPackageRepository requests a reconciliation of a package repository
+// based on the request.
+func (s *Server) RefreshPackageRepository(ctx context.Context, request *corev1.RefreshPackageRepositoryRequest) (*corev1.RefreshPackageRepositoryResponse, error) {
+	log.Infof("+fluxv2 RefreshPackageRepository [%v]", request)
+	if request == nil || request.PackageRepoRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request PackageRepoRef provided")
+	}
+
+	repoRef := request.PackageRepoRef
+	cluster, err := s.resolveCluster(repoRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if responseRef, err := s.refreshRepo(ctx, cluster, repoRef); err != nil {
+		return nil, err
+	} else {
+		return &corev1.RefreshPackageRepositoryResponse{
+			PackageRepoRef: responseRef,
+		}, nil
+	}
+}
+
+// SuspendPackageRepository suspends the reconciliation of a package repository
+// until it is resumed.
+func (s *Server) SuspendPackageRepository(ctx context.Context, request *v1alpha1.SuspendPackageRepositoryRequest) (*v1alpha1.SuspendPackageRepositoryResponse, error) {
+	log.Infof("+fluxv2 SuspendPackageRepository [%v]", request)
+	if request == nil || request.PackageRepoRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request PackageRepoRef provided")
+	}
+
+	repoRef := request.PackageRepoRef
+	cluster, err := s.resolveCluster(repoRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if repo, err := s.setRepoSuspended(ctx, cluster, repoRef, true); err != nil {
+		return nil, err
+	} else {
+		return &v1alpha1.SuspendPackageRepositoryResponse{
+			Status:    repoStatus(*repo),
+			Suspended: repo.Spec.Suspend,
+		}, nil
+	}
+}
+
+// ResumePackageRepository resumes the reconciliation of a suspended package repository.
+func (s *Server) ResumePackageRepository(ctx context.Context, request *v1alpha1.ResumePackageRepositoryRequest) (*v1alpha1.ResumePackageRepositoryResponse, error) {
+	log.Infof("+fluxv2 ResumePackageRepository [%v]", request)
+	if request == nil || request.PackageRepoRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request PackageRepoRef provided")
+	}
+
+	repoRef := request.PackageRepoRef
+	cluster, err := s.resolveCluster(repoRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if repo, err := s.setRepoSuspended(ctx, cluster, repoRef, false); err != nil {
+		return nil, err
+	} else {
+		return &v1alpha1.ResumePackageRepositoryResponse{
+			Status:    repoStatus(*repo),
+			Suspended: repo.Spec.Suspend,
+		}, nil
+	}
+}
+
+// ReconcilePackageRepository requests the reconciliation of a package repository
+// now, rather than at the next reconciliation interval.
+func (s *Server) ReconcilePackageRepository(ctx context.Context, request *v1alpha1.ReconcilePackageRepositoryRequest) (*v1alpha1.ReconcilePackageRepositoryResponse, error) {
+	log.Infof("+fluxv2 ReconcilePackageRepository [%v]", request)
+	if request == nil || request.PackageRepoRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request PackageRepoRef provided")
+	}
+
+	repoRef := request.PackageRepoRef
+	cluster, err := s.resolveCluster(repoRef.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	if repo, err := s.reconcileRepo(ctx, cluster, repoRef); err != nil {
+		return nil, err
+	} else {
+		return &v1alpha1.ReconcilePackageRepositoryResponse{
+			Status:    repoStatus(*repo),
+			Suspended: repo.Spec.Suspend,
+		}, nil
+	}
+}
+
+// ValidatePackageRepository is not supported by the 'fluxv2' plugin, whose
+// repositories are validated by the source-controller once added.
+func (s *Server) ValidatePackageRepository(ctx context.Context, request *corev1.ValidatePackageRepositoryRequest) (*corev1.ValidatePackageRepositoryResponse, error) {
+	log.Infof("+fluxv2 ValidatePackageRepository [%v]", request)
+	return nil, status.Errorf(codes.Unimplemented, "not supported yet: ValidatePackageRepository")
+}
+
 // This endpoint exists only for integration unit tests
 func (s *Server) SetUserManagedSecrets(ctx context.Context, request *v1alpha1.SetUserManagedSecretsRequest) (*v1alpha1.SetUserManagedSecretsResponse, error) {
 	log.Infof("+fluxv2 SetUserManagedSecrets [%t]", request.Value)
@@ -639,9 +1120,9 @@ func (s *Server) SetUserManagedSecrets(ctx context.Context, request *v1alpha1.Se
 // use cases when something happens in background as a result of a watch event,
 // aka an "out-of-band" interaction and use cases when the user wants something
 // done explicitly, aka "in-band" interaction
-func (s *Server) newRepoEventSink() repoEventSink {
+func (s *Server) newRepoEventSink(cluster string) repoEventSink {
 	cg := func(ctx context.Context) (clientgetter.ClientInterfaces, error) {
-		return s.clientGetter(ctx, s.kubeappsCluster)
+		return s.clientGetter(ctx, cluster)
 	}
 
 	// notice a bit of inconsistency here, we are using s.clientGetter
@@ -653,14 +1134,41 @@ func (s *Server) newRepoEventSink() repoEventSink {
 	// settings are more permissive than that of the default RBAC for
 	// kubeapps-internal-kubeappsapis account. If we don't like that behavior,
 	// I can easily switch to BackgroundClientGetter here
+	var chartCache *cache.ChartCache
+	if caches, ok := s.caches[cluster]; ok {
+		chartCache = caches.chartCache
+	}
 	return repoEventSink{
-		clientGetter: cg,
-		chartCache:   s.chartCache,
+		clientGetter:         cg,
+		chartCache:           chartCache,
+		ociRepositoryListers: s.ociRepositoryListers,
+	}
+}
+
+// resolveCluster returns the cluster a request is for, which defaults to the
+// cluster on which Kubeapps is installed, as long as the plugin is available
+// for it
+func (s *Server) resolveCluster(cluster string) (string, error) {
+	if cluster == "" {
+		cluster = s.kubeappsCluster
+	}
+	if _, ok := s.caches[cluster]; !ok {
+		return "", status.Errorf(codes.InvalidArgument, "the fluxv2 plugin is not available for cluster [%s]", cluster)
+	}
+	return cluster, nil
+}
+
+// cachesFor returns the caches of the repositories and charts of a cluster
+func (s *Server) cachesFor(cluster string) (*clusterCaches, error) {
+	if caches, ok := s.caches[cluster]; !ok || caches == nil || caches.repoCache == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "server cache for cluster [%s] has not been properly initialized", cluster)
+	} else {
+		return caches, nil
 	}
 }
 
-func (s *Server) getClient(ctx context.Context, namespace string) (ctrlclient.Client, error) {
-	client, err := s.clientGetter.ControllerRuntime(ctx, s.kubeappsCluster)
+func (s *Server) getClient(ctx context.Context, cluster, namespace string) (ctrlclient.Client, error) {
+	client, err := s.clientGetter.ControllerRuntime(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -668,8 +1176,8 @@ func (s *Server) getClient(ctx context.Context, namespace string) (ctrlclient.Cl
 }
 
 // hasAccessToNamespace returns an error if the client does not have read access to a given namespace
-func (s *Server) hasAccessToNamespace(ctx context.Context, gvr schema.GroupVersionResource, namespace string) (bool, error) {
-	typedCli, err := s.clientGetter.Typed(ctx, s.kubeappsCluster)
+func (s *Server) hasAccessToNamespace(ctx context.Context, cluster string, gvr schema.GroupVersionResource, namespace string) (bool, error) {
+	typedCli, err := s.clientGetter.Typed(ctx, cluster)
 	if err != nil {
 		return false, err
 	}
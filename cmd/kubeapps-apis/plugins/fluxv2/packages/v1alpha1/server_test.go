@@ -202,6 +202,112 @@ type testSpecChartWithUrl struct {
 	numRetries int
 }
 
+func TestMultipleClusters(t *testing.T) {
+	const otherCluster = "other"
+
+	storage, err := cache.NewMemoryStorage(1024)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	caches := map[string]*clusterCaches{}
+	for _, cluster := range []string{KubeappsCluster, otherCluster} {
+		chartCache, err := cache.NewChartCache("chartCache-"+cluster, cluster, storage, stopCh)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		defer chartCache.Shutdown()
+		caches[cluster] = &clusterCaches{
+			repoCache:  &cache.NamespacedResourceWatcherCache{},
+			chartCache: chartCache,
+		}
+	}
+	s := &Server{
+		caches:          caches,
+		kubeappsCluster: KubeappsCluster,
+	}
+
+	testCases := []struct {
+		name            string
+		cluster         string
+		expectedCluster string
+		expectedCode    codes.Code
+	}{
+		{
+			name:            "it defaults to the cluster on which Kubeapps is installed",
+			cluster:         "",
+			expectedCluster: KubeappsCluster,
+		},
+		{
+			name:            "it resolves an additional cluster",
+			cluster:         otherCluster,
+			expectedCluster: otherCluster,
+		},
+		{
+			name:         "it rejects a cluster for which the plugin is not available",
+			cluster:      "unknown",
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster, err := s.resolveCluster(tc.cluster)
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedCode != codes.OK {
+				return
+			}
+			if got, want := cluster, tc.expectedCluster; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			caches, err := s.cachesFor(cluster)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := caches, s.caches[tc.expectedCluster]; got != want {
+				t.Errorf("got the caches of another cluster")
+			}
+		})
+	}
+
+	t.Run("the caches of each cluster are separate", func(t *testing.T) {
+		defaultCaches, err := s.cachesFor(KubeappsCluster)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		otherCaches, err := s.cachesFor(otherCluster)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if defaultCaches.repoCache == otherCaches.repoCache || defaultCaches.chartCache == otherCaches.chartCache {
+			t.Fatalf("expected separate caches for each cluster")
+		}
+
+		// the same chart in both clusters is stored under different keys
+		defaultKey, err := defaultCaches.chartCache.KeyFor("default", "bitnami/redis", "14.4.0")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		otherKey, err := otherCaches.chartCache.KeyFor("default", "bitnami/redis", "14.4.0")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if defaultKey == otherKey {
+			t.Errorf("expected different chart keys for each cluster, got: %q", defaultKey)
+		}
+	})
+
+	t.Run("it returns an error for the caches of an unknown cluster", func(t *testing.T) {
+		if _, err := s.cachesFor("unknown"); status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("got: %+v, want: %+v", status.Code(err), codes.FailedPrecondition)
+		}
+	})
+}
+
 func newSimpleServerWithRepos(t *testing.T, repos []sourcev1.HelmRepository) (*Server, redismock.ClientMock, error) {
 	return newServerWithRepos(t, repos, nil, nil)
 }
@@ -245,6 +351,12 @@ func newServerWithRepos(t *testing.T, repos []sourcev1.HelmRepository, charts []
 }
 
 func newServerWithChartsAndReleases(t *testing.T, actionConfig *action.Configuration, charts []sourcev1.HelmChart, releases []helmv2.HelmRelease) (*Server, redismock.ClientMock, error) {
+	return newServerWithReposChartsAndReleases(t, actionConfig, nil, charts, releases)
+}
+
+// newServerWithReposChartsAndReleases is like newServerWithChartsAndReleases, with
+// the given repos in addition and, when there are any, an empty chart cache.
+func newServerWithReposChartsAndReleases(t *testing.T, actionConfig *action.Configuration, repos []sourcev1.HelmRepository, charts []sourcev1.HelmChart, releases []helmv2.HelmRelease) (*Server, redismock.ClientMock, error) {
 	typedClient := typfake.NewSimpleClientset()
 	// Creating an authorized clientGetter
 	typedClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
@@ -254,7 +366,7 @@ func newServerWithChartsAndReleases(t *testing.T, actionConfig *action.Configura
 	})
 
 	apiextIfc := apiextfake.NewSimpleClientset(fluxHelmRepositoryCRD)
-	ctrlClient := newCtrlClient(nil, charts, releases)
+	ctrlClient := newCtrlClient(repos, charts, releases)
 	clientGetter := func(context.Context, string) (clientgetter.ClientInterfaces, error) {
 		return clientgetter.
 			NewBuilder().
@@ -263,7 +375,11 @@ func newServerWithChartsAndReleases(t *testing.T, actionConfig *action.Configura
 			WithControllerRuntime(&ctrlClient).
 			Build(), nil
 	}
-	return newServer(t, clientGetter, actionConfig, nil, nil)
+	var chartSpecs []testSpecChartWithUrl
+	if len(repos) > 0 {
+		chartSpecs = []testSpecChartWithUrl{}
+	}
+	return newServer(t, clientGetter, actionConfig, repos, chartSpecs)
 }
 
 // newHelmActionConfig returns an action.Configuration with fake clients and memory storage.
@@ -284,9 +400,13 @@ func newHelmActionConfig(t *testing.T, namespace string, rels []helmReleaseStub)
 
 	for _, r := range rels {
 		config := map[string]interface{}{}
+		if r.values != nil {
+			config = r.values
+		}
 		rel := &release.Release{
 			Name:      r.name,
 			Namespace: r.namespace,
+			Version:   r.version,
 			Info: &release.Info{
 				Status: r.status,
 				Notes:  r.notes,
@@ -330,10 +450,10 @@ func newServer(t *testing.T,
 	mock.MatchExpectationsInOrder(false)
 
 	if clientGetter != nil {
-		// if client getter returns an error, FLUSHDB call does not take place, because
-		// newCacheWithRedisClient() raises an error before redisCli.FlushDB() call
+		// if client getter returns an error, the keys of the cache are not deleted,
+		// because newCacheWithRedisClient() raises an error before resync() call
 		if _, err := clientGetter(context.TODO(), ""); err == nil {
-			mock.ExpectFlushDB().SetVal("OK")
+			redisMockExpectResync(mock, charts != nil)
 		}
 	}
 
@@ -370,12 +490,15 @@ func newServer(t *testing.T,
 	}
 
 	var chartCache *cache.ChartCache
-	var err error
+	storage, err := cache.NewRedisStorage(redisCli)
+	if err != nil {
+		return nil, mock, err
+	}
 	cachedChartKeys := sets.String{}
 	cachedChartIds := sets.String{}
 
 	if charts != nil {
-		chartCache, err = cache.NewChartCache("chartCacheTest", redisCli, stopCh)
+		chartCache, err = cache.NewChartCache("chartCacheTest", KubeappsCluster, storage, stopCh)
 		if err != nil {
 			return nil, mock, err
 		}
@@ -415,6 +538,7 @@ func newServer(t *testing.T,
 
 	cacheConfig := cache.NamespacedResourceWatcherCacheConfig{
 		Gvr:          common.GetRepositoriesGvr(),
+		Cluster:      KubeappsCluster,
 		ClientGetter: backgroundClientGetter,
 		OnAddFunc:    sink.onAddRepo,
 		OnModifyFunc: sink.onModifyRepo,
@@ -438,7 +562,7 @@ func newServer(t *testing.T,
 	}
 
 	repoCache, err := cache.NewNamespacedResourceWatcherCache(
-		"repoCacheTest", cacheConfig, redisCli, stopCh, true)
+		"repoCacheTest", cacheConfig, storage, stopCh, true)
 	if err != nil {
 		return nil, mock, err
 	}
@@ -457,13 +581,17 @@ func newServer(t *testing.T,
 	}
 
 	s := &Server{
-		clientGetter:               clientGetter,
-		serviceAccountClientGetter: backgroundClientGetter,
-		actionConfigGetter: func(context.Context, string) (*action.Configuration, error) {
+		clientGetter: clientGetter,
+		actionConfigGetter: func(context.Context, *corev1.Context) (*action.Configuration, error) {
 			return actionConfig, nil
 		},
-		repoCache:       repoCache,
-		chartCache:      chartCache,
+		caches: map[string]*clusterCaches{
+			KubeappsCluster: {
+				serviceAccountClientGetter: backgroundClientGetter,
+				repoCache:                  repoCache,
+				chartCache:                 chartCache,
+			},
+		},
 		kubeappsCluster: KubeappsCluster,
 		pluginConfig:    common.NewDefaultPluginConfig(),
 	}
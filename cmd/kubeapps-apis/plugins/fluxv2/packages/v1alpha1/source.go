@@ -0,0 +1,808 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/cache"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/fluxv2/packages/v1alpha1/common"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+	httpclient "github.com/vmware-tanzu/kubeapps/pkg/http-client"
+	"github.com/vmware-tanzu/kubeapps/pkg/tarutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/chart"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	log "k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// see docs at https://fluxcd.io/docs/components/source/gitrepositories/ and
+	// https://fluxcd.io/docs/components/source/buckets/
+	fluxGitRepositories = "gitrepositories"
+	fluxBuckets         = "buckets"
+
+	// unlike HelmRepositories, GitRepositories and Buckets are only indexed when
+	// they are marked with this annotation, which lists the paths of the charts in
+	// the artifact of the source, separated by commas, e.g. "charts/podinfo,charts/redis"
+	chartPathsAnnotation = "kubeapps.dev/chart-paths"
+
+	// the types of the repos of the charts found in GitRepositories and Buckets
+	repoTypeGit    = "git"
+	repoTypeBucket = "bucket"
+)
+
+// chartSource is a flux source whose artifact is a tarball that may contain charts,
+// i.e. a GitRepository or a Bucket.
+// The charts of a source are identified the same way as those of a HelmRepository, i.e.
+// "sourceName/chartName", where chartName is the name of the directory of the chart.
+// So the charts of a source with the same name as a HelmRepository, or as a source of
+// an earlier kind, in the same namespace are not available, as their identifiers
+// would refer to the charts of the latter
+type chartSource interface {
+	ctrlclient.Object
+	GetArtifact() *sourcev1.Artifact
+	GetConditions() []metav1.Condition
+}
+
+// chartSourceKind has what is needed to watch and look up one kind of chart source
+type chartSourceKind struct {
+	kind     string
+	repoType string
+	gvr      schema.GroupVersionResource
+	newObj   func() ctrlclient.Object
+	newList  func() ctrlclient.ObjectList
+	// listItems returns the items of a list returned by newList
+	listItems func(ctrlclient.ObjectList) []ctrlclient.Object
+}
+
+var chartSourceKinds = []chartSourceKind{
+	{
+		kind:     sourcev1.GitRepositoryKind,
+		repoType: repoTypeGit,
+		gvr:      common.GetGitRepositoriesGvr(),
+		newObj:   func() ctrlclient.Object { return &sourcev1.GitRepository{} },
+		newList:  func() ctrlclient.ObjectList { return &sourcev1.GitRepositoryList{} },
+		listItems: func(ol ctrlclient.ObjectList) []ctrlclient.Object {
+			if gl, ok := ol.(*sourcev1.GitRepositoryList); !ok {
+				log.Errorf("Expected: *sourcev1.GitRepositoryList, got: %s", reflect.TypeOf(ol))
+				return nil
+			} else {
+				ret := make([]ctrlclient.Object, len(gl.Items))
+				for i, gr := range gl.Items {
+					ret[i] = gr.DeepCopy()
+				}
+				return ret
+			}
+		},
+	},
+	{
+		kind:     sourcev1.BucketKind,
+		repoType: repoTypeBucket,
+		gvr:      common.GetBucketsGvr(),
+		newObj:   func() ctrlclient.Object { return &sourcev1.Bucket{} },
+		newList:  func() ctrlclient.ObjectList { return &sourcev1.BucketList{} },
+		listItems: func(ol ctrlclient.ObjectList) []ctrlclient.Object {
+			if bl, ok := ol.(*sourcev1.BucketList); !ok {
+				log.Errorf("Expected: *sourcev1.BucketList, got: %s", reflect.TypeOf(ol))
+				return nil
+			} else {
+				ret := make([]ctrlclient.Object, len(bl.Items))
+				for i, b := range bl.Items {
+					ret[i] = b.DeepCopy()
+				}
+				return ret
+			}
+		},
+	},
+}
+
+// chartSourceKindForRepoType returns the kind of source the charts of a repo with the
+// given type come from, or nil for HelmRepositories
+func chartSourceKindForRepoType(repoType string) *chartSourceKind {
+	for i := range chartSourceKinds {
+		if chartSourceKinds[i].repoType == repoType {
+			return &chartSourceKinds[i]
+		}
+	}
+	return nil
+}
+
+// repoTypeForKey returns the type of the repo of the charts of the source with the
+// given key in a cache, which is empty for HelmRepositories
+func repoTypeForKey(key string) string {
+	switch strings.Split(key, cache.KeySegmentsSeparator)[0] {
+	case fluxGitRepositories:
+		return repoTypeGit
+	case fluxBuckets:
+		return repoTypeBucket
+	default:
+		return ""
+	}
+}
+
+func newChartSourceCacheConfig(cluster string, kind chartSourceKind, s repoEventSink) cache.NamespacedResourceWatcherCacheConfig {
+	return cache.NamespacedResourceWatcherCacheConfig{
+		Gvr:          kind.gvr,
+		Cluster:      cluster,
+		ClientGetter: s.clientGetter,
+		OnAddFunc:    s.onAddChartSource,
+		OnModifyFunc: s.onModifyChartSource,
+		OnGetFunc:    s.onGetRepo,
+		OnDeleteFunc: s.onDeleteRepo,
+		OnResyncFunc: func() error {
+			return s.onResyncChartSource(kind.repoType)
+		},
+		NewObjFunc:    kind.newObj,
+		NewListFunc:   kind.newList,
+		ListItemsFunc: kind.listItems,
+	}
+}
+
+// onResyncChartSource deletes the charts of the sources of the given repo type from the
+// chart cache, which is shared with the repo cache and the caches of the other kinds of
+// sources, so each of them only deletes its own charts
+func (s *repoEventSink) onResyncChartSource(repoType string) error {
+	if s.chartCache != nil {
+		return s.chartCache.OnSourceResync(repoType)
+	}
+	return nil
+}
+
+// returns the sources of the given kind from all namespaces (cluster-wide) that are
+// marked for kubeapps and are ready, excluding the ones that the caller has no read
+// access to, which are not visible from the given namespace or whose namespaced name
+// is in the given set of names already taken. The names of all the sources of the
+// kind are then added to that set
+func (s *Server) listChartSourcesInAllNamespaces(ctx context.Context, cluster, namespace string, kind chartSourceKind, taken sets.String) ([]chartSource, error) {
+	caches, err := s.cachesFor(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// same as for HelmRepositories, the actual List(...) call will be executed in the
+	// context of kubeapps-internal-kubeappsapis service account
+	backgroundCtx := context.Background()
+	client, err := caches.serviceAccountClientGetter.ControllerRuntime(backgroundCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := kind.newList()
+	if err := client.List(backgroundCtx, list); err != nil {
+		return nil, statuserror.FromK8sError("list", kind.kind, "", err)
+	}
+
+	allowedNamespaces := map[string]bool{}
+	items := []chartSource{}
+	names := []string{}
+	for _, obj := range kind.listItems(list) {
+		name := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}.String()
+		names = append(names, name)
+		if taken.Has(name) {
+			log.Warningf("Ignoring the charts of %s [%s], as a repository with the same name exists", kind.kind, name)
+			continue
+		}
+		src, ok := obj.(chartSource)
+		if !ok || len(chartPathsOfSource(src)) == 0 || !isChartSourceReady(src) {
+			continue
+		}
+		if namespace != apiv1.NamespaceAll &&
+			src.GetAnnotations()[namespaceScopedAnnotation] == "true" &&
+			src.GetNamespace() != namespace {
+			continue
+		}
+		allowed, checked := allowedNamespaces[src.GetNamespace()]
+		if !checked {
+			if allowed, err = s.hasAccessToNamespace(ctx, cluster, kind.gvr, src.GetNamespace()); err != nil {
+				return nil, err
+			}
+			allowedNamespaces[src.GetNamespace()] = allowed
+		}
+		if allowed {
+			items = append(items, src)
+		}
+	}
+	taken.Insert(names...)
+	return items, nil
+}
+
+// returns the charts of the sources of all kinds, keyed by the cache key of the source
+func (s *Server) getChartsForSources(ctx context.Context, cluster, namespace string, match []string) (map[string][]models.Chart, error) {
+	caches, err := s.cachesFor(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// the names of the HelmRepositories are taken, whether the caller has access to
+	// them or not, as their charts are the ones returned for their identifiers
+	client, err := caches.serviceAccountClientGetter.ControllerRuntime(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	var repoList sourcev1.HelmRepositoryList
+	if err := client.List(context.Background(), &repoList); err != nil {
+		return nil, statuserror.FromK8sError("list", "HelmRepository", "", err)
+	}
+	taken := sets.String{}
+	for _, repo := range repoList.Items {
+		taken.Insert(types.NamespacedName{Namespace: repo.Namespace, Name: repo.Name}.String())
+	}
+
+	chartsTyped := make(map[string][]models.Chart)
+	for _, kind := range chartSourceKinds {
+		sourceCache, ok := caches.sourceCaches[kind.repoType]
+		if !ok {
+			continue
+		}
+		sources, err := s.listChartSourcesInAllNamespaces(ctx, cluster, namespace, kind, taken)
+		if err != nil {
+			return nil, err
+		}
+		keys := sets.String{}
+		for _, src := range sources {
+			if matchesAnyName(src.GetName(), match) {
+				keys.Insert(sourceCache.KeyForNamespacedName(
+					types.NamespacedName{Namespace: src.GetNamespace(), Name: src.GetName()}))
+			}
+		}
+		chartsUntyped, err := sourceCache.GetForMultiple(keys)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range chartsUntyped {
+			if value == nil {
+				chartsTyped[key] = nil
+			} else if typedValue, ok := value.(repoCacheEntryValue); !ok {
+				return nil, status.Errorf(
+					codes.Internal,
+					"unexpected value fetched from cache: type: [%s], value: [%v]",
+					reflect.TypeOf(value), value)
+			} else {
+				chartsTyped[key] = typedValue.Charts
+			}
+		}
+	}
+	return chartsTyped, nil
+}
+
+// returns the chart with the given name from the first source with the given name,
+// in the order of the kinds of sources, if any
+func (s *Server) getChartFromSources(caches *clusterCaches, source types.NamespacedName, chartName string) (*models.Chart, error) {
+	for _, kind := range chartSourceKinds {
+		if sourceCache, ok := caches.sourceCaches[kind.repoType]; !ok {
+			continue
+		} else if entry, err := sourceCache.GetForOne(sourceCache.KeyForNamespacedName(source)); err != nil {
+			return nil, err
+		} else if entry != nil {
+			return getChartFromSource(sourceCache, source, chartName)
+		}
+	}
+	return nil, nil
+}
+
+func getChartFromSource(sourceCache *cache.NamespacedResourceWatcherCache, source types.NamespacedName, chartName string) (*models.Chart, error) {
+	entry, err := sourceCache.GetForOne(sourceCache.KeyForNamespacedName(source))
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	typedEntry, ok := entry.(repoCacheEntryValue)
+	if !ok {
+		return nil, status.Errorf(
+			codes.Internal,
+			"unexpected value fetched from cache: type: [%s], value: [%v]", reflect.TypeOf(entry), entry)
+	}
+	for _, chart := range typedEntry.Charts {
+		if chart.Name == chartName {
+			return &chart, nil // found it
+		}
+	}
+	return nil, nil
+}
+
+// findChartSourceInCluster returns the first GitRepository or Bucket with the given name,
+// in the order of the kinds of sources, if it is marked for kubeapps, along with its kind,
+// or nil if there is none
+func (s *Server) findChartSourceInCluster(ctx context.Context, cluster string, key types.NamespacedName) (chartSource, *chartSourceKind, error) {
+	client, err := s.getClient(ctx, cluster, key.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, kind := range chartSourceKinds {
+		obj := kind.newObj()
+		if err = client.Get(ctx, key, obj); errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, nil, statuserror.FromK8sError("get", kind.kind, key.String(), err)
+		} else if src, ok := obj.(chartSource); ok && len(chartPathsOfSource(src)) > 0 {
+			return src, &chartSourceKinds[i], nil
+		} else {
+			return nil, nil, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// availableSourceChartDetail is the counterpart of availableChartDetail for
+// charts found in GitRepositories and Buckets. There is only ever one version of
+// such a chart, the one in the current artifact of the source
+func (s *Server) availableSourceChartDetail(ctx context.Context, cluster string, src chartSource, kind chartSourceKind, chartName, chartVersion string) (*corev1.AvailablePackageDetail, error) {
+	sourceName := types.NamespacedName{Namespace: src.GetNamespace(), Name: src.GetName()}
+	if !isChartSourceReady(src) {
+		return nil, status.Errorf(codes.Internal, "%s [%s] is not in Ready state", kind.kind, sourceName)
+	}
+
+	caches, err := s.cachesFor(cluster)
+	if err != nil {
+		return nil, err
+	}
+	sourceCache, ok := caches.sourceCaches[kind.repoType]
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "charts from %s sources are not available for cluster [%s]", kind.kind, cluster)
+	}
+
+	chartModel, err := getChartFromSource(sourceCache, sourceName, chartName)
+	if err != nil {
+		return nil, err
+	} else if chartModel == nil || len(chartModel.ChartVersions) == 0 {
+		return nil, status.Errorf(codes.NotFound, "chart [%s] not found", chartName)
+	} else if chartVersion != "" && chartVersion != chartModel.ChartVersions[0].Version {
+		return nil, status.Errorf(codes.NotFound, "version [%s] of chart [%s] not found", chartVersion, chartName)
+	}
+
+	key, err := caches.chartCache.KeyForRepoType(chartModel.Repo.Type, sourceName.Namespace, chartModel.ID, chartModel.ChartVersions[0].Version)
+	if err != nil {
+		return nil, err
+	}
+	byteArray, err := caches.chartCache.GetForOne(key, chartModel, downloadChartFromSourceFn())
+	if err != nil {
+		return nil, err
+	} else if byteArray == nil {
+		return nil, status.Errorf(codes.Internal, "failed to load details for chart [%s]", chartModel.ID)
+	}
+
+	chartDetail, err := tarutil.FetchChartDetailFromTarball(bytes.NewReader(byteArray), chartModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgDetail, err := availablePackageDetailFromChartDetail(chartModel.ID, chartDetail)
+	if err != nil {
+		return nil, err
+	}
+	pkgDetail.RepoUrl = chartModel.Repo.URL
+	pkgDetail.AvailablePackageRef.Context.Namespace = sourceName.Namespace
+	// per https://github.com/vmware-tanzu/kubeapps/pull/3686#issue-1038093832
+	pkgDetail.AvailablePackageRef.Context.Cluster = cluster
+	return pkgDetail, nil
+}
+
+// onAddChartSource essentially tells the cache whether to and what to store for a given key
+func (s *repoEventSink) onAddChartSource(key string, obj ctrlclient.Object) (interface{}, bool, error) {
+	log.V(4).Infof("+onAddChartSource(%s)", key)
+	defer log.V(4).Info("-onAddChartSource()")
+
+	if src, ok := obj.(chartSource); !ok {
+		return nil, false, fmt.Errorf("expected a GitRepository or a Bucket, got: %s", reflect.TypeOf(obj))
+	} else if len(chartPathsOfSource(src)) == 0 {
+		// not marked for kubeapps
+		return nil, false, nil
+	} else if isChartSourceReady(src) {
+		return s.indexAndEncodeChartSource(src, nil)
+	} else {
+		log.Infof("Skipping packages for source [%s] because it is not in 'Ready' state", key)
+		return nil, false, nil
+	}
+}
+
+// onModifyChartSource essentially tells the cache whether or not to and what to store for a given key
+func (s *repoEventSink) onModifyChartSource(key string, obj ctrlclient.Object, oldValue interface{}) (interface{}, bool, error) {
+	src, ok := obj.(chartSource)
+	if !ok {
+		return nil, false, fmt.Errorf("expected a GitRepository or a Bucket, got: %s", reflect.TypeOf(obj))
+	} else if !isChartSourceReady(src) {
+		log.V(4).Infof("Skipping packages for source [%s] because it is not in 'Ready' state", key)
+		return nil, false, nil
+	}
+
+	cacheEntryUntyped, err := s.onGetRepo(key, oldValue)
+	if err != nil {
+		return nil, false, err
+	}
+	cacheEntry, ok := cacheEntryUntyped.(repoCacheEntryValue)
+	if !ok {
+		return nil, false, status.Errorf(
+			codes.Internal,
+			"unexpected value found in cache for key [%s]: %v",
+			key, cacheEntryUntyped)
+	}
+
+	// the charts may change due to either a new artifact or different chart paths
+	if cacheEntry.Checksum != chartSourceChecksum(src) {
+		return s.indexAndEncodeChartSource(src, cacheEntry.Charts)
+	} else {
+		// skip because the content did not change
+		return nil, false, nil
+	}
+}
+
+// indexAndEncodeChartSource indexes the charts of a source and returns the encoded
+// cache entry. Charts in oldCharts that are not the same in the new index any more,
+// e.g. because they have been removed from the chart paths of the source, are removed
+// from the chart cache
+func (s *repoEventSink) indexAndEncodeChartSource(src chartSource, oldCharts []models.Chart) ([]byte, bool, error) {
+	charts, err := indexChartSource(src)
+	if err != nil {
+		return nil, false, err
+	}
+
+	cacheEntryValue := repoCacheEntryValue{
+		Checksum: chartSourceChecksum(src),
+		Charts:   charts,
+	}
+
+	// use gob encoding instead of json, it peforms much better
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err = enc.Encode(cacheEntryValue); err != nil {
+		return nil, false, err
+	}
+
+	if s.chartCache != nil {
+		if len(oldCharts) > 0 {
+			if err = s.chartCache.DeleteCharts(staleOciCharts(oldCharts, charts)); err != nil {
+				return nil, false, err
+			}
+		}
+		if err = s.chartCache.SyncCharts(charts, downloadChartFromSourceFn()); err != nil {
+			return nil, false, err
+		}
+	}
+	return buf.Bytes(), true, nil
+}
+
+// indexChartSource returns the charts found at the chart paths of a source, which is
+// assumed to be ready. Unlike the index of a HelmRepository, each chart has just the
+// one version that is in the current artifact of the source
+func indexChartSource(src chartSource) ([]models.Chart, error) {
+	paths := chartPathsOfSource(src)
+	if len(paths) == 0 {
+		return []models.Chart{}, nil
+	}
+
+	artifact := src.GetArtifact()
+	if artifact == nil || artifact.URL == "" {
+		return nil, status.Errorf(codes.Internal,
+			"expected field status.artifact.url not found on source\n[%s]",
+			common.PrettyPrint(src))
+	}
+
+	startTime := time.Now()
+	log.Infof("+indexChartSource: [%s], artifact URL: [%s]", src.GetName(), artifact.URL)
+
+	// same as for the index of a HelmRepository, the artifact is served by
+	// source-controller in the local cluster, so there is no need for any auth
+	// if a transient error occurs the item will be re-queued and retried after a back-off period
+	byteArray, err := httpclient.Get(artifact.URL, httpclient.New(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := chartMetadataFromArtifact(byteArray, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	modelRepo := &models.Repo{
+		Namespace: src.GetNamespace(),
+		Name:      src.GetName(),
+		URL:       chartSourceURL(src),
+		Type:      chartSourceRepoType(src),
+	}
+
+	charts := []models.Chart{}
+	for _, p := range paths {
+		m, ok := metadata[p]
+		if !ok {
+			log.Warningf("No chart found at path [%s] of source [%s]", p, src.GetName())
+			continue
+		}
+		name := path.Base(p)
+		maintainers := []chart.Maintainer{}
+		for _, maintainer := range m.Maintainers {
+			if maintainer != nil {
+				maintainers = append(maintainers, *maintainer)
+			}
+		}
+		charts = append(charts, models.Chart{
+			ID:          fmt.Sprintf("%s/%s", src.GetName(), name),
+			Name:        name,
+			Repo:        modelRepo,
+			Description: m.Description,
+			Home:        m.Home,
+			Keywords:    m.Keywords,
+			Maintainers: maintainers,
+			Sources:     m.Sources,
+			Icon:        m.Icon,
+			Category:    m.Annotations["category"],
+			ChartVersions: []models.ChartVersion{
+				{
+					Version:    m.Version,
+					AppVersion: m.AppVersion,
+					Created:    artifact.LastUpdateTime.Time,
+					Digest:     artifact.Checksum,
+					URLs:       []string{sourceChartURL(artifact.URL, p)},
+				},
+			},
+		})
+	}
+
+	duration := time.Since(startTime)
+	log.Infof("-indexChartSource: [%s], indexed [%d] packages in [%d] ms", src.GetName(), len(charts), duration.Milliseconds())
+	return charts, nil
+}
+
+// downloadChartFromSourceFn returns a function that makes a chart tarball out of
+// the files of the chart in the artifact of a source, so that charts from sources can
+// be kept in the chart cache just like those from HelmRepositories
+func downloadChartFromSourceFn() cache.DownloadChartFn {
+	return func(chartID, chartUrl, chartVersion string) ([]byte, error) {
+		artifactURL, chartPath, err := splitSourceChartURL(chartUrl)
+		if err != nil {
+			return nil, err
+		}
+		artifact, err := httpclient.Get(artifactURL, httpclient.New(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return chartTarballFromArtifact(artifact, chartPath, path.Base(chartID))
+	}
+}
+
+//
+// source-related utilities
+//
+
+// returns the paths of the charts in the artifact of a source, which are
+// only set for sources marked for kubeapps
+func chartPathsOfSource(src chartSource) []string {
+	paths := []string{}
+	for _, p := range strings.Split(src.GetAnnotations()[chartPathsAnnotation], ",") {
+		if p = cleanArtifactPath(p); p != "" && p != "." {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+func isChartSourceReady(src chartSource) bool {
+	readyCond := meta.FindStatusCondition(src.GetConditions(), fluxmeta.ReadyCondition)
+	if readyCond == nil || readyCond.Status != metav1.ConditionTrue || src.GetArtifact() == nil {
+		return false
+	}
+	var observedGeneration int64
+	switch s := src.(type) {
+	case *sourcev1.GitRepository:
+		observedGeneration = s.Status.ObservedGeneration
+	case *sourcev1.Bucket:
+		observedGeneration = s.Status.ObservedGeneration
+	}
+	return src.GetGeneration() > 0 && src.GetGeneration() == observedGeneration
+}
+
+// the charts of a source depend on both the artifact and the chart paths
+func chartSourceChecksum(src chartSource) string {
+	checksum := ""
+	if artifact := src.GetArtifact(); artifact != nil {
+		checksum = artifact.Checksum
+	}
+	return fmt.Sprintf("%s:%s", checksum, strings.Join(chartPathsOfSource(src), ","))
+}
+
+func chartSourceRepoType(src chartSource) string {
+	if _, ok := src.(*sourcev1.Bucket); ok {
+		return repoTypeBucket
+	}
+	return repoTypeGit
+}
+
+// returns the URL shown to users as the URL of the repo of the charts of a source
+func chartSourceURL(src chartSource) string {
+	switch s := src.(type) {
+	case *sourcev1.GitRepository:
+		return s.Spec.URL
+	case *sourcev1.Bucket:
+		scheme := "https"
+		if s.Spec.Insecure {
+			scheme = "http"
+		}
+		return fmt.Sprintf("%s://%s/%s", scheme, s.Spec.Endpoint, s.Spec.BucketName)
+	}
+	return ""
+}
+
+// the URL of a chart from a source is the URL of the artifact of the source, with
+// the path of the chart in the artifact as the fragment
+func sourceChartURL(artifactURL, chartPath string) string {
+	return fmt.Sprintf("%s#%s", artifactURL, url.PathEscape(chartPath))
+}
+
+// the opposite of sourceChartURL
+func splitSourceChartURL(chartURL string) (artifactURL, chartPath string, err error) {
+	u, err := url.Parse(chartURL)
+	if err != nil {
+		return "", "", err
+	} else if u.Fragment == "" {
+		return "", "", fmt.Errorf("missing chart path in URL [%s]", chartURL)
+	}
+	chartPath = u.Fragment
+	u.Fragment = ""
+	u.RawFragment = ""
+	return u.String(), chartPath, nil
+}
+
+// returns what goes in spec.chart.spec.chart of a HelmRelease of the given chart, which
+// is the name of the chart for HelmRepositories but the path of the chart in the artifact
+// for GitRepositories and Buckets
+func chartPathInSource(chart *models.Chart) (string, error) {
+	if chartSourceKindForRepoType(chart.Repo.Type) == nil {
+		return chart.Name, nil
+	} else if len(chart.ChartVersions) == 0 || len(chart.ChartVersions[0].URLs) == 0 {
+		return "", status.Errorf(codes.Internal, "missing URL of chart [%s]", chart.ID)
+	}
+	_, chartPath, err := splitSourceChartURL(chart.ChartVersions[0].URLs[0])
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "invalid URL of chart [%s]: %v", chart.ID, err)
+	}
+	return fmt.Sprintf("./%s", chartPath), nil
+}
+
+// returns the name under which kubeapps knows the chart of a release, which for
+// GitRepositories and Buckets is the name of the directory of the chart
+func chartNameOfRelease(rel *helmv2.HelmRelease) string {
+	chartName := rel.Spec.Chart.Spec.Chart
+	switch rel.Spec.Chart.Spec.SourceRef.Kind {
+	case sourcev1.GitRepositoryKind, sourcev1.BucketKind:
+		return path.Base(cleanArtifactPath(chartName))
+	}
+	return chartName
+}
+
+func matchesAnyName(name string, match []string) bool {
+	if len(match) == 0 {
+		return true
+	}
+	for _, m := range match {
+		if matched, err := regexp.MatchString(m, name); matched && err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func cleanArtifactPath(p string) string {
+	return strings.Trim(path.Clean(strings.TrimSpace(p)), "/")
+}
+
+// reads the metadata of the charts at the given paths of the artifact of a source,
+// which is a gzipped tarball, keyed by path. Paths without a chart are not included
+func chartMetadataFromArtifact(artifact []byte, paths []string) (map[string]*chart.Metadata, error) {
+	chartYamls := map[string]string{}
+	for _, p := range paths {
+		chartYamls[path.Join(p, "Chart.yaml")] = p
+	}
+
+	gzr, err := gzip.NewReader(bytes.NewReader(artifact))
+	if err != nil {
+		return nil, err
+	}
+	defer gzr.Close()
+
+	result := map[string]*chart.Metadata{}
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		p, ok := chartYamls[cleanArtifactPath(header.Name)]
+		if !ok || header.Typeflag != tar.TypeReg {
+			continue
+		}
+		byteArray, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		var m chart.Metadata
+		if err = yaml.Unmarshal(byteArray, &m); err != nil {
+			log.Warningf("Failed to parse chart at path [%s] due to: %v", p, err)
+			continue
+		}
+		result[p] = &m
+	}
+	return result, nil
+}
+
+// returns a gzipped chart tarball with the files under the given path of the
+// artifact of a source, in a top-level directory with the given name, same as the
+// tarball produced by "helm package"
+func chartTarballFromArtifact(artifact []byte, chartPath, chartName string) ([]byte, error) {
+	gzr, err := gzip.NewReader(bytes.NewReader(artifact))
+	if err != nil {
+		return nil, err
+	}
+	defer gzr.Close()
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+
+	prefix := cleanArtifactPath(chartPath) + "/"
+	found := false
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		name := cleanArtifactPath(header.Name)
+		if header.Typeflag != tar.TypeReg || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		relPath := strings.TrimPrefix(name, prefix)
+		found = found || relPath == "Chart.yaml"
+		if err = tw.WriteHeader(&tar.Header{
+			Name:     path.Join(chartName, relPath),
+			Typeflag: tar.TypeReg,
+			Mode:     header.Mode,
+			Size:     header.Size,
+			ModTime:  header.ModTime,
+		}); err != nil {
+			return nil, err
+		}
+		if _, err = io.Copy(tw, tr); err != nil {
+			return nil, err
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no chart found at path [%s]", chartPath)
+	}
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+	if err = gzw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,295 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	fluxmeta "github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
+	"github.com/vmware-tanzu/kubeapps/pkg/tarutil"
+	"helm.sh/helm/v3/pkg/chart"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var monorepo_artifact_files = map[string]string{
+	"./README.md": "# monorepo",
+	"./charts/podinfo/Chart.yaml": `apiVersion: v2
+name: podinfo
+version: 6.1.5
+appVersion: 6.1.5
+description: Podinfo Helm chart for Kubernetes
+home: https://github.com/stefanprodan/podinfo
+maintainers:
+- name: stefanprodan
+  email: stefanprodan@users.noreply.github.com
+annotations:
+  category: Test
+`,
+	"./charts/podinfo/values.yaml":           "replicaCount: 1\n",
+	"./charts/podinfo/README.md":             "# podinfo",
+	"./charts/podinfo/templates/deploy.yaml": "kind: Deployment\n",
+	"./charts/other/values.yaml":             "foo: bar\n",
+}
+
+func TestIndexChartSource(t *testing.T) {
+	artifact := newTestArtifact(t, monorepo_artifact_files)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		w.Write(artifact)
+	}))
+	defer ts.Close()
+
+	src := newTestGitRepository("monorepo", "default", "charts/podinfo, charts/other,charts/missing/")
+	src.Status.Artifact.URL = ts.URL + "/gitrepository/default/monorepo/main.tar.gz"
+
+	charts, err := indexChartSource(src)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	expected := []models.Chart{
+		{
+			ID:   "monorepo/podinfo",
+			Name: "podinfo",
+			Repo: &models.Repo{
+				Namespace: "default",
+				Name:      "monorepo",
+				URL:       "https://github.com/example/monorepo",
+				Type:      repoTypeGit,
+			},
+			Description: "Podinfo Helm chart for Kubernetes",
+			Home:        "https://github.com/stefanprodan/podinfo",
+			Maintainers: []chart.Maintainer{
+				{Name: "stefanprodan", Email: "stefanprodan@users.noreply.github.com"},
+			},
+			Category: "Test",
+			ChartVersions: []models.ChartVersion{
+				{
+					Version:    "6.1.5",
+					AppVersion: "6.1.5",
+					Digest:     "6d5e1ad5ba1ac1d3ad2b6d1d5bb4a4cbcb26a4cd",
+					URLs:       []string{src.Status.Artifact.URL + "#charts%2Fpodinfo"},
+				},
+			},
+		},
+	}
+	opts := cmpopts.IgnoreFields(models.ChartVersion{}, "Created")
+	if got, want := charts, expected; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+
+	// the chart of the index can be downloaded as a chart tarball
+	fn := downloadChartFromSourceFn()
+	tarball, err := fn(charts[0].ID, charts[0].ChartVersions[0].URLs[0], charts[0].ChartVersions[0].Version)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	chartDetail, err := tarutil.FetchChartDetailFromTarball(bytes.NewReader(tarball), charts[0].ID)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := chartDetail[models.ValuesKey], "replicaCount: 1\n"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := chartDetail[models.ReadmeKey], "# podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestIndexChartSourceNotMarkedForKubeapps(t *testing.T) {
+	src := newTestGitRepository("monorepo", "default", "")
+	if charts, err := indexChartSource(src); err != nil {
+		t.Fatalf("%+v", err)
+	} else if len(charts) != 0 {
+		t.Errorf("expected no charts, got: %v", charts)
+	}
+}
+
+func TestChartTarballFromArtifactWithoutChart(t *testing.T) {
+	artifact := newTestArtifact(t, monorepo_artifact_files)
+	if _, err := chartTarballFromArtifact(artifact, "charts/other", "other"); err == nil {
+		t.Errorf("expected an error for a path without a Chart.yaml")
+	}
+}
+
+func TestSourceChartURL(t *testing.T) {
+	chartURL := sourceChartURL("http://source-controller.flux-system.svc.cluster.local./gitrepository/default/monorepo/abc.tar.gz", "charts/podinfo")
+	artifactURL, chartPath, err := splitSourceChartURL(chartURL)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := artifactURL, "http://source-controller.flux-system.svc.cluster.local./gitrepository/default/monorepo/abc.tar.gz"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got, want := chartPath, "charts/podinfo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestChartNameOfRelease(t *testing.T) {
+	testCases := []struct {
+		name     string
+		kind     string
+		chart    string
+		expected string
+	}{
+		{
+			name:     "returns the chart of a release from a HelmRepository",
+			kind:     sourcev1.HelmRepositoryKind,
+			chart:    "podinfo",
+			expected: "podinfo",
+		},
+		{
+			name:     "returns the directory of the chart of a release from a GitRepository",
+			kind:     sourcev1.GitRepositoryKind,
+			chart:    "./charts/podinfo",
+			expected: "podinfo",
+		},
+		{
+			name:     "returns the directory of the chart of a release from a Bucket",
+			kind:     sourcev1.BucketKind,
+			chart:    "charts/podinfo/",
+			expected: "podinfo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rel := &helmv2.HelmRelease{
+				Spec: helmv2.HelmReleaseSpec{
+					Chart: helmv2.HelmChartTemplate{
+						Spec: helmv2.HelmChartTemplateSpec{
+							Chart:     tc.chart,
+							SourceRef: helmv2.CrossNamespaceObjectReference{Kind: tc.kind, Name: "monorepo"},
+						},
+					},
+				},
+			}
+			if got, want := chartNameOfRelease(rel), tc.expected; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestListChartSourcesSkipsTakenNames(t *testing.T) {
+	s, mock, err := newSimpleServerWithRepos(t, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	ctx := context.Background()
+	ctrlClient, err := s.clientGetter.ControllerRuntime(ctx, s.kubeappsCluster)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, name := range []string{"podinfo", "monorepo"} {
+		if err := ctrlClient.Create(ctx, newTestGitRepository(name, "default", "charts/podinfo")); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	bucket := &sourcev1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: "monorepo", Namespace: "default"}}
+	if err := ctrlClient.Create(ctx, bucket); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// the name of a HelmRepository is taken, so the GitRepository with the same
+	// name is skipped, while the names of the GitRepositories are taken for Buckets
+	taken := sets.NewString("default/podinfo")
+	gitRepos, err := s.listChartSourcesInAllNamespaces(ctx, s.kubeappsCluster, "default", chartSourceKinds[0], taken)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	names := []string{}
+	for _, src := range gitRepos {
+		names = append(names, src.GetName())
+	}
+	if got, want := names, []string{"monorepo"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	if got, want := taken.List(), []string{"default/monorepo", "default/podinfo"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	buckets, err := s.listChartSourcesInAllNamespaces(ctx, s.kubeappsCluster, "default", chartSourceKinds[1], taken)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(buckets) != 0 {
+		t.Errorf("expected the Bucket with the name of a GitRepository to be skipped, got: %v", buckets)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func newTestGitRepository(name, namespace, chartPaths string) *sourcev1.GitRepository {
+	src := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			Generation: 1,
+		},
+		Spec: sourcev1.GitRepositorySpec{
+			URL: "https://github.com/example/monorepo",
+		},
+		Status: sourcev1.GitRepositoryStatus{
+			ObservedGeneration: 1,
+			Conditions: []metav1.Condition{
+				{
+					Type:   fluxmeta.ReadyCondition,
+					Status: metav1.ConditionTrue,
+					Reason: fluxmeta.SucceededReason,
+				},
+			},
+			Artifact: &sourcev1.Artifact{
+				Checksum: "6d5e1ad5ba1ac1d3ad2b6d1d5bb4a4cbcb26a4cd",
+			},
+		},
+	}
+	if chartPaths != "" {
+		src.Annotations = map[string]string{chartPathsAnnotation: chartPaths}
+	}
+	return src
+}
+
+// newTestArtifact returns a gzipped tarball with the given files, like the
+// artifacts of flux sources
+func newTestArtifact(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := gzw.Close(); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return buf.Bytes()
+}
@@ -318,6 +318,17 @@ func newCtrlClient(repos []sourcev1.HelmRepository, charts []sourcev1.HelmChart,
 		Version: sourcev1.GroupVersion.Version,
 		Kind:    sourcev1.HelmChartKind},
 		apimeta.RESTScopeNamespace)
+	// GitRepositories and Buckets are looked up for charts too
+	rm.Add(schema.GroupVersionKind{
+		Group:   sourcev1.GroupVersion.Group,
+		Version: sourcev1.GroupVersion.Version,
+		Kind:    sourcev1.GitRepositoryKind},
+		apimeta.RESTScopeNamespace)
+	rm.Add(schema.GroupVersionKind{
+		Group:   sourcev1.GroupVersion.Group,
+		Version: sourcev1.GroupVersion.Version,
+		Kind:    sourcev1.BucketKind},
+		apimeta.RESTScopeNamespace)
 	rm.Add(schema.GroupVersionKind{
 		Group:   helmv2.GroupVersion.Group,
 		Version: helmv2.GroupVersion.Version,
@@ -0,0 +1,125 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestGetInstalledPackageRevisions(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		request            *corev1.GetInstalledPackageRevisionsRequest
+		expectedResponse   *corev1.GetInstalledPackageRevisionsResponse
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "returns the revisions of the installed package from the most recent",
+			existingReleases: []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartVersion: "1.18.2",
+					status:       release.StatusSuperseded,
+					version:      1,
+				},
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartVersion: "1.18.3",
+					values:       "{\"replicaCount\":2}",
+					status:       release.StatusDeployed,
+					version:      2,
+				},
+			},
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			},
+			expectedResponse: &corev1.GetInstalledPackageRevisionsResponse{
+				Revisions: []*corev1.InstalledPackageRevision{
+					{
+						ReleaseRevision: 2,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "1.18.3",
+							AppVersion: DefaultAppVersion,
+						},
+						ValuesApplied: "{\"replicaCount\":2}",
+						Status: &corev1.InstalledPackageStatus{
+							Ready:      true,
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+							UserReason: "deployed",
+						},
+					},
+					{
+						ReleaseRevision: 1,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "1.18.2",
+							AppVersion: DefaultAppVersion,
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Ready:      false,
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED,
+							UserReason: "superseded",
+						},
+					},
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		corev1.GetInstalledPackageRevisionsResponse{},
+		corev1.InstalledPackageRevision{},
+		corev1.InstalledPackageStatus{},
+		corev1.PackageAppVersion{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases, nil)
+
+			server, _, cleanup := makeServer(t, authorized, actionConfig)
+			defer cleanup()
+
+			response, err := server.GetInstalledPackageRevisions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+		})
+	}
+}
@@ -437,3 +437,62 @@ func (s *Server) deleteRepo(ctx context.Context, cluster string, repoRef *corev1
 		return nil
 	}
 }
+
+// refreshRepo forces the apprepository-controller to resync the repository,
+// which it does whenever the ResyncRequests of the spec changes.
+func (s *Server) refreshRepo(ctx context.Context, cluster string, repoRef *corev1.PackageRepositoryReference) error {
+	client, err := s.getClient(ctx, cluster, repoRef.Context.Namespace)
+	if err != nil {
+		return err
+	}
+
+	key := types.NamespacedName{
+		Name:      repoRef.Identifier,
+		Namespace: repoRef.Context.Namespace,
+	}
+	appRepo := &apprepov1alpha1.AppRepository{}
+	if err = client.Get(ctx, key, appRepo); err != nil {
+		return statuserror.FromK8sError("get", AppRepositoryKind, key.String(), err)
+	}
+
+	log.V(4).Infof("Refreshing AppRepository: [%s]", repoRef.Identifier)
+
+	appRepo.Spec.ResyncRequests++
+	if err = client.Update(ctx, appRepo); err != nil {
+		return statuserror.FromK8sError("update", AppRepositoryKind, key.String(), err)
+	}
+	return nil
+}
+
+// validateRepo checks that a connection can be made to the repository with
+// the given details, without creating the repository or its secrets.
+func (s *Server) validateRepo(ctx context.Context, repo *HelmRepository) error {
+	if repo.url == "" {
+		return status.Errorf(codes.InvalidArgument, "repository url may not be empty")
+	}
+	if repo.repoType == "" || !slices.Contains(ValidRepoTypes, repo.repoType) {
+		return status.Errorf(codes.InvalidArgument, "repository type [%s] not supported", repo.repoType)
+	}
+
+	var secret *k8scorev1.Secret
+	if s.pluginConfig.UserManagedSecrets {
+		typedClient, err := s.clientGetter.Typed(ctx, repo.cluster)
+		if err != nil {
+			return err
+		}
+		if secret, err = validateUserManagedRepoSecret(ctx, typedClient, repo.name, repo.tlsConfig, repo.auth); err != nil {
+			return err
+		}
+	} else {
+		var err error
+		if secret, _, err = newSecretFromTlsConfigAndAuth(repo.name, repo.tlsConfig, repo.auth); err != nil {
+			return err
+		}
+	}
+
+	helmRepoCrd, err := newHelmRepoCrd(repo, secret)
+	if err != nil {
+		return err
+	}
+	return s.ValidateRepository(helmRepoCrd, secret)
+}
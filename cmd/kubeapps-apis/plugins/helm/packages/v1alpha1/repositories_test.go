@@ -1129,6 +1129,156 @@ func TestDeletePackageRepository(t *testing.T) {
 	}
 }
 
+func TestRefreshPackageRepository(t *testing.T) {
+
+	repos := []*appRepov1alpha1.AppRepository{repo1}
+
+	testCases := []struct {
+		name               string
+		request            *corev1.RefreshPackageRepositoryRequest
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "no context provided",
+			request: &corev1.RefreshPackageRepositoryRequest{
+				PackageRepoRef: &corev1.PackageRepositoryReference{
+					Plugin:     plugin,
+					Identifier: "repo-1",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "refresh - success",
+			request: &corev1.RefreshPackageRepositoryRequest{
+				PackageRepoRef: &corev1.PackageRepositoryReference{
+					Plugin:     plugin,
+					Context:    &corev1.Context{Namespace: "ns-1", Cluster: KubeappsCluster},
+					Identifier: "repo-1",
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "refresh - not found",
+			request: &corev1.RefreshPackageRepositoryRequest{
+				PackageRepoRef: &corev1.PackageRepositoryReference{
+					Plugin:     plugin,
+					Context:    &corev1.Context{Namespace: "unknown-1", Cluster: KubeappsCluster},
+					Identifier: "repo-1",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var unstructuredObjects []k8sruntime.Object
+			for _, obj := range repos {
+				unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+				unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: unstructuredContent})
+			}
+
+			s := newServerWithSecretsAndRepos(t, nil, unstructuredObjects, repos)
+
+			response, err := s.RefreshPackageRepository(context.Background(), tc.request)
+
+			// checks
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got error: %d, want: %d, err: %+v", got, want, err)
+			} else if got != codes.OK {
+				return
+			}
+
+			if got, want := response.GetPackageRepoRef().GetIdentifier(), tc.request.PackageRepoRef.Identifier; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			// The resync requests are increased so that the controller resyncs the repository.
+			appRepo, _, _, err := s.getPkgRepository(context.Background(), KubeappsCluster, "ns-1", "repo-1")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := appRepo.Spec.ResyncRequests, repo1.Spec.ResyncRequests+1; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestValidatePackageRepository(t *testing.T) {
+	testCases := []struct {
+		name             string
+		request          *corev1.ValidatePackageRepositoryRequest
+		repoClientGetter newRepoClient
+		expectedResponse *corev1.ValidatePackageRepositoryResponse
+		statusCode       codes.Code
+	}{
+		{
+			name: "returns invalid argument for an invalid package repository",
+			request: &corev1.ValidatePackageRepositoryRequest{
+				PackageRepository: addRepoReqWrongCustomValues,
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns valid for a package repository which can be reached",
+			request: &corev1.ValidatePackageRepositoryRequest{
+				PackageRepository: addRepoReqCustomValuesHelmValid,
+			},
+			repoClientGetter: newRepoHttpClient(map[string]*http.Response{"https://example.com/index.yaml": {StatusCode: 200}}),
+			expectedResponse: &corev1.ValidatePackageRepositoryResponse{
+				Valid: true,
+			},
+			statusCode: codes.OK,
+		},
+		{
+			name: "returns not valid with the reason for a package repository which cannot be reached",
+			request: &corev1.ValidatePackageRepositoryRequest{
+				PackageRepository: addRepoReqCustomValuesHelmValid,
+			},
+			repoClientGetter: newRepoHttpClient(
+				map[string]*http.Response{
+					"https://example.com/index.yaml": httpResponse(404, "It failed because of X and Y"),
+				}),
+			expectedResponse: &corev1.ValidatePackageRepositoryResponse{
+				Valid:      false,
+				UserReason: "Failed repository validation: &{404 It failed because of X and Y}",
+			},
+			statusCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newServerWithSecretsAndRepos(t, nil, nil, nil)
+			if tc.repoClientGetter != nil {
+				s.repoClientGetter = tc.repoClientGetter
+			}
+
+			response, err := s.ValidatePackageRepository(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.statusCode != codes.OK {
+				return
+			}
+
+			opt1 := cmpopts.IgnoreUnexported(corev1.ValidatePackageRepositoryResponse{})
+			if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, opt1) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1))
+			}
+
+			// Validating a repository does not create it.
+			if _, _, _, err := s.getPkgRepository(context.Background(), KubeappsCluster, tc.request.PackageRepository.Context.Namespace, tc.request.PackageRepository.Name); status.Code(err) != codes.NotFound {
+				t.Errorf("got: %+v, want: %+v", status.Code(err), codes.NotFound)
+			}
+		})
+	}
+}
+
 func checkRepoSecrets(s *Server, t *testing.T, namespace string, userManagedSecrets bool,
 	actualRepo *appRepov1alpha1.AppRepository, expectedRepo *appRepov1alpha1.AppRepository, expectedSecret *apiv1.Secret) {
 	ctx := context.Background()
@@ -12,7 +12,6 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
-	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"helm.sh/helm/v3/pkg/chart"
@@ -24,8 +23,8 @@ func TestRollbackInstalledPackage(t *testing.T) {
 	testCases := []struct {
 		name               string
 		existingReleases   []releaseStub
-		request            *helmv1.RollbackInstalledPackageRequest
-		expectedResponse   *helmv1.RollbackInstalledPackageResponse
+		request            *corev1.RollbackInstalledPackageRequest
+		expectedResponse   *corev1.RollbackInstalledPackageResponse
 		expectedStatusCode codes.Code
 		expectedRelease    *release.Release
 	}{
@@ -51,7 +50,7 @@ func TestRollbackInstalledPackage(t *testing.T) {
 					version:        1,
 				},
 			},
-			request: &helmv1.RollbackInstalledPackageRequest{
+			request: &corev1.RollbackInstalledPackageRequest{
 				InstalledPackageRef: &corev1.InstalledPackageReference{
 					Context: &corev1.Context{
 						Cluster:   "default",
@@ -61,7 +60,7 @@ func TestRollbackInstalledPackage(t *testing.T) {
 				},
 				ReleaseRevision: 1,
 			},
-			expectedResponse: &helmv1.RollbackInstalledPackageResponse{
+			expectedResponse: &corev1.RollbackInstalledPackageResponse{
 				InstalledPackageRef: &corev1.InstalledPackageReference{
 					Context: &corev1.Context{
 						Cluster:   "default",
@@ -92,7 +91,7 @@ func TestRollbackInstalledPackage(t *testing.T) {
 		},
 		{
 			name: "returns not found if installed package doesn't exist",
-			request: &helmv1.RollbackInstalledPackageRequest{
+			request: &corev1.RollbackInstalledPackageRequest{
 				InstalledPackageRef: &corev1.InstalledPackageReference{
 					Context: &corev1.Context{
 						Namespace: "default",
@@ -105,7 +104,7 @@ func TestRollbackInstalledPackage(t *testing.T) {
 	}
 
 	ignoredUnexported := cmpopts.IgnoreUnexported(
-		helmv1.RollbackInstalledPackageResponse{},
+		corev1.RollbackInstalledPackageResponse{},
 		corev1.InstalledPackageReference{},
 		corev1.Context{},
 		plugins.Plugin{},
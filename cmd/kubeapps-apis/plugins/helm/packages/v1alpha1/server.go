@@ -22,9 +22,14 @@ import (
 	helmv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/helm/packages/v1alpha1/utils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/k8sutils"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/manifestdiff"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgfilter"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/resourcerefs"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/valuesschema"
 	"github.com/vmware-tanzu/kubeapps/pkg/agent"
 	chartutils "github.com/vmware-tanzu/kubeapps/pkg/chart"
 	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
@@ -33,16 +38,19 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corek8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	log "k8s.io/klog/v2"
@@ -453,12 +461,21 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 		cmd.AllNamespaces = true
 	}
 
-	cmd.Limit = int(request.GetPaginationOptions().GetPageSize())
-	cmd.Offset, err = paginate.ItemOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
+	pageSize := int(request.GetPaginationOptions().GetPageSize())
+	itemOffset, err := paginate.ItemOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 
+	// When filtering, the pagination needs to be applied to the filtered
+	// results, so we list all releases.
+	filterOptions := request.GetFilterOptions()
+	filtering := !pkgfilter.IsEmpty(filterOptions)
+	if !filtering {
+		cmd.Limit = pageSize
+		cmd.Offset = itemOffset
+	}
+
 	// TODO(mnelson): Check whether we need to support ListAll (status == "all" in existing helm support)
 
 	releases, err := cmd.Run()
@@ -466,64 +483,236 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 		return nil, status.Errorf(codes.Internal, "Unable to run Helm List action: %v", err)
 	}
 
-	installedPkgSummaries := make([]*corev1.InstalledPackageSummary, len(releases))
+	installedPkgSummaries := []*corev1.InstalledPackageSummary{}
 	cluster := request.GetContext().GetCluster()
 	if cluster == "" {
 		cluster = s.globalPackagingCluster
 	}
-	for i, r := range releases {
-		installedPkgSummaries[i] = installedPkgSummaryFromRelease(r)
-		installedPkgSummaries[i].InstalledPackageRef.Context.Cluster = cluster
-		installedPkgSummaries[i].InstalledPackageRef.Plugin = GetPluginDetail()
-	}
-
-	// Fill in the latest package version for each.
-	// TODO(mnelson): Update to do this with a single query rather than iterating and
-	// querying per release.
-	for i, rel := range releases {
-		// Helm does not store a back-reference to the chart used to create a
-		// release (https://github.com/helm/helm/issues/6464), so for each
-		// release, we look up a chart with than name and version available in
-		// the release namespace, and if one is found, pull out the latest chart
-		// version.
-		cq := utils.ChartQuery{
-			Namespace:  rel.Namespace,
-			ChartName:  rel.Chart.Metadata.Name,
-			Version:    rel.Chart.Metadata.Version,
-			AppVersion: rel.Chart.Metadata.AppVersion,
-		}
-		charts, err := s.manager.GetPaginatedChartListWithFilters(cq, 0, 0)
+	// TODO(mnelson): Update to fill in the latest package versions with a single
+	// query rather than iterating and querying per release.
+	numMatched := 0
+	for _, r := range releases {
+		installedPkgSummary, err := s.installedPkgSummaryWithStatusFromRelease(r, cluster)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Error while fetching related charts: %v", err)
+			return nil, err
 		}
-		// TODO(agamez): deal with multiple matches, perhaps returning []AvailablePackageRef ?
-		// Example: global + namespaced repo including an overlapping subset of packages.
-		if len(charts) > 0 && len(charts[0].ChartVersions) > 0 {
-			installedPkgSummaries[i].LatestVersion = &corev1.PackageAppVersion{
-				PkgVersion: charts[0].ChartVersions[0].Version,
-				AppVersion: charts[0].ChartVersions[0].AppVersion,
+		if filtering {
+			if !pkgfilter.MatchesInstalledPackageSummary(filterOptions, installedPkgSummary) {
+				continue
+			}
+			numMatched++
+			if numMatched <= itemOffset {
+				continue
 			}
 		}
-		installedPkgSummaries[i].Status = &corev1.InstalledPackageStatus{
-			Ready:      rel.Info.Status == release.StatusDeployed,
-			Reason:     statusReasonForHelmStatus(rel.Info.Status),
-			UserReason: rel.Info.Status.String(),
-		}
-		installedPkgSummaries[i].CurrentVersion = &corev1.PackageAppVersion{
-			PkgVersion: rel.Chart.Metadata.Version,
-			AppVersion: rel.Chart.Metadata.AppVersion,
+		installedPkgSummaries = append(installedPkgSummaries, installedPkgSummary)
+		if pageSize > 0 && len(installedPkgSummaries) == pageSize {
+			break
 		}
 	}
 
 	response := &corev1.GetInstalledPackageSummariesResponse{
 		InstalledPackageSummaries: installedPkgSummaries,
 	}
-	if cmd.Limit > 0 && len(releases) == cmd.Limit {
-		response.NextPageToken = fmt.Sprintf("%d", cmd.Offset+cmd.Limit)
+	if pageSize > 0 && len(installedPkgSummaries) == pageSize {
+		response.NextPageToken = fmt.Sprintf("%d", itemOffset+pageSize)
 	}
 	return response, nil
 }
 
+// WatchInstalledPackageSummaries streams changes to the installed packages managed
+// by the 'helm' plugin by watching the Helm release secrets.
+func (s *Server) WatchInstalledPackageSummaries(request *corev1.WatchInstalledPackageSummariesRequest, stream corev1.PackagesService_WatchInstalledPackageSummariesServer) error {
+	log.InfoS("+helm WatchInstalledPackageSummaries", "cluster", request.GetContext().GetCluster(), "namespace", request.GetContext().GetNamespace())
+
+	ctx := stream.Context()
+	cluster := request.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	typedClient, err := s.clientGetter.Typed(ctx, cluster)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "unable to get client : %v", err)
+	}
+	// The watch is re-established from the last received resourceVersion when
+	// the API server closes it, so that the client keeps receiving updates.
+	secrets := typedClient.CoreV1().Secrets(request.GetContext().GetNamespace())
+	listWatch := func() (watch.Interface, error) {
+		watcher, err := k8sutils.NewListWatcher(
+			func(options metav1.ListOptions) (runtime.Object, error) {
+				options.LabelSelector = "owner=helm"
+				return secrets.List(ctx, options)
+			},
+			func(options metav1.ListOptions) (watch.Interface, error) {
+				options.LabelSelector = "owner=helm"
+				return secrets.Watch(ctx, options)
+			},
+		)
+		if err != nil {
+			return nil, statuserror.FromK8sError("watch", "Secret", "", err)
+		}
+		return watcher, nil
+	}
+	watcher, err := listWatch()
+	if err != nil {
+		return err
+	}
+	defer func() { watcher.Stop() }()
+
+	// A release has a secret per revision, so we track the releases already
+	// sent to distinguish new releases from updated ones.
+	sentReleases := map[types.NamespacedName]bool{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return status.Errorf(codes.Unavailable, "The watch of Helm release secrets ended unexpectedly")
+			}
+			if event.Type == watch.Error {
+				if watchErr := k8serrors.FromObject(event.Object); !k8serrors.IsResourceExpired(watchErr) && !k8serrors.IsGone(watchErr) {
+					return status.Errorf(codes.Internal, "Error while watching Helm release secrets: %v", event.Object)
+				}
+				// The changes since the last received resourceVersion are no
+				// longer available, so the secrets are listed again and the
+				// releases deleted in the meantime are sent as deleted.
+				watcher.Stop()
+				newWatcher, err := listWatch()
+				if err != nil {
+					return err
+				}
+				watcher = newWatcher
+				for releaseName := range sentReleases {
+					response, err := s.watchEventForRelease(ctx, cluster, releaseName, true)
+					if err != nil {
+						return err
+					}
+					if response.EventType != corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED {
+						continue
+					}
+					delete(sentReleases, releaseName)
+					if err = stream.Send(response); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			secret, ok := event.Object.(*corek8sv1.Secret)
+			if !ok {
+				continue
+			}
+			releaseName := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Labels["name"]}
+			response, err := s.watchEventForRelease(ctx, cluster, releaseName, sentReleases[releaseName])
+			if err != nil {
+				return err
+			}
+			if response == nil {
+				continue
+			}
+			if response.EventType == corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED {
+				delete(sentReleases, releaseName)
+			} else {
+				sentReleases[releaseName] = true
+			}
+			if err = stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchEventForRelease returns the watch event for the latest revision of a
+// release, or nil if there is nothing to send.
+func (s *Server) watchEventForRelease(ctx context.Context, cluster string, releaseName types.NamespacedName, alreadySent bool) (*corev1.WatchInstalledPackageSummariesResponse, error) {
+	actionConfig, err := s.actionConfigGetter(ctx, &corev1.Context{Cluster: cluster, Namespace: releaseName.Namespace})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+	rel, err := actionConfig.Releases.Last(releaseName.Name)
+	if err != nil {
+		if !errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, status.Errorf(codes.Internal, "Unable to get the latest revision of release %q: %v", releaseName, err)
+		}
+		if !alreadySent {
+			return nil, nil
+		}
+		return &corev1.WatchInstalledPackageSummariesResponse{
+			EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED,
+			InstalledPackageSummary: &corev1.InstalledPackageSummary{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   cluster,
+						Namespace: releaseName.Namespace,
+					},
+					Identifier: releaseName.Name,
+					Plugin:     GetPluginDetail(),
+				},
+				Name: releaseName.Name,
+			},
+		}, nil
+	}
+
+	installedPkgSummary, err := s.installedPkgSummaryWithStatusFromRelease(rel, cluster)
+	if err != nil {
+		return nil, err
+	}
+	eventType := corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED
+	if alreadySent {
+		eventType = corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED
+	}
+	return &corev1.WatchInstalledPackageSummariesResponse{
+		EventType:               eventType,
+		InstalledPackageSummary: installedPkgSummary,
+	}, nil
+}
+
+// installedPkgSummaryWithStatusFromRelease returns the installed package summary
+// for a release, including its status and the latest available package version.
+func (s *Server) installedPkgSummaryWithStatusFromRelease(rel *release.Release, cluster string) (*corev1.InstalledPackageSummary, error) {
+	installedPkgSummary := installedPkgSummaryFromRelease(rel)
+	installedPkgSummary.InstalledPackageRef.Context.Cluster = cluster
+	installedPkgSummary.InstalledPackageRef.Plugin = GetPluginDetail()
+
+	// Helm does not store a back-reference to the chart used to create a
+	// release (https://github.com/helm/helm/issues/6464), so for each
+	// release, we look up a chart with than name and version available in
+	// the release namespace, and if one is found, pull out the latest chart
+	// version.
+	cq := utils.ChartQuery{
+		Namespace:  rel.Namespace,
+		ChartName:  rel.Chart.Metadata.Name,
+		Version:    rel.Chart.Metadata.Version,
+		AppVersion: rel.Chart.Metadata.AppVersion,
+	}
+	charts, err := s.manager.GetPaginatedChartListWithFilters(cq, 0, 0)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error while fetching related charts: %v", err)
+	}
+	// TODO(agamez): deal with multiple matches, perhaps returning []AvailablePackageRef ?
+	// Example: global + namespaced repo including an overlapping subset of packages.
+	if len(charts) > 0 && len(charts[0].ChartVersions) > 0 {
+		installedPkgSummary.LatestVersion = &corev1.PackageAppVersion{
+			PkgVersion: charts[0].ChartVersions[0].Version,
+			AppVersion: charts[0].ChartVersions[0].AppVersion,
+		}
+	}
+	installedPkgSummary.Status = &corev1.InstalledPackageStatus{
+		Ready:      rel.Info.Status == release.StatusDeployed,
+		Reason:     statusReasonForHelmStatus(rel.Info.Status),
+		UserReason: rel.Info.Status.String(),
+	}
+	installedPkgSummary.CurrentVersion = &corev1.PackageAppVersion{
+		PkgVersion: rel.Chart.Metadata.Version,
+		AppVersion: rel.Chart.Metadata.AppVersion,
+	}
+	return installedPkgSummary, nil
+}
+
 func statusReasonForHelmStatus(s release.Status) corev1.InstalledPackageStatus_StatusReason {
 	switch s {
 	case release.StatusDeployed:
@@ -686,6 +875,9 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "Missing permissions %v", err)
 	}
+	if err := valuesschema.Validate(request.GetValues(), ch.Values, ch.Schema); err != nil {
+		return nil, err
+	}
 
 	// Create an action config for the target namespace.
 	actionConfig, err := s.actionConfigGetter(ctx, request.GetTargetContext())
@@ -693,7 +885,12 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
 	}
 
-	release, err := s.createReleaseFunc(actionConfig, request.GetName(), request.GetTargetContext().GetNamespace(), request.GetValues(), ch, registrySecrets, s.pluginConfig.TimeoutSeconds)
+	var release *release.Release
+	if request.GetDryRun() {
+		release, err = agent.DryRunCreateRelease(actionConfig, request.GetName(), request.GetTargetContext().GetNamespace(), request.GetValues(), ch, registrySecrets)
+	} else {
+		release, err = s.createReleaseFunc(actionConfig, request.GetName(), request.GetTargetContext().GetNamespace(), request.GetValues(), ch, registrySecrets, s.pluginConfig.TimeoutSeconds)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to create helm release %q in the namespace %q: %v", request.GetName(), request.GetTargetContext().GetNamespace(), err)
 	}
@@ -702,7 +899,7 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 	if cluster == "" {
 		cluster = s.globalPackagingCluster
 	}
-	return &corev1.CreateInstalledPackageResponse{
+	response := &corev1.CreateInstalledPackageResponse{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
 				Cluster:   cluster,
@@ -711,7 +908,11 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 			Identifier: release.Name,
 			Plugin:     GetPluginDetail(),
 		},
-	}, nil
+	}
+	if request.GetDryRun() {
+		response.Manifest = release.Manifest
+	}
+	return response, nil
 }
 
 // UpdateInstalledPackage updates an installed package.
@@ -757,6 +958,9 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "Missing permissions %v", err)
 	}
+	if err := valuesschema.Validate(request.GetValues(), ch.Values, ch.Schema); err != nil {
+		return nil, err
+	}
 
 	// Create an action config for the installed pkg context.
 	actionConfig, err := s.actionConfigGetter(ctx, installedRef.GetContext())
@@ -764,6 +968,10 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
 	}
 
+	if request.GetDryRun() {
+		return s.dryRunUpdateInstalledPackage(actionConfig, installedRef, request.GetValues(), ch, registrySecrets)
+	}
+
 	release, err := agent.UpgradeRelease(actionConfig, releaseName, request.GetValues(), ch, registrySecrets, s.pluginConfig.TimeoutSeconds)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to upgrade helm release %q in the namespace %q: %v", releaseName, installedRef.GetContext().GetNamespace(), err)
@@ -786,6 +994,41 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 	}, nil
 }
 
+// dryRunUpdateInstalledPackage renders the upgrade of an installed package
+// without applying it, returning the rendered manifest together with the
+// differences with the currently deployed one.
+func (s *Server) dryRunUpdateInstalledPackage(actionConfig *action.Configuration, installedRef *corev1.InstalledPackageReference, values string, ch *chart.Chart, registrySecrets map[string]string) (*corev1.UpdateInstalledPackageResponse, error) {
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	current, upgraded, err := agent.DryRunUpgradeRelease(actionConfig, releaseName, values, ch, registrySecrets)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to upgrade helm release %q in the namespace %q: %v", releaseName, namespace, err)
+	}
+
+	diffs, err := manifestdiff.ResourceDiffs(current.Manifest, upgraded.Manifest, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := installedRef.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	return &corev1.UpdateInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   cluster,
+				Namespace: upgraded.Namespace,
+			},
+			Identifier: upgraded.Name,
+			Plugin:     GetPluginDetail(),
+		},
+		Manifest:      upgraded.Manifest,
+		ResourceDiffs: diffs,
+	}, nil
+}
+
 // getAppRepoAndRelatedSecrets retrieves the given repo from its cluster and namespace
 func (s *Server) getAppRepoAndRelatedSecrets(ctx context.Context, cluster, appRepoName, appRepoNamespace string) (*appRepov1.AppRepository, *corek8sv1.Secret, *corek8sv1.Secret, error) {
 
@@ -931,7 +1174,7 @@ func (s *Server) DeleteInstalledPackage(ctx context.Context, request *corev1.Del
 }
 
 // RollbackInstalledPackage updates an installed package.
-func (s *Server) RollbackInstalledPackage(ctx context.Context, request *helmv1.RollbackInstalledPackageRequest) (*helmv1.RollbackInstalledPackageResponse, error) {
+func (s *Server) RollbackInstalledPackage(ctx context.Context, request *corev1.RollbackInstalledPackageRequest) (*corev1.RollbackInstalledPackageResponse, error) {
 	installedRef := request.GetInstalledPackageRef()
 	releaseName := installedRef.GetIdentifier()
 	log.InfoS("+helm RollbackInstalledPackage", "cluster", installedRef.GetContext().GetCluster(), "namespace", installedRef.GetContext().GetNamespace())
@@ -955,7 +1198,7 @@ func (s *Server) RollbackInstalledPackage(ctx context.Context, request *helmv1.R
 		cluster = s.globalPackagingCluster
 	}
 
-	return &helmv1.RollbackInstalledPackageResponse{
+	return &corev1.RollbackInstalledPackageResponse{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
 				Cluster:   cluster,
@@ -967,6 +1210,70 @@ func (s *Server) RollbackInstalledPackage(ctx context.Context, request *helmv1.R
 	}, nil
 }
 
+// GetInstalledPackageRevisions returns the revisions of an installed package
+// from the release history.
+func (s *Server) GetInstalledPackageRevisions(ctx context.Context, request *corev1.GetInstalledPackageRevisionsRequest) (*corev1.GetInstalledPackageRevisionsResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	log.InfoS("+helm GetInstalledPackageRevisions", "cluster", installedRef.GetContext().GetCluster(), "namespace", installedRef.GetContext().GetNamespace(), "id", releaseName)
+
+	actionConfig, err := s.actionConfigGetter(ctx, installedRef.GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+
+	releases, err := agent.GetReleaseHistory(actionConfig, releaseName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", releaseName, installedRef.GetContext().GetNamespace(), err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get the history of helm release %q in the namespace %q: %v", releaseName, installedRef.GetContext().GetNamespace(), err)
+	}
+
+	revisions := make([]*corev1.InstalledPackageRevision, 0, len(releases))
+	for _, r := range releases {
+		revision, err := installedPkgRevisionFromRelease(r)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to create installed package revision from release: %v", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return &corev1.GetInstalledPackageRevisionsResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+func installedPkgRevisionFromRelease(r *release.Release) (*corev1.InstalledPackageRevision, error) {
+	revision := &corev1.InstalledPackageRevision{
+		ReleaseRevision: int32(r.Version),
+	}
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		revision.Version = &corev1.PackageAppVersion{
+			PkgVersion: r.Chart.Metadata.Version,
+			AppVersion: r.Chart.Metadata.AppVersion,
+		}
+	}
+	if r.Info != nil {
+		revision.Status = &corev1.InstalledPackageStatus{
+			Ready:      r.Info.Status == release.StatusDeployed,
+			Reason:     statusReasonForHelmStatus(r.Info.Status),
+			UserReason: r.Info.Status.String(),
+		}
+		if !r.Info.LastDeployed.IsZero() {
+			revision.Updated = timestamppb.New(r.Info.LastDeployed.Time)
+		}
+	}
+	if len(r.Config) > 0 {
+		valuesMarshalled, err := json.Marshal(r.Config)
+		if err != nil {
+			return nil, err
+		}
+		revision.ValuesApplied = string(valuesMarshalled)
+	}
+	return revision, nil
+}
+
 // GetInstalledPackageResourceRefs returns the references for the Kubernetes
 // resources created by an installed package.
 func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *corev1.GetInstalledPackageResourceRefsRequest) (*corev1.GetInstalledPackageResourceRefsResponse, error) {
@@ -996,6 +1303,20 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 
 func (s *Server) AddPackageRepository(ctx context.Context, request *corev1.AddPackageRepositoryRequest) (*corev1.AddPackageRepositoryResponse, error) {
 	log.Infof("+helm AddPackageRepository '%s' pointing to '%s'", request.Name, request.Url)
+	helmRepo, err := s.newHelmRepositoryFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	if repoRef, err := s.newRepo(ctx, helmRepo); err != nil {
+		return nil, err
+	} else {
+		return &corev1.AddPackageRepositoryResponse{PackageRepoRef: repoRef}, nil
+	}
+}
+
+// newHelmRepositoryFromRequest checks and maps the details of a package
+// repository to be added, as used when adding or validating it.
+func (s *Server) newHelmRepositoryFromRequest(request *corev1.AddPackageRepositoryRequest) (*HelmRepository, error) {
 	if request == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "no request provided")
 	}
@@ -1043,11 +1364,7 @@ func (s *Server) AddPackageRepository(ctx context.Context, request *corev1.AddPa
 		auth:          request.GetAuth(),
 		customDetails: customDetails,
 	}
-	if repoRef, err := s.newRepo(ctx, helmRepo); err != nil {
-		return nil, err
-	} else {
-		return &corev1.AddPackageRepositoryResponse{PackageRepoRef: repoRef}, nil
-	}
+	return helmRepo, nil
 }
 
 func (s *Server) getClient(ctx context.Context, cluster string, namespace string) (ctrlclient.Client, error) {
@@ -1170,6 +1487,55 @@ func (s *Server) DeletePackageRepository(ctx context.Context, request *corev1.De
 	}
 }
 
+func (s *Server) RefreshPackageRepository(ctx context.Context, request *corev1.RefreshPackageRepositoryRequest) (*corev1.RefreshPackageRepositoryResponse, error) {
+	log.Infof("+helm RefreshPackageRepository [%v]", request)
+
+	if request == nil || request.PackageRepoRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request PackageRepoRef provided")
+	}
+	repoRef := request.GetPackageRepoRef()
+	if repoRef.GetContext() == nil || repoRef.GetContext().GetCluster() == "" || repoRef.GetContext().GetNamespace() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "no valid context provided")
+	}
+	cluster := repoRef.GetContext().GetCluster()
+
+	if err := s.refreshRepo(ctx, cluster, repoRef); err != nil {
+		return nil, err
+	} else {
+		return &corev1.RefreshPackageRepositoryResponse{
+			PackageRepoRef: &corev1.PackageRepositoryReference{
+				Context:    repoRef.GetContext(),
+				Identifier: repoRef.GetIdentifier(),
+				Plugin:     GetPluginDetail(),
+			},
+		}, nil
+	}
+}
+
+func (s *Server) ValidatePackageRepository(ctx context.Context, request *corev1.ValidatePackageRepositoryRequest) (*corev1.ValidatePackageRepositoryResponse, error) {
+	log.Infof("+helm ValidatePackageRepository '%s' pointing to '%s'", request.GetPackageRepository().GetName(), request.GetPackageRepository().GetUrl())
+
+	helmRepo, err := s.newHelmRepositoryFromRequest(request.GetPackageRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// A repository which cannot be reached is reported in the response,
+	// rather than as an error of the request.
+	if err := s.validateRepo(ctx, helmRepo); err != nil {
+		if status.Code(err) != codes.FailedPrecondition {
+			return nil, err
+		}
+		return &corev1.ValidatePackageRepositoryResponse{
+			Valid:      false,
+			UserReason: status.Convert(err).Message(),
+		}, nil
+	}
+	return &corev1.ValidatePackageRepositoryResponse{
+		Valid: true,
+	}, nil
+}
+
 // SetUserManagedSecrets This endpoint exists only for integration unit tests
 func (s *Server) SetUserManagedSecrets(ctx context.Context, request *helmv1.SetUserManagedSecretsRequest) (*helmv1.SetUserManagedSecretsResponse, error) {
 	log.Infof("+helm SetUserManagedSecrets [%t]", request.Value)
@@ -1343,9 +1343,12 @@ core:
 }
 func TestGetInstalledPackageSummaries(t *testing.T) {
 	testCases := []struct {
-		name               string
-		request            *corev1.GetInstalledPackageSummariesRequest
-		existingReleases   []releaseStub
+		name             string
+		request          *corev1.GetInstalledPackageSummariesRequest
+		existingReleases []releaseStub
+		// queriedReleases are the releases for which the asset DB is queried, when
+		// different from the releases in the expected response.
+		queriedReleases    []releaseStub
 		expectedStatusCode codes.Code
 		expectedResponse   *corev1.GetInstalledPackageSummariesResponse
 	}{
@@ -1765,6 +1768,81 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "returns only the installed packages matching the filter options",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{Namespace: "namespace-1"},
+				FilterOptions: &corev1.InstalledPackageFilterOptions{
+					StatusReasons: []corev1.InstalledPackageStatus_StatusReason{
+						corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
+					},
+				},
+			},
+			existingReleases: []releaseStub{
+				{
+					name:         "my-release-1",
+					namespace:    "namespace-1",
+					chartVersion: "1.2.3",
+					status:       release.StatusDeployed,
+					version:      1,
+				},
+				{
+					name:         "my-release-2",
+					namespace:    "namespace-1",
+					chartVersion: "3.4.5",
+					status:       release.StatusFailed,
+					version:      1,
+				},
+			},
+			queriedReleases: []releaseStub{
+				{
+					name:          "my-release-1",
+					namespace:     "namespace-1",
+					chartVersion:  "1.2.3",
+					latestVersion: "1.2.3",
+					version:       DefaultReleaseRevision,
+				},
+				{
+					name:          "my-release-2",
+					namespace:     "namespace-1",
+					chartVersion:  "3.4.5",
+					latestVersion: "3.4.5",
+					version:       DefaultReleaseRevision,
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					{
+						InstalledPackageRef: &corev1.InstalledPackageReference{
+							Context: &corev1.Context{
+								Cluster:   globalPackagingCluster,
+								Namespace: "namespace-1",
+							},
+							Identifier: "my-release-2",
+							Plugin:     GetPluginDetail(),
+						},
+						Name:    "my-release-2",
+						IconUrl: "https://example.com/icon.png",
+						PkgVersionReference: &corev1.VersionReference{
+							Version: "3.4.5",
+						},
+						CurrentVersion: &corev1.PackageAppVersion{
+							PkgVersion: "3.4.5",
+							AppVersion: DefaultAppVersion,
+						},
+						LatestVersion: &corev1.PackageAppVersion{
+							PkgVersion: "3.4.5",
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Ready:      false,
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
+							UserReason: "failed",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1774,7 +1852,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 			server, mock, cleanup := makeServer(t, authorized, actionConfig)
 			defer cleanup()
 
-			if tc.expectedStatusCode == codes.OK {
+			if tc.queriedReleases != nil {
+				populateAssetDB(t, mock, tc.queriedReleases)
+			} else if tc.expectedStatusCode == codes.OK {
 				populateAssetDBWithSummaries(t, mock, tc.expectedResponse.InstalledPackageSummaries)
 			}
 
@@ -205,3 +205,104 @@ func TestUpdateInstalledPackage(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateInstalledPackageDryRun(t *testing.T) {
+	existingRelease := releaseStub{
+		name:           "my-apache",
+		namespace:      "default",
+		chartID:        "bitnami/apache",
+		chartVersion:   "1.18.3",
+		chartNamespace: globalPackagingNamespace,
+		status:         release.StatusDeployed,
+		version:        1,
+		manifest: `---
+# Source: apache/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config`,
+	}
+	request := &corev1.UpdateInstalledPackageRequest{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   "default",
+				Namespace: "default",
+			},
+			Identifier: "my-apache",
+		},
+		PkgVersionReference: &corev1.VersionReference{
+			Version: "1.18.4",
+		},
+		Values: "{\"foo\": \"baz\"}",
+		DryRun: true,
+	}
+	// The fake chart of the new version has no templates, so the
+	// existing resource would be removed by the update.
+	expectedResponse := &corev1.UpdateInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   "default",
+				Namespace: "default",
+			},
+			Identifier: "my-apache",
+			Plugin:     GetPluginDetail(),
+		},
+		ResourceDiffs: []*corev1.ResourceDiff{
+			{
+				ResourceRef: &corev1.ResourceRef{
+					ApiVersion: "v1",
+					Kind:       "ConfigMap",
+					Name:       "my-config",
+					Namespace:  "default",
+				},
+				ChangeType: corev1.ResourceDiff_CHANGE_TYPE_REMOVED,
+				Diff: `-# Source: apache/templates/configmap.yaml
+-apiVersion: v1
+-kind: ConfigMap
+-metadata:
+-  name: my-config
+`,
+			},
+		},
+	}
+
+	actionConfig := newActionConfigFixture(t, "default", []releaseStub{existingRelease}, nil)
+	server, mockDB, cleanup := makeServer(t, true, actionConfig, &v1alpha1.AppRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bitnami",
+			Namespace: globalPackagingNamespace,
+		},
+	})
+	defer cleanup()
+	populateAssetDB(t, mockDB, []releaseStub{existingRelease})
+	populateAssetForTarball(t, mockDB, "bitnami%apache", globalPackagingNamespace, "1.18.4")
+
+	response, err := server.UpdateInstalledPackage(context.Background(), request)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		corev1.UpdateInstalledPackageResponse{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		plugins.Plugin{},
+		corev1.ResourceDiff{},
+		corev1.ResourceRef{},
+	)
+	if got, want := response, expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+	}
+
+	// The deployed release is left untouched.
+	rel, err := actionConfig.Releases.Deployed("my-apache")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := rel.Version, 1; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+	if got, want := rel.Chart.Metadata.Version, "1.18.3"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/release"
+	apiv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// fakeWatchInstalledPackageSummariesServer sends the events of the stream on a channel.
+type fakeWatchInstalledPackageSummariesServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *corev1.WatchInstalledPackageSummariesResponse
+}
+
+func (s *fakeWatchInstalledPackageSummariesServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchInstalledPackageSummariesServer) Send(response *corev1.WatchInstalledPackageSummariesResponse) error {
+	s.responses <- response
+	return nil
+}
+
+// watchEvent holds the relevant fields of a watch response for comparison.
+type watchEvent struct {
+	EventType  corev1.WatchInstalledPackageSummariesResponse_EventType
+	Identifier string
+	PkgVersion string
+}
+
+func (s *fakeWatchInstalledPackageSummariesServer) nextEvent(t *testing.T) watchEvent {
+	select {
+	case response := <-s.responses:
+		return watchEvent{
+			EventType:  response.GetEventType(),
+			Identifier: response.GetInstalledPackageSummary().GetInstalledPackageRef().GetIdentifier(),
+			PkgVersion: response.GetInstalledPackageSummary().GetCurrentVersion().GetPkgVersion(),
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for a watch event")
+		return watchEvent{}
+	}
+}
+
+// releaseSecret returns the secret used by Helm to store a release revision.
+func releaseSecret(name, namespace string, version int, resourceVersion string) *apiv1.Secret {
+	return &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("sh.helm.release.v1.%s.v%d", name, version),
+			Namespace:       namespace,
+			ResourceVersion: resourceVersion,
+			Labels: map[string]string{
+				"owner":   "helm",
+				"name":    name,
+				"version": fmt.Sprintf("%d", version),
+			},
+		},
+	}
+}
+
+func TestWatchInstalledPackageSummaries(t *testing.T) {
+	const namespace = "namespace-1"
+	existingRelease := releaseStub{
+		name:         "my-release",
+		namespace:    namespace,
+		chartVersion: "1.2.3",
+		status:       release.StatusDeployed,
+		version:      1,
+	}
+	upgradedRelease := existingRelease
+	upgradedRelease.chartVersion = "1.2.4"
+	upgradedRelease.version = 2
+
+	actionConfig := newActionConfigFixture(t, namespace, []releaseStub{existingRelease}, nil)
+	server, mock, cleanup := makeServer(t, true, actionConfig)
+	defer cleanup()
+
+	clientSet := typfake.NewSimpleClientset(releaseSecret("my-release", namespace, 1, "1"))
+	// The fake client does not set the resourceVersion of lists, which is
+	// required to watch the changes since the list.
+	clientSet.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		obj, err := clientSet.Tracker().List(apiv1.SchemeGroupVersion.WithResource("secrets"), apiv1.SchemeGroupVersion.WithKind("Secret"), action.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		obj.(*apiv1.SecretList).ResourceVersion = "1"
+		return true, obj, nil
+	})
+	watcher := watch.NewFakeWithChanSize(10, false)
+	clientSet.PrependWatchReactor("secrets", k8stesting.DefaultWatchReactor(watcher, nil))
+	server.clientGetter = func(context.Context, string) (clientgetter.ClientInterfaces, error) {
+		return clientgetter.NewBuilder().WithTyped(clientSet).Build(), nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeWatchInstalledPackageSummariesServer{
+		ctx:       ctx,
+		responses: make(chan *corev1.WatchInstalledPackageSummariesResponse, 10),
+	}
+	populateAssetDB(t, mock, []releaseStub{existingRelease})
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- server.WatchInstalledPackageSummaries(&corev1.WatchInstalledPackageSummariesRequest{
+			Context: &corev1.Context{Namespace: namespace},
+		}, stream)
+	}()
+
+	// The existing release is sent as added.
+	if got, want := stream.nextEvent(t), (watchEvent{corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED, "my-release", "1.2.3"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// A new revision of the release is sent as modified.
+	if err := actionConfig.Releases.Create(releaseForStub(t, upgradedRelease)); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	populateAssetDB(t, mock, []releaseStub{upgradedRelease})
+	watcher.Add(releaseSecret("my-release", namespace, 2, "2"))
+	if got, want := stream.nextEvent(t), (watchEvent{corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED, "my-release", "1.2.4"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// The secret of a release which is not stored (yet) is not sent.
+	watcher.Add(releaseSecret("other-release", namespace, 1, "3"))
+
+	// The deletion of the release is sent as deleted.
+	for _, version := range []int{1, 2} {
+		if _, err := actionConfig.Releases.Delete("my-release", version); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	watcher.Delete(releaseSecret("my-release", namespace, 2, "4"))
+	if got, want := stream.nextEvent(t), (watchEvent{EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED, Identifier: "my-release"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the watch to end")
+	}
+	if len(stream.responses) > 0 {
+		t.Errorf("unexpected events: %+v", <-stream.responses)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("%+v", err)
+	}
+}
+
+func TestWatchInstalledPackageSummariesRestartsExpiredWatches(t *testing.T) {
+	const namespace = "namespace-1"
+	existingRelease := releaseStub{
+		name:         "my-release",
+		namespace:    namespace,
+		chartVersion: "1.2.3",
+		status:       release.StatusDeployed,
+		version:      1,
+	}
+
+	actionConfig := newActionConfigFixture(t, namespace, []releaseStub{existingRelease}, nil)
+	server, mock, cleanup := makeServer(t, true, actionConfig)
+	defer cleanup()
+
+	clientSet := typfake.NewSimpleClientset(releaseSecret("my-release", namespace, 1, "1"))
+	clientSet.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		obj, err := clientSet.Tracker().List(apiv1.SchemeGroupVersion.WithResource("secrets"), apiv1.SchemeGroupVersion.WithKind("Secret"), action.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		obj.(*apiv1.SecretList).ResourceVersion = "1"
+		return true, obj, nil
+	})
+	// Each watch gets its own fake watcher, as a stopped one cannot be reused.
+	watchers := make(chan *watch.FakeWatcher, 2)
+	clientSet.PrependWatchReactor("secrets", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		watcher := watch.NewFakeWithChanSize(10, false)
+		watchers <- watcher
+		return true, watcher, nil
+	})
+	server.clientGetter = func(context.Context, string) (clientgetter.ClientInterfaces, error) {
+		return clientgetter.NewBuilder().WithTyped(clientSet).Build(), nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeWatchInstalledPackageSummariesServer{
+		ctx:       ctx,
+		responses: make(chan *corev1.WatchInstalledPackageSummariesResponse, 10),
+	}
+	populateAssetDB(t, mock, []releaseStub{existingRelease})
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- server.WatchInstalledPackageSummaries(&corev1.WatchInstalledPackageSummariesRequest{
+			Context: &corev1.Context{Namespace: namespace},
+		}, stream)
+	}()
+
+	if got, want := stream.nextEvent(t), (watchEvent{corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED, "my-release", "1.2.3"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// The release is deleted while the changes since the resourceVersion of
+	// the watch are no longer available.
+	if _, err := actionConfig.Releases.Delete("my-release", 1); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := clientSet.Tracker().Delete(apiv1.SchemeGroupVersion.WithResource("secrets"), namespace, releaseSecret("my-release", namespace, 1, "1").Name); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	(<-watchers).Error(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonExpired, Code: http.StatusGone})
+
+	// The secrets are listed again and the deleted release is sent as deleted.
+	if got, want := stream.nextEvent(t), (watchEvent{EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED, Identifier: "my-release"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// The changes are watched again.
+	select {
+	case <-watchers:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the watch to be restarted")
+	}
+
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the watch to end")
+	}
+	if len(stream.responses) > 0 {
+		t.Errorf("unexpected events: %+v", <-stream.responses)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("%+v", err)
+	}
+}
+
+func TestWatchInstalledPackageSummariesErrors(t *testing.T) {
+	server, _, cleanup := makeServer(t, true, nil)
+	defer cleanup()
+
+	clientSet := typfake.NewSimpleClientset()
+	numLists := 0
+	clientSet.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		numLists++
+		if numLists > 1 {
+			return true, nil, k8serrors.NewForbidden(apiv1.Resource("secrets"), "", fmt.Errorf("Bang!"))
+		}
+		return true, &apiv1.SecretList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}, nil
+	})
+	watcher := watch.NewFakeWithChanSize(10, false)
+	clientSet.PrependWatchReactor("secrets", k8stesting.DefaultWatchReactor(watcher, nil))
+	server.clientGetter = func(context.Context, string) (clientgetter.ClientInterfaces, error) {
+		return clientgetter.NewBuilder().WithTyped(clientSet).Build(), nil
+	}
+
+	stream := &fakeWatchInstalledPackageSummariesServer{
+		ctx:       context.Background(),
+		responses: make(chan *corev1.WatchInstalledPackageSummariesResponse, 10),
+	}
+	// The watch fails when the secrets cannot be listed again after the
+	// resourceVersion is too old.
+	watcher.Error(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonGone, Code: http.StatusGone})
+
+	err := server.WatchInstalledPackageSummaries(&corev1.WatchInstalledPackageSummariesRequest{
+		Context: &corev1.Context{Namespace: "default"},
+	}, stream)
+
+	if got, want := status.Code(err), codes.PermissionDenied; got != want {
+		t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+	}
+}
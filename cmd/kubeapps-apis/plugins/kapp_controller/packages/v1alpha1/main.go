@@ -28,7 +28,13 @@ func init() {
 // RegisterWithGRPCServer enables a plugin to register with a gRPC server
 // returning the server implementation.
 func RegisterWithGRPCServer(opts pluginsv1alpha1.GRPCPluginRegistrationOptions) (interface{}, error) {
-	svr := NewServer(opts.ConfigGetter, opts.ClustersConfig.KubeappsClusterName, opts.PluginConfigPath)
+	// The cache of the server is stopped once the server stops serving
+	var stopCh <-chan struct{}
+	if opts.Ctx != nil {
+		stopCh = opts.Ctx.Done()
+	}
+
+	svr := NewServer(opts.ConfigGetter, opts.ClustersConfig, opts.PluginConfigPath, stopCh)
 	v1alpha1.RegisterKappControllerPackagesServiceServer(opts.Registrar, svr)
 	return svr, nil
 }
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
 
 	"github.com/cppforlife/go-cli-ui/ui"
 	ctlapp "github.com/k14s/kapp/pkg/kapp/app"
@@ -21,6 +22,8 @@ import (
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/kapp_controller/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"github.com/vmware-tanzu/kubeapps/pkg/kube"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/client-go/dynamic"
@@ -59,6 +62,17 @@ type Server struct {
 	// (i.e. code is re-usable by multiple components)
 	kappClientsGetter kappClientsGetter
 	pluginConfig      *kappControllerPluginParsedConfig
+	// corePackagesClientGetter holds a function to obtain the core.packages.v1alpha1
+	// client, used to retrieve the packages managed by other plugins.
+	corePackagesClientGetter func() (corev1.PackagesServiceClient, error)
+	// coreRepositoriesClientGetter holds a function to obtain the
+	// core.packages.v1alpha1 repositories client, used to retrieve the
+	// package repositories managed by other plugins.
+	coreRepositoriesClientGetter func() (corev1.RepositoriesServiceClient, error)
+	// resourceCache holds the packages, package metadatas and package repositories
+	// of the requested clusters and namespaces. When nil, they are always requested
+	// from the API server.
+	resourceCache *resourceCache
 }
 
 // parsePluginConfig parses the input plugin configuration json file and return the configuration options.
@@ -94,7 +108,7 @@ func parsePluginConfig(pluginConfigPath string) (*kappControllerPluginParsedConf
 
 // NewServer returns a Server automatically configured with a function to obtain
 // the k8s client config.
-func NewServer(configGetter core.KubernetesConfigGetter, globalPackagingCluster, pluginConfigPath string) *Server {
+func NewServer(configGetter core.KubernetesConfigGetter, clustersConfig kube.ClustersConfig, pluginConfigPath string, stopCh <-chan struct{}) *Server {
 	var err error
 	pluginConfig := defaultPluginConfig
 	if pluginConfigPath != "" {
@@ -109,8 +123,27 @@ func NewServer(configGetter core.KubernetesConfigGetter, globalPackagingCluster,
 	return &Server{
 		clientGetter:             clientgetter.NewClientGetter(configGetter, clientgetter.Options{}),
 		globalPackagingNamespace: globalPackagingNamespace,
-		globalPackagingCluster:   globalPackagingCluster,
+		globalPackagingCluster:   clustersConfig.KubeappsClusterName,
 		pluginConfig:             pluginConfig,
+		resourceCache: newResourceCache(func(ctx context.Context, cluster string) (dynamic.Interface, error) {
+			return clientgetter.NewBackgroundClientGetterForCluster(configGetter, clustersConfig, cluster, clientgetter.Options{}).Dynamic(ctx)
+		}, stopCh),
+		corePackagesClientGetter: func() (corev1.PackagesServiceClient, error) {
+			port := os.Getenv("PORT")
+			conn, err := grpc.Dial("localhost:"+port, grpc.WithInsecure())
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "unable to dial to localhost grpc service: %s", err.Error())
+			}
+			return corev1.NewPackagesServiceClient(conn), nil
+		},
+		coreRepositoriesClientGetter: func() (corev1.RepositoriesServiceClient, error) {
+			port := os.Getenv("PORT")
+			conn, err := grpc.Dial("localhost:"+port, grpc.WithInsecure())
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "unable to dial to localhost grpc service: %s", err.Error())
+			}
+			return corev1.NewRepositoriesServiceClient(conn), nil
+		},
 		kappClientsGetter: func(ctx context.Context, cluster, namespace string) (ctlapp.Apps, ctlres.IdentifiedResources, *kappcmdapp.FailingAPIServicesPolicy, ctlres.ResourceFilter, error) {
 			if configGetter == nil {
 				return ctlapp.Apps{}, ctlres.IdentifiedResources{}, nil, ctlres.ResourceFilter{}, status.Errorf(codes.Internal, "configGetter arg required")
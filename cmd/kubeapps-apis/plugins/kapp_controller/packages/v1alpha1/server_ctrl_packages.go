@@ -6,6 +6,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,19 +16,28 @@ import (
 	"github.com/vmware-tanzu/carvel-vendir/pkg/vendir/versions"
 	vendirversions "github.com/vmware-tanzu/carvel-vendir/pkg/vendir/versions/v1alpha1"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	kappcorev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/kapp_controller/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/k8sutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/paginate"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgfilter"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/valuesschema"
+	"github.com/vmware-tanzu/kubeapps/pkg/chart/models"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	k8scorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
 	log "k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
 )
 
-const PACKAGES_CHANNEL_BUFFER_SIZE = 20
-
 // GetAvailablePackageSummaries returns the available packages managed by the 'kapp_controller' plugin
 func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *corev1.GetAvailablePackageSummariesRequest) (*corev1.GetAvailablePackageSummariesResponse, error) {
 	// Retrieve parameters from the request
@@ -67,53 +77,27 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 		}
 	}
 
-	// Create a channel to receive all packages available in the namespace.
-	// Using a buffered channel so that we don't block the network request if we
-	// can't process fast enough.
-	getPkgsChannel := make(chan *datapackagingv1alpha1.Package, PACKAGES_CHANNEL_BUFFER_SIZE)
-	var getPkgsError error
-	go func() {
-		getPkgsError = s.getPkgs(ctx, cluster, namespace, getPkgsChannel)
-	}()
-
-	// Skip through the packages until we get to the first item in our
-	// paginated results.
-	currentPkg := <-getPkgsChannel
-	for currentPkg != nil && len(pkgMetadatas) > 0 && currentPkg.Spec.RefName != pkgMetadatas[0].Name {
-		currentPkg = <-getPkgsChannel
+	// Get only the packages of the package metadatas in this page of results.
+	refNames := make([]string, len(pkgMetadatas))
+	for i, pkgMetadata := range pkgMetadatas {
+		refNames[i] = pkgMetadata.Name
+	}
+	pkgsByRefName, err := s.getPkgsByRefName(ctx, cluster, namespace, refNames)
+	if err != nil {
+		return nil, statuserror.FromK8sError("get", "Package", "", err)
 	}
 
 	availablePackageSummaries := make([]*corev1.AvailablePackageSummary, len(pkgMetadatas))
 	categories := []string{}
-	pkgsForMeta := []*datapackagingv1alpha1.Package{}
 	for i, pkgMetadata := range pkgMetadatas {
-		// currentPkg will be nil if the channel is closed and there's no
-		// more items to consume.
-		if currentPkg == nil {
+		pkgsForMeta := pkgsByRefName[pkgMetadata.Name]
+		if len(pkgsForMeta) == 0 {
 			return nil, statuserror.FromK8sError("get", "Package", pkgMetadata.Name, fmt.Errorf("no package versions for the package %q", pkgMetadata.Name))
 		}
-		// The kapp-controller returns both packages and package metadata
-		// in order. But some repositories have invalid data (TAP 1.0.2)
-		// where a package is present *without* corresponding metadata.
-		for currentPkg.Spec.RefName != pkgMetadata.Name {
-			if currentPkg.Spec.RefName > pkgMetadata.Name {
-				return nil, status.Errorf(codes.Internal, fmt.Sprintf("unexpected order for kapp-controller packages, expected %q, found %q", pkgMetadata.Name, currentPkg.Spec.RefName))
-			}
-			log.Errorf("Package %q did not have a corresponding metadata (want %q)", currentPkg.Spec.RefName, pkgMetadata.Name)
-			currentPkg = <-getPkgsChannel
-		}
-		// Collect the packages for a particular refName to be able to send the
+		// Use the packages for a particular refName to be able to send the
 		// latest semver version. For the moment, kapp-controller just returns
 		// CRs with the default alpha sorting of the CR name.
 		// Ref https://kubernetes.slack.com/archives/CH8KCCKA5/p1646285201181119
-		pkgsForMeta = append(pkgsForMeta, currentPkg)
-		currentPkg = <-getPkgsChannel
-		for currentPkg != nil && currentPkg.Spec.RefName == pkgMetadata.Name {
-			pkgsForMeta = append(pkgsForMeta, currentPkg)
-			currentPkg = <-getPkgsChannel
-		}
-		// At this point, we have all the packages collected that match
-		// this ref name, and currentPkg is for the next meta name.
 		pkgVersionMap, err := getPkgVersionsMap(pkgsForMeta)
 		if err != nil || len(pkgVersionMap[pkgMetadata.Name]) == 0 {
 			return nil, status.Errorf(codes.Internal, fmt.Sprintf("unable to calculate package versions map for packages: %v, err: %v", pkgsForMeta, err))
@@ -122,14 +106,6 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 		availablePackageSummary := s.buildAvailablePackageSummary(pkgMetadata, latestVersion, cluster)
 		availablePackageSummaries[i] = availablePackageSummary
 		categories = append(categories, availablePackageSummary.Categories...)
-
-		// Reset the packages for the current meta name.
-		pkgsForMeta = pkgsForMeta[:0]
-	}
-
-	// Verify no error during go routine.
-	if getPkgsError != nil {
-		return nil, statuserror.FromK8sError("get", "Package", "", err)
 	}
 
 	// Only return a next page token if the request was for pagination and
@@ -180,20 +156,18 @@ func (s *Server) GetAvailablePackageVersions(ctx context.Context, request *corev
 
 	}
 
-	// TODO(minelson): support configurable version summary for kapp-controller pkgs
-	// as already done for Helm (see #3588 for more info).
-	versions := make([]*corev1.PackageAppVersion, len(pkgVersionsMap[pkgName]))
+	versions := make([]models.ChartVersion, len(pkgVersionsMap[pkgName]))
 	for i, v := range pkgVersionsMap[pkgName] {
 		// Currently, PkgVersion and AppVersion are the same
 		// https://kubernetes.slack.com/archives/CH8KCCKA5/p1636386358322000?thread_ts=1636371493.320900&cid=CH8KCCKA5
-		versions[i] = &corev1.PackageAppVersion{
-			PkgVersion: v.version.String(),
+		versions[i] = models.ChartVersion{
+			Version:    v.version.String(),
 			AppVersion: v.version.String(),
 		}
 	}
 
 	return &corev1.GetAvailablePackageVersionsResponse{
-		PackageAppVersions: versions,
+		PackageAppVersions: pkgutils.PackageAppVersionsSummary(versions, s.pluginConfig.versionsInSummary),
 	}, nil
 }
 
@@ -292,9 +266,18 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 		cluster = s.globalPackagingCluster
 	}
 
-	// retrieve the paginated list of installed packages
-	// TODO(agamez): we should be paginating this request rather than requesting everything every time
-	pkgInstalls, err := s.getPkgInstalls(ctx, cluster, namespace)
+	// When filtering, the item offset counts only the matching summaries, so the
+	// pagination is done after filtering all the summaries instead.
+	filterOptions := request.GetFilterOptions()
+	filtering := !pkgfilter.IsEmpty(filterOptions)
+
+	// Retrieve the installed packages up to the end of the requested page, as
+	// the API server returns them in order.
+	limit := int64(0)
+	if pageSize > 0 && !filtering {
+		limit = int64(itemOffset) + int64(pageSize)
+	}
+	pkgInstalls, err := s.getPkgInstalls(ctx, cluster, namespace, limit)
 	if err != nil {
 		return nil, statuserror.FromK8sError("get", "PackageInstall", "", err)
 	}
@@ -304,7 +287,7 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 
 	if len(pkgInstalls) > 0 {
 		startAt := -1
-		if pageSize > 0 {
+		if pageSize > 0 && !filtering {
 			startAt = itemOffset
 			if startAt > len(pkgInstalls) {
 				return nil, status.Errorf(codes.InvalidArgument, "invalid pagination arguments %v", request.GetPaginationOptions())
@@ -370,39 +353,33 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 			}
 		}
 
-		// Create a channel to receive all packages available in the namespace
-		// (or across namespaces) Using a buffered channel so that we don't
-		// block the network request if we can't process fast enough.
-		getPkgsChannel := make(chan *datapackagingv1alpha1.Package, PACKAGES_CHANNEL_BUFFER_SIZE)
-		var getPkgsError error
-		go func() {
-			getPkgsError = s.getPkgs(ctx, cluster, namespace, getPkgsChannel)
-		}()
-
-		// For each package, we check if we need it to populate our
-		// package data.
-		pkgsForVersionMap := []*datapackagingv1alpha1.Package{}
-		for pkg := range getPkgsChannel {
-			pkgDataForNamespaces, ok := pkgDatas[pkg.Spec.RefName]
-			if !ok {
-				continue
-			}
-			pkgData, ok := pkgDataForNamespaces[pkg.Namespace]
-			if !ok {
-				continue
-			}
-			pkgsForVersionMap = append(pkgsForVersionMap, pkg)
-			_, ok = pkgData.versions[pkg.Spec.Version]
-			if !ok {
-				continue
-			}
-			pkgData.versions[pkg.Spec.Version] = pkg
+		// Get only the packages referred to by the package installs, checking
+		// for each of them if we need it to populate our package data.
+		refNames := make([]string, 0, len(pkgDatas))
+		for refName := range pkgDatas {
+			refNames = append(refNames, refName)
 		}
-
-		// Verify no error during go routine.
-		if getPkgsError != nil {
+		sort.Strings(refNames)
+		pkgsByRefName, err := s.getPkgsByRefName(ctx, cluster, namespace, refNames)
+		if err != nil {
 			return nil, statuserror.FromK8sError("get", "Package", "", err)
 		}
+		pkgsForVersionMap := []*datapackagingv1alpha1.Package{}
+		for _, refName := range refNames {
+			pkgDataForNamespaces := pkgDatas[refName]
+			for _, pkg := range pkgsByRefName[refName] {
+				pkgData, ok := pkgDataForNamespaces[pkg.Namespace]
+				if !ok {
+					continue
+				}
+				pkgsForVersionMap = append(pkgsForVersionMap, pkg)
+				_, ok = pkgData.versions[pkg.Spec.Version]
+				if !ok {
+					continue
+				}
+				pkgData.versions[pkg.Spec.Version] = pkg
+			}
+		}
 
 		// Calculate the version map for all packages that we're interested
 		// in for this paginated call.
@@ -434,12 +411,25 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, fmt.Sprintf("unable to create the InstalledPackageSummary: %v", err))
 			}
+			if filtering && !pkgfilter.MatchesInstalledPackageSummary(filterOptions, installedPackageSummary) {
+				continue
+			}
 
 			// append the availablePackageSummary to the slice
 			installedPkgSummaries = append(installedPkgSummaries, installedPackageSummary)
 		}
 	}
 
+	if filtering && pageSize > 0 {
+		if itemOffset > len(installedPkgSummaries) {
+			itemOffset = len(installedPkgSummaries)
+		}
+		installedPkgSummaries = installedPkgSummaries[itemOffset:]
+		if len(installedPkgSummaries) > int(pageSize) {
+			installedPkgSummaries = installedPkgSummaries[:pageSize]
+		}
+	}
+
 	// Only return a next page token if the request was for pagination and
 	// the results are a full page.
 	nextPageToken := ""
@@ -453,6 +443,142 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 	return response, nil
 }
 
+// WatchInstalledPackageSummaries streams changes to the installed packages managed by the 'kapp_controller' plugin
+func (s *Server) WatchInstalledPackageSummaries(request *corev1.WatchInstalledPackageSummariesRequest, stream corev1.PackagesService_WatchInstalledPackageSummariesServer) error {
+	// Retrieve parameters from the request
+	namespace := request.GetContext().GetNamespace()
+	cluster := request.GetContext().GetCluster()
+	log.InfoS("+kapp-controller WatchInstalledPackageSummaries", "cluster", cluster, "namespace", namespace)
+
+	// Assume the default cluster if none is specified
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	ctx := stream.Context()
+	watcher, err := s.watchPkgInstalls(ctx, cluster, namespace)
+	if err != nil {
+		return statuserror.FromK8sError("watch", "PackageInstall", "", err)
+	}
+	defer watcher.Stop()
+
+	// Package installs without package metadata are not sent, so we track
+	// the ones already sent to distinguish new installs from updated ones.
+	sentPkgInstalls := map[string]bool{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return status.Errorf(codes.Unavailable, "the watch of PackageInstalls ended unexpectedly")
+			}
+			if event.Type == watch.Error {
+				return status.Errorf(codes.Internal, "error while watching PackageInstalls: %v", event.Object)
+			}
+			unstructuredPkgInstall, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			pkgInstall := &packagingv1alpha1.PackageInstall{}
+			err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredPkgInstall.Object, pkgInstall)
+			if err != nil {
+				return status.Errorf(codes.Internal, "unable to convert the PackageInstall: %v", err)
+			}
+			key := fmt.Sprintf("%s/%s", pkgInstall.Namespace, pkgInstall.Name)
+
+			response, err := s.buildWatchInstalledPackageSummariesResponse(ctx, event.Type, pkgInstall, sentPkgInstalls[key], cluster)
+			if err != nil {
+				return err
+			}
+			if response == nil {
+				continue
+			}
+			if response.EventType == corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED {
+				delete(sentPkgInstalls, key)
+			} else {
+				sentPkgInstalls[key] = true
+			}
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// buildWatchInstalledPackageSummariesResponse returns the watch event for a change to a
+// package install, or nil if there is nothing to send.
+func (s *Server) buildWatchInstalledPackageSummariesResponse(ctx context.Context, eventType watch.EventType, pkgInstall *packagingv1alpha1.PackageInstall, alreadySent bool, cluster string) (*corev1.WatchInstalledPackageSummariesResponse, error) {
+	if eventType == watch.Deleted {
+		if !alreadySent {
+			return nil, nil
+		}
+		return &corev1.WatchInstalledPackageSummariesResponse{
+			EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED,
+			InstalledPackageSummary: &corev1.InstalledPackageSummary{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   cluster,
+						Namespace: pkgInstall.Namespace,
+					},
+					Plugin:     &pluginDetail,
+					Identifier: pkgInstall.Name,
+				},
+				Name: pkgInstall.Name,
+			},
+		}, nil
+	}
+
+	// The package metadata may be in the same namespace as the package
+	// install or in the global namespace.
+	pkgName := pkgInstall.Spec.PackageRef.RefName
+	var pkgMetadata *datapackagingv1alpha1.PackageMetadata
+	for _, ns := range []string{pkgInstall.Namespace, s.globalPackagingNamespace} {
+		var err error
+		pkgMetadata, err = s.getPkgMetadata(ctx, cluster, ns, pkgName)
+		if err == nil {
+			break
+		}
+		if !errors.IsNotFound(err) {
+			return nil, statuserror.FromK8sError("get", "PackageMetadata", pkgName, err)
+		}
+	}
+	// Ignore packages which do not have associated metadata
+	// available. See https://github.com/vmware-tanzu/kubeapps/issues/4901
+	if pkgMetadata == nil {
+		log.Errorf("+kapp-controller WatchInstalledPackageSummaries: No corresponding package metadata found for package %q. Ignoring package.", pkgName)
+		return nil, nil
+	}
+
+	// Use the field selector to return only Package CRs that match on the spec.refName.
+	fieldSelector := fmt.Sprintf("spec.refName=%s", pkgMetadata.Name)
+	pkgs, err := s.getPkgsWithFieldSelector(ctx, cluster, pkgMetadata.Namespace, fieldSelector)
+	if err != nil {
+		return nil, statuserror.FromK8sError("get", "Package", "", err)
+	}
+	pkgVersionsMap, err := getPkgVersionsMap(pkgs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to get the PkgVersionsMap: '%v'", err)
+	}
+
+	installedPackageSummary, err := s.buildInstalledPackageSummary(pkgInstall, pkgMetadata, pkgVersionsMap, cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("unable to create the InstalledPackageSummary: %v", err))
+	}
+
+	responseEventType := corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED
+	if alreadySent {
+		responseEventType = corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED
+	}
+	return &corev1.WatchInstalledPackageSummariesResponse{
+		EventType:               responseEventType,
+		InstalledPackageSummary: installedPackageSummary,
+	}, nil
+}
+
 // GetInstalledPackageDetail returns the package metadata managed by the 'kapp_controller' plugin
 func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.GetInstalledPackageDetailRequest) (*corev1.GetInstalledPackageDetailResponse, error) {
 	// Retrieve parameters from the request
@@ -502,11 +628,16 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 
 	// get the values applies i) get the secret name where it is stored; 2) get the values from the secret
 	valuesApplied := ""
-	// retrieve every value and build a single string containing all of them
+	// only the values managed by this plugin are retrieved, any other values secret
+	// is exposed as a reference in the custom detail
+	managedSecretName := valuesSecretName(pkgInstall.Name, pkgInstall.Namespace)
 	for _, pkgInstallValue := range pkgInstall.Spec.Values {
+		if pkgInstallValue.SecretRef == nil {
+			continue
+		}
 		secretRefName := pkgInstallValue.SecretRef.Name
 		// if there is a secret containing the applied values of this installed package, get the them
-		if secretRefName != "" {
+		if secretRefName == managedSecretName {
 			values, err := typedClient.CoreV1().Secrets(namespace).Get(ctx, secretRefName, metav1.GetOptions{})
 			if err != nil {
 				if errors.IsNotFound(err) {
@@ -515,6 +646,10 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 					return nil, statuserror.FromK8sError("get", "Secret", secretRefName, err)
 				}
 			}
+			if values != nil && !isPluginManagedValues(pkgInstall, values) {
+				log.Warningf("The values secret %q is not managed by the plugin, ignoring its values", secretRefName)
+				values = nil
+			}
 			if values != nil {
 				for fileName, valuesContent := range values.Data {
 					valuesApplied = fmt.Sprintf("%s\n# %s\n%s\n---", valuesApplied, fileName, valuesContent)
@@ -562,10 +697,12 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 	if request.GetReconciliationOptions() == nil || request.GetReconciliationOptions().GetServiceAccountName() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "no request ReconciliationOptions serviceAccountName provided")
 	}
+	if request.GetDryRun() {
+		return nil, status.Errorf(codes.Unimplemented, "dry-runs are not supported yet by the kapp_controller plugin")
+	}
 
 	// Retrieve additional parameters from the request
 	identifier := request.GetAvailablePackageRef().GetIdentifier()
-	packageCluster := request.GetAvailablePackageRef().GetContext().GetCluster()
 	packageNamespace := request.GetAvailablePackageRef().GetContext().GetNamespace()
 	reconciliationOptions := request.GetReconciliationOptions()
 	pkgVersion := request.GetPkgVersionReference().GetVersion()
@@ -580,21 +717,33 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 		targetCluster = s.globalPackagingCluster
 	}
 
-	if targetCluster != packageCluster {
-		return nil, status.Errorf(codes.InvalidArgument, "installing packages in other clusters in not supported yet")
-	}
-
 	typedClient, _, err := s.GetClients(ctx, targetCluster)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to get the k8s client: '%v'", err)
 	}
 
-	// fetch the package metadata
-	pkgMetadata, err := s.getPkgMetadata(ctx, packageCluster, packageNamespace, pkgName)
+	// fetch the package metadata.
+	// The PackageInstall is resolved by the kapp-controller running in the target cluster,
+	// so the package (and its values schema) is looked up there, regardless of the cluster
+	// in which the available package was browsed.
+	pkgMetadata, err := s.getPkgMetadata(ctx, targetCluster, packageNamespace, pkgName)
 	if err != nil {
 		return nil, statuserror.FromK8sError("get", "PackageMetadata", pkgName, err)
 	}
 
+	// validate the values before creating any resource
+	if err := s.validateValues(ctx, targetCluster, packageNamespace, pkgMetadata.Name, pkgVersion, values); err != nil {
+		return nil, err
+	}
+
+	// validate the custom detail, if any, before creating any resource
+	var customDetail *kappcorev1.InstalledPackageCustomDetail
+	if request.GetCustomDetail() != nil {
+		if customDetail, err = s.validateInstalledPackageCustomDetail(ctx, targetCluster, targetNamespace, installedPackageName, request.GetCustomDetail()); err != nil {
+			return nil, err
+		}
+	}
+
 	// build a new secret object with the values
 	secret, err := s.buildSecret(installedPackageName, values, targetNamespace)
 	if err != nil {
@@ -606,6 +755,9 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 	if err != nil {
 		return nil, status.Errorf(status.Code(err), "Unable to create the PackageInstall '%s' due to '%v'", installedPackageName, err)
 	}
+	if customDetail != nil {
+		toPkgInstallCustomDetail(customDetail, newPkgInstall)
+	}
 
 	// create the Secret in the cluster
 	// TODO(agamez): check when is the best moment to create this object.
@@ -626,6 +778,20 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 		return nil, statuserror.FromK8sError("create", "PackageInstall", newPkgInstall.Name, err)
 	}
 
+	// update the secret with the owner reference, so that it is tracked as managed by this plugin
+	setPkgInstallOwnerReference(createdSecret, createdPkgInstall)
+	if _, err = typedClient.CoreV1().Secrets(targetNamespace).Update(ctx, createdSecret, metav1.UpdateOptions{}); err != nil {
+		// clean-up the package install and the secret if something fails, as the secret
+		// would not be tracked as managed by this plugin otherwise
+		if err := s.deletePkgInstall(ctx, targetCluster, targetNamespace, newPkgInstall.Name); err != nil && !errors.IsNotFound(err) {
+			return nil, statuserror.FromK8sError("delete", "PackageInstall", newPkgInstall.Name, err)
+		}
+		if err := typedClient.CoreV1().Secrets(targetNamespace).Delete(ctx, secret.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return nil, statuserror.FromK8sError("delete", "Secret", secret.Name, err)
+		}
+		return nil, statuserror.FromK8sError("update", "Secret", createdSecret.Name, err)
+	}
+
 	resource, err := s.getAppResource(ctx, targetCluster, targetNamespace)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to get the App resource: '%v'", err)
@@ -680,6 +846,9 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 	if request.GetInstalledPackageRef().GetIdentifier() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "no request Name provided")
 	}
+	if request.GetDryRun() {
+		return nil, status.Errorf(codes.Unimplemented, "dry-runs are not supported yet by the kapp_controller plugin")
+	}
 
 	// Retrieve additional parameters from the request
 	reconciliationOptions := request.GetReconciliationOptions()
@@ -719,6 +888,19 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 		return nil, status.Errorf(codes.InvalidArgument, "The selected version %q is not elegible to be installed: %v", pkgVersion, err)
 	}
 
+	// Ensure the values are valid before updating any resource
+	if err := s.validateValues(ctx, packageCluster, packageNamespace, pkgInstall.Spec.PackageRef.RefName, pkgVersion, values); err != nil {
+		return nil, err
+	}
+
+	// Ensure the custom detail, if any, is valid before updating any resource
+	var customDetail *kappcorev1.InstalledPackageCustomDetail
+	if request.GetCustomDetail() != nil {
+		if customDetail, err = s.validateInstalledPackageCustomDetail(ctx, packageCluster, packageNamespace, installedPackageName, request.GetCustomDetail()); err != nil {
+			return nil, err
+		}
+	}
+
 	// Set the versionSelection
 	pkgInstall.Spec.PackageRef.VersionSelection = versionSelection
 
@@ -742,46 +924,67 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 		pkgInstall.Spec.Paused = reconciliationOptions.Suspend
 	}
 
-	// update the pkgInstall in the server
-	updatedPkgInstall, err := s.updatePkgInstall(ctx, packageCluster, packageNamespace, pkgInstall)
-	if err != nil {
-		return nil, statuserror.FromK8sError("update", "PackageInstall", installedPackageName, err)
+	// Update the values.yaml values file if any is passed, otherwise, delete the values.
+	// Only the values managed by this plugin are modified, any other values secret is preserved
+	// unless the custom detail is provided.
+	managedSecretName := valuesSecretName(installedPackageName, packageNamespace)
+	var otherValues []packagingv1alpha1.PackageInstallValues
+	for _, packageInstallValue := range pkgInstall.Spec.Values {
+		if packageInstallValue.SecretRef == nil || packageInstallValue.SecretRef.Name != managedSecretName {
+			otherValues = append(otherValues, packageInstallValue)
+		}
 	}
-
-	// Update the values.yaml values file if any is passed, otherwise, delete the values
 	if values != "" {
 		secret, err := s.buildSecret(installedPackageName, values, packageNamespace)
 		if err != nil {
-			return nil, statuserror.FromK8sError("update", "Secret", secret.Name, err)
+			return nil, statuserror.FromK8sError("update", "Secret", managedSecretName, err)
+		}
+		setPkgInstallOwnerReference(secret, pkgInstall)
+		// A secret with the name used by this plugin may exist for any other purpose,
+		// so it is only overwritten when it is managed by this plugin
+		var updatedSecret *k8scorev1.Secret
+		existingSecret, err := typedClient.CoreV1().Secrets(packageNamespace).Get(ctx, secret.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			updatedSecret, err = typedClient.CoreV1().Secrets(packageNamespace).Create(ctx, secret, metav1.CreateOptions{})
+		} else if err == nil {
+			if !isPluginManagedValues(pkgInstall, existingSecret) {
+				return nil, status.Errorf(codes.FailedPrecondition, "the values secret %q is not managed by the plugin and cannot be overwritten", secret.Name)
+			}
+			secret.ResourceVersion = existingSecret.ResourceVersion
+			updatedSecret, err = typedClient.CoreV1().Secrets(packageNamespace).Update(ctx, secret, metav1.UpdateOptions{})
 		}
-		updatedSecret, err := typedClient.CoreV1().Secrets(packageNamespace).Update(ctx, secret, metav1.UpdateOptions{})
 		if updatedSecret == nil || err != nil {
 			return nil, statuserror.FromK8sError("update", "Secret", secret.Name, err)
 		}
 
-		if updatedSecret != nil {
-			// Similar logic as in https://github.com/vmware-tanzu/carvel-kapp-controller/blob/v0.32.0/cli/pkg/kctrl/cmd/package/installed/create_or_update.go#L505
-			pkgInstall.Spec.Values = []packagingv1alpha1.PackageInstallValues{{
-				SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{
-					// The secret name should have the format: <name>-<namespace> as per:
-					// https://github.com/vmware-tanzu/carvel-kapp-controller/blob/v0.32.0/cli/pkg/kctrl/cmd/package/installed/created_resource_annotations.go#L19
-					Name: updatedSecret.Name,
-				},
-			}}
-		}
-
+		// Similar logic as in https://github.com/vmware-tanzu/carvel-kapp-controller/blob/v0.32.0/cli/pkg/kctrl/cmd/package/installed/create_or_update.go#L505
+		// The managed values go first, so that any other values secret takes precedence
+		pkgInstall.Spec.Values = append([]packagingv1alpha1.PackageInstallValues{{
+			SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{
+				// The secret name should have the format: <name>-<namespace> as per:
+				// https://github.com/vmware-tanzu/carvel-kapp-controller/blob/v0.32.0/cli/pkg/kctrl/cmd/package/installed/created_resource_annotations.go#L19
+				Name: updatedSecret.Name,
+			},
+		}}, otherValues...)
 	} else {
-		// Delete all the associated secrets
-		// TODO(agamez): maybe it's too aggressive and we should be deleting only those secrets created by this plugin
-		// See https://github.com/vmware-tanzu/kubeapps/pull/3790#discussion_r754797195
-		for _, packageInstallValue := range pkgInstall.Spec.Values {
-			secretId := packageInstallValue.SecretRef.Name
-			err := typedClient.CoreV1().Secrets(packageNamespace).Delete(ctx, secretId, metav1.DeleteOptions{})
-			if errors.IsNotFound(err) {
-				log.Warningf("The referenced secret does not exist: %s", statuserror.FromK8sError("get", "Secret", secretId, err).Error())
-			} else {
-				return nil, statuserror.FromK8sError("delete", "Secret", secretId, err)
-			}
+		pkgInstall.Spec.Values = otherValues
+	}
+
+	// Update the additional values secrets and overlays if the custom detail is passed
+	if customDetail != nil {
+		toPkgInstallCustomDetail(customDetail, pkgInstall)
+	}
+
+	// update the pkgInstall in the server
+	updatedPkgInstall, err := s.updatePkgInstall(ctx, packageCluster, packageNamespace, pkgInstall)
+	if err != nil {
+		return nil, statuserror.FromK8sError("update", "PackageInstall", installedPackageName, err)
+	}
+
+	// Delete the values secret managed by this plugin once it is no longer referenced
+	if values == "" {
+		if err := s.deleteManagedValuesSecret(ctx, typedClient, pkgInstall, managedSecretName); err != nil {
+			return nil, err
 		}
 	}
 
@@ -799,6 +1002,63 @@ func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.Upd
 	}, nil
 }
 
+// validateValues validates the values against the values schema of the given package version, if any.
+// Values for a version which cannot be found are not validated, leaving kapp-controller to report the error.
+func (s *Server) validateValues(ctx context.Context, cluster, namespace, pkgName, pkgVersion, values string) error {
+	// Use the field selector to return only Package CRs that match on the spec.refName.
+	fieldSelector := fmt.Sprintf("spec.refName=%s", pkgName)
+	pkgs, err := s.getPkgsWithFieldSelector(ctx, cluster, namespace, fieldSelector)
+	if err != nil {
+		return statuserror.FromK8sError("get", "Package", pkgName, err)
+	}
+	for _, pkg := range pkgs {
+		if pkg.Spec.RefName != pkgName || pkg.Spec.Version != pkgVersion {
+			continue
+		}
+		schema := pkg.Spec.ValuesSchema.OpenAPIv3.Raw
+		// kapp-controller applies the defaults of the schema before validating the values
+		defaultValues, err := pkgutils.DefaultValuesFromSchema(schema, false)
+		if err != nil {
+			return status.Errorf(codes.Internal, "unable to get the default values of the package %q: %v", pkgName, err)
+		}
+		defaults := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(defaultValues), &defaults); err != nil {
+			return status.Errorf(codes.Internal, "unable to parse the default values of the package %q: %v", pkgName, err)
+		}
+		return valuesschema.Validate(values, defaults, schema)
+	}
+	return nil
+}
+
+// validateInstalledPackageCustomDetail returns the custom detail of an installed package, ensuring the
+// referenced values and overlays secrets exist, so that kapp-controller can reconcile the package.
+func (s *Server) validateInstalledPackageCustomDetail(ctx context.Context, cluster, namespace, installedPackageName string, any *anypb.Any) (*kappcorev1.InstalledPackageCustomDetail, error) {
+	customDetail := &kappcorev1.InstalledPackageCustomDetail{}
+	if err := any.UnmarshalTo(customDetail); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "custom details are invalid: %v", err)
+	}
+
+	secretNames := []string{}
+	for _, secretRef := range customDetail.GetValuesSecrets() {
+		if secretRef.GetName() == valuesSecretName(installedPackageName, namespace) {
+			return nil, status.Errorf(codes.InvalidArgument, "the values secret %q is managed by the plugin and cannot be referenced", secretRef.GetName())
+		}
+		secretNames = append(secretNames, secretRef.GetName())
+	}
+	secretNames = append(secretNames, customDetail.GetOverlaySecrets()...)
+
+	for _, name := range secretNames {
+		if name == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid custom details, the secret name is not provided")
+		}
+		if _, err := s.getSecret(ctx, cluster, namespace, name); err != nil {
+			err = statuserror.FromK8sError("get", "Secret", name, err)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid custom details, the secret could not be accessed: %v", err)
+		}
+	}
+	return customDetail, nil
+}
+
 // DeleteInstalledPackage Deletes an installed package managed by the 'kapp_controller' plugin
 func (s *Server) DeleteInstalledPackage(ctx context.Context, request *corev1.DeleteInstalledPackageRequest) (*corev1.DeleteInstalledPackageResponse, error) {
 	// Retrieve parameters from the request
@@ -835,23 +1095,40 @@ func (s *Server) DeleteInstalledPackage(ctx context.Context, request *corev1.Del
 		return nil, statuserror.FromK8sError("delete", "PackageInstall", identifier, err)
 	}
 
-	// Delete all the associated secrets
-	// TODO(agamez): maybe it's too aggressive and we should be deleting only those secrets created by this plugin
-	// See https://github.com/vmware-tanzu/kubeapps/pull/3790#discussion_r754797195
+	// Delete the associated secrets created by this plugin,
+	// any other referenced secret has been provided by the user and is left untouched
 	for _, packageInstallValue := range pkgInstall.Spec.Values {
-		secretId := packageInstallValue.SecretRef.Name
-		err := typedClient.CoreV1().Secrets(namespace).Delete(ctx, secretId, metav1.DeleteOptions{})
-		if err != nil {
-			if errors.IsNotFound(err) {
-				log.Warningf("The referenced secret does not exist: %s", statuserror.FromK8sError("get", "Secret", secretId, err).Error())
-			} else {
-				return nil, statuserror.FromK8sError("delete", "Secret", secretId, err)
-			}
+		if packageInstallValue.SecretRef == nil {
+			continue
+		}
+		if err := s.deleteManagedValuesSecret(ctx, typedClient, pkgInstall, packageInstallValue.SecretRef.Name); err != nil {
+			return nil, err
 		}
 	}
 	return &corev1.DeleteInstalledPackageResponse{}, nil
 }
 
+// deleteManagedValuesSecret deletes the given values secret only if it is managed by this plugin for the PackageInstall.
+func (s *Server) deleteManagedValuesSecret(ctx context.Context, typedClient kubernetes.Interface, pkgInstall *packagingv1alpha1.PackageInstall, secretName string) error {
+	secret, err := typedClient.CoreV1().Secrets(pkgInstall.Namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Warningf("The referenced secret does not exist: %s", statuserror.FromK8sError("get", "Secret", secretName, err).Error())
+			return nil
+		}
+		return statuserror.FromK8sError("get", "Secret", secretName, err)
+	}
+	if !isPluginManagedValues(pkgInstall, secret) {
+		log.InfoS("Skipping the deletion of a values secret not managed by the plugin", "namespace", pkgInstall.Namespace, "name", secretName)
+		return nil
+	}
+	err = typedClient.CoreV1().Secrets(pkgInstall.Namespace).Delete(ctx, secretName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return statuserror.FromK8sError("delete", "Secret", secretName, err)
+	}
+	return nil
+}
+
 // GetInstalledPackageResourceRefs returns the references for the k8s resources of an installed package managed by the 'kapp_controller' plugin
 func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *corev1.GetInstalledPackageResourceRefsRequest) (*corev1.GetInstalledPackageResourceRefsResponse, error) {
 	// Retrieve parameters from the request
@@ -875,3 +1152,121 @@ func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *c
 		ResourceRefs: refs,
 	}, nil
 }
+
+// GetInstalledPackageRevisions is not supported yet by the 'kapp_controller' plugin,
+// as kapp-controller does not keep a history of the applied package versions
+func (s *Server) GetInstalledPackageRevisions(ctx context.Context, request *corev1.GetInstalledPackageRevisionsRequest) (*corev1.GetInstalledPackageRevisionsResponse, error) {
+	log.InfoS("+kapp-controller GetInstalledPackageRevisions", "cluster", request.GetInstalledPackageRef().GetContext().GetCluster(), "namespace", request.GetInstalledPackageRef().GetContext().GetNamespace(), "id", request.GetInstalledPackageRef().GetIdentifier())
+	return nil, status.Errorf(codes.Unimplemented, "revisions are not supported yet by the kapp_controller plugin")
+}
+
+// RollbackInstalledPackage is not supported yet by the 'kapp_controller' plugin
+func (s *Server) RollbackInstalledPackage(ctx context.Context, request *corev1.RollbackInstalledPackageRequest) (*corev1.RollbackInstalledPackageResponse, error) {
+	log.InfoS("+kapp-controller RollbackInstalledPackage", "cluster", request.GetInstalledPackageRef().GetContext().GetCluster(), "namespace", request.GetInstalledPackageRef().GetContext().GetNamespace(), "id", request.GetInstalledPackageRef().GetIdentifier())
+	return nil, status.Errorf(codes.Unimplemented, "rollbacks are not supported yet by the kapp_controller plugin")
+}
+
+// ConvertHelmPackage generates the Carvel PackageMetadata and Package for a Helm chart,
+// creating them in the target context if a namespace is provided
+func (s *Server) ConvertHelmPackage(ctx context.Context, request *kappcorev1.ConvertHelmPackageRequest) (*kappcorev1.ConvertHelmPackageResponse, error) {
+	// Retrieve parameters from the request
+	helmPkgRef := request.GetAvailablePackageRef()
+	targetCluster := request.GetTargetContext().GetCluster()
+	targetNamespace := request.GetTargetContext().GetNamespace()
+	log.InfoS("+kapp-controller ConvertHelmPackage", "cluster", targetCluster, "namespace", targetNamespace, "id", helmPkgRef.GetIdentifier())
+
+	// Validate the request
+	if helmPkgRef == nil || helmPkgRef.GetIdentifier() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "no request AvailablePackageRef provided")
+	}
+	if helmPkgRef.GetPlugin().GetName() != HELM_PLUGIN_NAME {
+		return nil, status.Errorf(codes.InvalidArgument, "the AvailablePackageRef must belong to the %q plugin, got: %q", HELM_PLUGIN_NAME, helmPkgRef.GetPlugin().GetName())
+	}
+	// The Helm package identifier has the format: <repoName>/<chartName>
+	repoName, _, found := strings.Cut(helmPkgRef.GetIdentifier(), "/")
+	if !found || repoName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the AvailablePackageRef identifier %q is not a valid Helm package identifier", helmPkgRef.GetIdentifier())
+	}
+
+	if targetCluster == "" {
+		targetCluster = s.globalPackagingCluster
+	}
+
+	// Retrieve the Helm package detail on behalf of the user through the core packages API
+	outgoingCtx, err := copyAuthorizationMetadataForOutgoing(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The generated Package fetches the chart without any credentials, so the
+	// charts of repositories requiring authentication cannot be converted
+	repoClient, err := s.coreRepositoriesClientGetter()
+	if err != nil {
+		return nil, err
+	}
+	repoResponse, err := repoClient.GetPackageRepositoryDetail(outgoingCtx, &corev1.GetPackageRepositoryDetailRequest{
+		PackageRepoRef: &corev1.PackageRepositoryReference{
+			Context:    helmPkgRef.GetContext(),
+			Plugin:     helmPkgRef.GetPlugin(),
+			Identifier: repoName,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if repoResponse.GetDetail().GetAuth().GetType() != corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_UNSPECIFIED {
+		return nil, status.Errorf(codes.FailedPrecondition, "the Helm package %q cannot be converted since its repository %q requires authentication", helmPkgRef.GetIdentifier(), repoName)
+	}
+
+	coreClient, err := s.corePackagesClientGetter()
+	if err != nil {
+		return nil, err
+	}
+	detailResponse, err := coreClient.GetAvailablePackageDetail(outgoingCtx, &corev1.GetAvailablePackageDetailRequest{
+		AvailablePackageRef: helmPkgRef,
+		PkgVersion:          request.GetPkgVersion(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	helmPkgDetail := detailResponse.GetAvailablePackageDetail()
+
+	// Build the PackageMetadata and Package objects
+	refName := helmPkgRefName(repoName, helmPkgDetail.GetName())
+	pkgMetadata := s.buildHelmPkgMetadata(helmPkgDetail, refName, targetNamespace)
+	pkg, err := s.buildHelmPkg(helmPkgDetail, refName, targetNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := toYAMLManifest(pkgMetadata, pkg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to generate the manifest: %v", err)
+	}
+	response := &kappcorev1.ConvertHelmPackageResponse{
+		Manifest: manifest,
+	}
+
+	// Just return the manifest if no target namespace is provided
+	if targetNamespace == "" {
+		return response, nil
+	}
+
+	// The PackageMetadata is shared by every version of the chart, so it may already exist
+	if _, err = s.createPkgMetadata(ctx, targetCluster, targetNamespace, pkgMetadata); err != nil && !errors.IsAlreadyExists(err) {
+		return nil, statuserror.FromK8sError("create", "PackageMetadata", pkgMetadata.Name, err)
+	}
+	if _, err = s.createPkg(ctx, targetCluster, targetNamespace, pkg); err != nil {
+		return nil, statuserror.FromK8sError("create", "Package", pkg.Name, err)
+	}
+
+	response.AvailablePackageRef = &corev1.AvailablePackageReference{
+		Context: &corev1.Context{
+			Cluster:   targetCluster,
+			Namespace: targetNamespace,
+		},
+		Plugin:     GetPluginDetail(),
+		Identifier: buildPackageIdentifier(pkgMetadata),
+	}
+	return response, nil
+}
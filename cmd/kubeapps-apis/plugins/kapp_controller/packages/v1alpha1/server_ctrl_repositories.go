@@ -9,8 +9,10 @@ import (
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
 	log "k8s.io/klog/v2"
 )
 
@@ -30,9 +32,6 @@ func (s *Server) AddPackageRepository(ctx context.Context, request *corev1.AddPa
 	log.InfoS("+kapp-controller AddPackageRepository", "cluster", cluster, "namespace", namespace, "name", request.GetName())
 
 	// validation
-	if cluster != s.globalPackagingCluster {
-		return nil, status.Errorf(codes.InvalidArgument, "installing package repositories in other clusters in not supported yet")
-	}
 	if err := s.validatePackageRepositoryCreate(ctx, cluster, request); err != nil {
 		return nil, err
 	}
@@ -188,9 +187,6 @@ func (s *Server) UpdatePackageRepository(ctx context.Context, request *corev1.Up
 	log.InfoS("+kapp-controller UpdatePackageRepository", "cluster", cluster, "namespace", namespace, "name", name)
 
 	// identity validation
-	if cluster != s.globalPackagingCluster {
-		return nil, status.Errorf(codes.InvalidArgument, "updating package repositories in other clusters in not supported yet")
-	}
 	if name == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "no request Name provided")
 	}
@@ -310,3 +306,89 @@ func (s *Server) DeletePackageRepository(ctx context.Context, request *corev1.De
 	log.InfoS("-kapp-controller DeletePackageRepository", "cluster", cluster, "namespace", namespace, "name", name)
 	return response, nil
 }
+
+// RefreshPackageRepository triggers a reconciliation of a package repository managed by the 'kapp_controller' plugin
+func (s *Server) RefreshPackageRepository(ctx context.Context, request *corev1.RefreshPackageRepositoryRequest) (*corev1.RefreshPackageRepositoryResponse, error) {
+	// context info
+	cluster := request.GetPackageRepoRef().GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	namespace := request.GetPackageRepoRef().GetContext().GetNamespace()
+	if namespace == "" {
+		namespace = s.globalPackagingNamespace
+	}
+	name := request.GetPackageRepoRef().GetIdentifier()
+
+	// trace logging
+	log.InfoS("+kapp-controller RefreshPackageRepository", "cluster", cluster, "namespace", namespace, "name", name)
+
+	// identity validation
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "no request Name provided")
+	}
+
+	// fetch existing repository
+	pkgRepository, err := s.getPkgRepository(ctx, cluster, namespace, name)
+	if err != nil {
+		return nil, statuserror.FromK8sError("get", "PackageRepository", name, err)
+	}
+	if pkgRepository.Spec.Paused {
+		return nil, status.Errorf(codes.FailedPrecondition, "the package repository %q is paused and cannot be refreshed", name)
+	}
+
+	// kapp-controller only reconciles the repository before its sync period
+	// when its spec changes, so, as done by kctrl, it is paused and then
+	// unpaused to trigger a reconciliation.
+	pkgRepository.Spec.Paused = true
+	if _, err = s.updatePkgRepository(ctx, cluster, namespace, pkgRepository); err != nil {
+		return nil, statuserror.FromK8sError("update", "PackageRepository", name, err)
+	}
+	if err = s.unpausePkgRepository(ctx, cluster, namespace, name); err != nil {
+		log.Errorf("+kapp-controller RefreshPackageRepository: the package repository %q was left paused: %v", name, err)
+		return nil, statuserror.FromK8sError("unpause", "PackageRepository", name, err)
+	}
+
+	// response
+	response := &corev1.RefreshPackageRepositoryResponse{
+		PackageRepoRef: &corev1.PackageRepositoryReference{
+			Context: &corev1.Context{
+				Cluster:   cluster,
+				Namespace: namespace,
+			},
+			Plugin:     GetPluginDetail(),
+			Identifier: name,
+		},
+	}
+
+	log.InfoS("-kapp-controller RefreshPackageRepository", "cluster", cluster, "namespace", namespace, "name", name)
+	return response, nil
+}
+
+// unpausePkgRepository unpauses the latest version of a package repository,
+// retrying on conflicts, such as with the status updates by kapp-controller.
+// It is not cancelled with the request, since the repository must not be
+// left paused.
+func (s *Server) unpausePkgRepository(ctx context.Context, cluster, namespace, name string) error {
+	unpauseCtx := context.Background()
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		unpauseCtx = metadata.NewIncomingContext(unpauseCtx, md.Copy())
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pkgRepository, err := s.getPkgRepository(unpauseCtx, cluster, namespace, name)
+		if err != nil {
+			return err
+		}
+		if !pkgRepository.Spec.Paused {
+			return nil
+		}
+		pkgRepository.Spec.Paused = false
+		_, err = s.updatePkgRepository(unpauseCtx, cluster, namespace, pkgRepository)
+		return err
+	})
+}
+
+// ValidatePackageRepository is not supported by the 'kapp_controller' plugin
+func (s *Server) ValidatePackageRepository(ctx context.Context, request *corev1.ValidatePackageRepositoryRequest) (*corev1.ValidatePackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "validating package repositories is not supported yet by the kapp_controller plugin")
+}
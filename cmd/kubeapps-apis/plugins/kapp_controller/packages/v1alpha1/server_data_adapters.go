@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -23,6 +24,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 	k8scorev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	log "k8s.io/klog/v2"
 )
 
@@ -321,23 +323,27 @@ func (s *Server) buildInstalledPackageDetail(pkgInstall *packagingv1alpha1.Packa
 		installedPackageDetail.ReconciliationOptions.Suspend = pkgInstall.Status.Conditions[0].Type == kappctrlv1alpha1.Reconciling
 	}
 
+	if customDetail := toInstalledPackageCustomDetail(pkgInstall); customDetail != nil {
+		if installedPackageDetail.CustomDetail, err = anypb.New(customDetail); err != nil {
+			return nil, err
+		}
+	}
+
 	return installedPackageDetail, nil
 }
 
 func (s *Server) buildSecret(installedPackageName, values, targetNamespace string) (*k8scorev1.Secret, error) {
-	// Using this pattern as per:
-	// https://github.com/vmware-tanzu/carvel-kapp-controller/blob/v0.36.1/cli/pkg/kctrl/cmd/package/installed/created_resource_annotations.go#L19
-	// #nosec G101
-	kappctrlSecretName := "%s-%s-values"
-
 	return &k8scorev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       k8scorev1.ResourceSecrets.String(),
 			APIVersion: k8scorev1.SchemeGroupVersion.WithResource(k8scorev1.ResourceSecrets.String()).String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf(kappctrlSecretName, installedPackageName, targetNamespace),
+			Name:      valuesSecretName(installedPackageName, targetNamespace),
 			Namespace: targetNamespace,
+			Annotations: map[string]string{
+				Annotation_ManagedBy_Key: Annotation_ManagedBy_Value,
+			},
 		},
 		Data: map[string][]byte{
 			// Using "values.yaml" as per:
@@ -421,6 +427,101 @@ func (s *Server) buildPkgInstall(installedPackageName, targetCluster, targetName
 	return pkgInstall, nil
 }
 
+// helm packages
+
+func (s *Server) buildHelmPkgMetadata(helmPkgDetail *corev1.AvailablePackageDetail, refName, namespace string) *datapackagingv1alpha1.PackageMetadata {
+	displayName := helmPkgDetail.GetDisplayName()
+	if displayName == "" {
+		displayName = helmPkgDetail.GetName()
+	}
+
+	maintainers := []datapackagingv1alpha1.Maintainer{}
+	for _, maintainer := range helmPkgDetail.GetMaintainers() {
+		maintainers = append(maintainers, datapackagingv1alpha1.Maintainer{Name: maintainer.GetName()})
+	}
+
+	// The chart icon is not included since Carvel only supports base64-encoded SVG data
+	return &datapackagingv1alpha1.PackageMetadata{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       pkgMetadataResource,
+			APIVersion: datapackagingv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      refName,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				Annotation_ManagedBy_Key: Annotation_ManagedBy_Value,
+			},
+		},
+		Spec: datapackagingv1alpha1.PackageMetadataSpec{
+			DisplayName:      displayName,
+			ShortDescription: helmPkgDetail.GetShortDescription(),
+			LongDescription:  helmPkgDetail.GetLongDescription(),
+			Maintainers:      maintainers,
+			Categories:       helmPkgDetail.GetCategories(),
+		},
+	}
+}
+
+func (s *Server) buildHelmPkg(helmPkgDetail *corev1.AvailablePackageDetail, refName, namespace string) (*datapackagingv1alpha1.Package, error) {
+	pkgVersion := helmPkgDetail.GetVersion().GetPkgVersion()
+	if pkgVersion == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the Helm package %q has no version", helmPkgDetail.GetName())
+	}
+	if helmPkgDetail.GetRepoUrl() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the Helm package %q has no repository url", helmPkgDetail.GetName())
+	}
+
+	// The values.schema.json is a JSON schema, used as is by Carvel to show the values that can be configured
+	var valuesSchema datapackagingv1alpha1.ValuesSchema
+	if schema := helmPkgDetail.GetValuesSchema(); schema != "" {
+		if !json.Valid([]byte(schema)) {
+			return nil, status.Errorf(codes.InvalidArgument, "the values schema of the Helm package %q is not a valid JSON", helmPkgDetail.GetName())
+		}
+		valuesSchema.OpenAPIv3 = runtime.RawExtension{Raw: []byte(schema)}
+	}
+
+	return &datapackagingv1alpha1.Package{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       pkgResource,
+			APIVersion: datapackagingv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			// The package name should have the format: <refName>.<version> as per:
+			// https://carvel.dev/kapp-controller/docs/v0.38.0/packaging/#package
+			Name:      fmt.Sprintf("%s.%s", refName, pkgVersion),
+			Namespace: namespace,
+			Annotations: map[string]string{
+				Annotation_ManagedBy_Key: Annotation_ManagedBy_Value,
+			},
+		},
+		Spec: datapackagingv1alpha1.PackageSpec{
+			RefName:      refName,
+			Version:      pkgVersion,
+			ValuesSchema: valuesSchema,
+			Template: datapackagingv1alpha1.AppTemplateSpec{
+				Spec: &kappctrlv1alpha1.AppSpec{
+					Fetch: []kappctrlv1alpha1.AppFetch{{
+						HelmChart: &kappctrlv1alpha1.AppFetchHelmChart{
+							Name:    helmPkgDetail.GetName(),
+							Version: pkgVersion,
+							Repository: &kappctrlv1alpha1.AppFetchHelmChartRepo{
+								URL: helmPkgDetail.GetRepoUrl(),
+							},
+						},
+					}},
+					Template: []kappctrlv1alpha1.AppTemplate{{
+						HelmTemplate: &kappctrlv1alpha1.AppTemplateHelmTemplate{},
+					}},
+					Deploy: []kappctrlv1alpha1.AppDeploy{{
+						Kapp: &kappctrlv1alpha1.AppDeployKapp{},
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
 // package repositories
 
 func (s *Server) buildPackageRepositorySummary(pkgRepository *packagingv1alpha1.PackageRepository, cluster string) (*corev1.PackageRepositorySummary, error) {
@@ -663,6 +764,8 @@ func (s *Server) buildPkgRepositoryUpdate(request *corev1.UpdatePackageRepositor
 		rptype = Type_GIT
 	case repository.Spec.Fetch.HTTP != nil:
 		rptype = Type_HTTP
+	case repository.Spec.Fetch.Inline != nil:
+		rptype = Type_Inline
 	}
 
 	// custom details
@@ -744,6 +847,14 @@ func (s *Server) buildPkgRepositorySpec(rptype string, interval string, url stri
 			}
 			spec.Fetch.HTTP = http
 		}
+	case Type_Inline:
+		{
+			inline := &kappctrlv1alpha1.AppFetchInline{}
+			if details.Fetch != nil && details.Fetch.Inline != nil {
+				toPkgFetchInline(details.Fetch.Inline, inline)
+			}
+			spec.Fetch.Inline = inline
+		}
 	}
 
 	return spec
@@ -772,10 +883,8 @@ func (s *Server) validatePackageRepositoryCreate(ctx context.Context, cluster st
 	}
 
 	switch request.Type {
-	case Type_ImgPkgBundle, Type_Image, Type_GIT, Type_HTTP:
+	case Type_ImgPkgBundle, Type_Image, Type_GIT, Type_HTTP, Type_Inline:
 		// valid types
-	case Type_Inline:
-		return status.Errorf(codes.InvalidArgument, "inline repositories are not supported")
 	case "":
 		return status.Errorf(codes.InvalidArgument, "no repository Type provided")
 	default:
@@ -785,6 +894,12 @@ func (s *Server) validatePackageRepositoryCreate(ctx context.Context, cluster st
 	if _, err := pkgutils.ToDuration(request.Interval); err != nil {
 		return status.Errorf(codes.InvalidArgument, "invalid interval: %v", err)
 	}
+	if request.Type == Type_Inline {
+		if err := s.validatePackageRepositoryInline(ctx, cluster, namespace, request.Url, request.Auth, request.CustomDetail); err != nil {
+			return err
+		}
+		return nil
+	}
 	if request.Url == "" {
 		return status.Errorf(codes.InvalidArgument, "no request Url provided")
 	}
@@ -814,7 +929,7 @@ func (s *Server) validatePackageRepositoryUpdate(ctx context.Context, cluster st
 	case pkgRepository.Spec.Fetch.HTTP != nil:
 		rptype = Type_HTTP
 	case pkgRepository.Spec.Fetch.Inline != nil:
-		return status.Errorf(codes.FailedPrecondition, "inline repositories are not supported")
+		rptype = Type_Inline
 	default:
 		return status.Errorf(codes.Internal, "the package repository has a fetch directive that is not supported")
 	}
@@ -829,17 +944,23 @@ func (s *Server) validatePackageRepositoryUpdate(ctx context.Context, cluster st
 	if _, err := pkgutils.ToDuration(request.Interval); err != nil {
 		return status.Errorf(codes.InvalidArgument, "invalid interval: %v", err)
 	}
-	if request.Url == "" {
-		return status.Errorf(codes.InvalidArgument, "no request Url provided")
-	}
-	if request.Auth != nil {
-		if err := s.validatePackageRepositoryAuth(ctx, cluster, pkgRepository.GetNamespace(), rptype, request.Auth, pkgRepository, pkgSecret); err != nil {
+	if rptype == Type_Inline {
+		if err := s.validatePackageRepositoryInline(ctx, cluster, pkgRepository.GetNamespace(), request.Url, request.Auth, request.CustomDetail); err != nil {
 			return err
 		}
-	}
-	if request.CustomDetail != nil {
-		if err := s.validatePackageRepositoryDetails(rptype, request.CustomDetail); err != nil {
-			return err
+	} else {
+		if request.Url == "" {
+			return status.Errorf(codes.InvalidArgument, "no request Url provided")
+		}
+		if request.Auth != nil {
+			if err := s.validatePackageRepositoryAuth(ctx, cluster, pkgRepository.GetNamespace(), rptype, request.Auth, pkgRepository, pkgSecret); err != nil {
+				return err
+			}
+		}
+		if request.CustomDetail != nil {
+			if err := s.validatePackageRepositoryDetails(rptype, request.CustomDetail); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -887,6 +1008,60 @@ func (s *Server) validatePackageRepositoryDetails(rptype string, any *anypb.Any)
 	return nil
 }
 
+// validatePackageRepositoryInline validates the content of an inline repository: it has no url
+// nor auth, and its sources are provided through the custom details, with any referenced
+// Secret or ConfigMap existing in the repository namespace.
+func (s *Server) validatePackageRepositoryInline(ctx context.Context, cluster, namespace, url string, auth *corev1.PackageRepositoryAuth, any *anypb.Any) error {
+	if url != "" {
+		return status.Errorf(codes.InvalidArgument, "Url is not supported for inline repositories")
+	}
+	if auth != nil {
+		return status.Errorf(codes.InvalidArgument, "Auth is not supported for inline repositories")
+	}
+	if any == nil {
+		return status.Errorf(codes.InvalidArgument, "inline repositories require the inline content in the custom details")
+	}
+	if err := s.validatePackageRepositoryDetails(Type_Inline, any); err != nil {
+		return err
+	}
+
+	details := &kappcorev1.PackageRepositoryCustomDetail{}
+	if err := any.UnmarshalTo(details); err != nil {
+		return status.Errorf(codes.InvalidArgument, "custom details are invalid: %v", err)
+	}
+	inline := details.GetFetch().GetInline()
+	if len(inline.GetPaths()) == 0 && len(inline.GetPathsFrom()) == 0 {
+		return status.Errorf(codes.InvalidArgument, "inline repositories require at least one path or path source")
+	}
+	for _, pf := range inline.GetPathsFrom() {
+		switch {
+		case pf.GetSecretRef() != nil && pf.GetConfigMapRef() != nil:
+			return status.Errorf(codes.InvalidArgument, "invalid inline source, only one of secret or configmap can be referenced")
+		case pf.GetSecretRef() != nil:
+			name := pf.GetSecretRef().GetName()
+			if name == "" {
+				return status.Errorf(codes.InvalidArgument, "invalid inline source, the secret name is not provided")
+			}
+			if _, err := s.getSecret(ctx, cluster, namespace, name); err != nil {
+				err = statuserror.FromK8sError("get", "Secret", name, err)
+				return status.Errorf(codes.InvalidArgument, "invalid inline source, the secret could not be accessed: %v", err)
+			}
+		case pf.GetConfigMapRef() != nil:
+			name := pf.GetConfigMapRef().GetName()
+			if name == "" {
+				return status.Errorf(codes.InvalidArgument, "invalid inline source, the configmap name is not provided")
+			}
+			if _, err := s.getConfigMap(ctx, cluster, namespace, name); err != nil {
+				err = statuserror.FromK8sError("get", "ConfigMap", name, err)
+				return status.Errorf(codes.InvalidArgument, "invalid inline source, the configmap could not be accessed: %v", err)
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid inline source, a secret or configmap must be referenced")
+		}
+	}
+	return nil
+}
+
 func (s *Server) validatePackageRepositoryAuth(ctx context.Context, cluster, namespace string, rptype string, auth *corev1.PackageRepositoryAuth, pkgRepository *packagingv1alpha1.PackageRepository, pkgSecret *k8scorev1.Secret) error {
 	// validate type compatibility
 	switch rptype {
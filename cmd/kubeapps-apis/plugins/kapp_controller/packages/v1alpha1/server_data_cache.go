@@ -0,0 +1,321 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	packagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/packaging/v1alpha1"
+	datapackagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apiserver/apis/datapackaging/v1alpha1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	// resourceCacheResyncPeriod is the period after which the informers
+	// re-process all the cached resources
+	resourceCacheResyncPeriod = 10 * time.Minute
+	// resourceCacheSyncTimeout is the maximum time a request waits for the
+	// initial list of an informer before using the API server instead
+	resourceCacheSyncTimeout = 30 * time.Second
+	// resourceCacheIdleTimeout is the time after which an informer not used
+	// by any request is stopped and removed from the cache
+	resourceCacheIdleTimeout = 30 * time.Minute
+	// resourceCacheEvictionPeriod is the period of the checks for idle informers
+	resourceCacheEvictionPeriod = time.Minute
+	// nameIndex indexes the cached resources by their name
+	nameIndex = "metadata.name"
+	// refNameIndex indexes the cached packages by the name of their metadata
+	refNameIndex = "spec.refName"
+)
+
+var (
+	pkgsGvr            = datapackagingv1alpha1.SchemeGroupVersion.WithResource(pkgsResource)
+	pkgMetadatasGvr    = datapackagingv1alpha1.SchemeGroupVersion.WithResource(pkgMetadatasResource)
+	pkgRepositoriesGvr = packagingv1alpha1.SchemeGroupVersion.WithResource(pkgRepositoriesResource)
+	namespacesGvr      = k8scorev1.SchemeGroupVersion.WithResource("namespaces")
+)
+
+// resourceCacheKey identifies the informer of a resource in a cluster and
+// namespace, where an empty namespace means all the namespaces.
+type resourceCacheKey struct {
+	cluster   string
+	namespace string
+	gvr       schema.GroupVersionResource
+}
+
+// resourceInformer is an informer together with the last error of its
+// list and watch, if any, and the time it was last used.
+type resourceInformer struct {
+	informer cache.SharedIndexInformer
+	// stopCh stops the informer when it is evicted from the cache
+	stopCh chan struct{}
+	// lastUsed is guarded by the mutex of the cache
+	lastUsed time.Time
+	mu       sync.Mutex
+	err      error
+	// errSynced and errResourceVersion are whether the informer had synced and
+	// the resource version last synced when the error happened, so that the
+	// error is cleared by a later successful list
+	errSynced          bool
+	errResourceVersion string
+}
+
+// resourceCache is a shared, watch-driven cache of the Package,
+// PackageMetadata and PackageRepository resources of each cluster and
+// namespace requested. The informers are started on first use with the
+// credentials of the plugin rather than those of the user, so each request
+// must check that the user has access to the resources before using the
+// cache. Informers not used for a while are stopped, and all of them once
+// the stop channel is closed.
+type resourceCache struct {
+	// clientGetter returns the client of the plugin for a cluster
+	clientGetter func(ctx context.Context, cluster string) (dynamic.Interface, error)
+	mu           sync.Mutex
+	informers    map[resourceCacheKey]*resourceInformer
+	stopped      bool
+}
+
+func newResourceCache(clientGetter func(ctx context.Context, cluster string) (dynamic.Interface, error), stopCh <-chan struct{}) *resourceCache {
+	c := &resourceCache{
+		clientGetter: clientGetter,
+		informers:    map[resourceCacheKey]*resourceInformer{},
+	}
+	go func() {
+		wait.Until(func() {
+			c.evictIdle(time.Now().Add(-resourceCacheIdleTimeout))
+		}, resourceCacheEvictionPeriod, stopCh)
+		c.stop()
+	}()
+	return c
+}
+
+// informerFor returns the synced informer for the key, starting it if needed.
+// An error is returned when the informer cannot be started or synced, in which
+// case the resources must be requested from the API server instead. Informers
+// are only started for existing namespaces, as the namespace of the key comes
+// from the request.
+func (c *resourceCache) informerFor(ctx context.Context, key resourceCacheKey) (cache.SharedIndexInformer, error) {
+	c.mu.Lock()
+	if c.stopped {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("the cache is stopped")
+	}
+	ri, ok := c.informers[key]
+	if !ok {
+		client, err := c.clientGetter(ctx, key.cluster)
+		if err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+		if key.namespace != "" {
+			if _, err := client.Resource(namespacesGvr).Get(ctx, key.namespace, metav1.GetOptions{}); err != nil {
+				c.mu.Unlock()
+				return nil, err
+			}
+		}
+		indexers := cache.Indexers{nameIndex: nameIndexFunc}
+		if key.gvr == pkgsGvr {
+			indexers[refNameIndex] = refNameIndexFunc
+		}
+		ri = &resourceInformer{
+			informer: dynamicinformer.NewFilteredDynamicInformer(client, key.gvr, key.namespace, resourceCacheResyncPeriod, indexers, nil).Informer(),
+			stopCh:   make(chan struct{}),
+		}
+		if err := ri.informer.SetWatchErrorHandler(ri.onWatchError); err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+		c.informers[key] = ri
+		go ri.informer.Run(ri.stopCh)
+	}
+	ri.lastUsed = time.Now()
+	c.mu.Unlock()
+
+	if err := ri.waitForSync(ctx); err != nil {
+		return nil, err
+	}
+	return ri.informer, nil
+}
+
+// evictIdle stops and removes the informers not used since the given time.
+func (c *resourceCache) evictIdle(since time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, ri := range c.informers {
+		if ri.lastUsed.Before(since) {
+			close(ri.stopCh)
+			delete(c.informers, key)
+		}
+	}
+}
+
+// stop stops and removes all the informers, without starting new ones.
+func (c *resourceCache) stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, ri := range c.informers {
+		close(ri.stopCh)
+		delete(c.informers, key)
+	}
+	c.stopped = true
+}
+
+// onWatchError records the error of the list and watch of the informer, which
+// is retried by the informer itself.
+func (ri *resourceInformer) onWatchError(r *cache.Reflector, err error) {
+	cache.DefaultWatchErrorHandler(r, err)
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+	ri.err = err
+	ri.errSynced = ri.informer.HasSynced()
+	ri.errResourceVersion = r.LastSyncResourceVersion()
+}
+
+// lastError returns the last error of the list and watch of the informer,
+// unless the informer has synced for the first time or synced a newer
+// resource version since then.
+func (ri *resourceInformer) lastError() error {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+	if ri.err != nil && ((!ri.errSynced && ri.informer.HasSynced()) || ri.informer.LastSyncResourceVersion() != ri.errResourceVersion) {
+		ri.err = nil
+	}
+	return ri.err
+}
+
+// waitForSync waits for the initial list of the informer, returning early if
+// the list failed, the request ended or it took too long.
+func (ri *resourceInformer) waitForSync(ctx context.Context) error {
+	if ri.informer.HasSynced() {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, resourceCacheSyncTimeout)
+	defer cancel()
+	return wait.PollImmediateUntil(100*time.Millisecond, func() (bool, error) {
+		if ri.informer.HasSynced() {
+			return true, nil
+		}
+		return false, ri.lastError()
+	}, ctx.Done())
+}
+
+// list returns the cached resources of the cluster and namespace, optionally
+// only those with one of the given values for an index, sorted by namespace and
+// name as the API server does. The returned resources are shared with the cache
+// and must not be modified.
+func (c *resourceCache) list(ctx context.Context, cluster, namespace string, gvr schema.GroupVersionResource, index string, values ...string) ([]unstructured.Unstructured, error) {
+	informer, err := c.informerFor(ctx, resourceCacheKey{cluster: cluster, namespace: namespace, gvr: gvr})
+	if err != nil {
+		return nil, err
+	}
+	var objs []interface{}
+	if index == "" {
+		objs = informer.GetIndexer().List()
+	} else {
+		for _, value := range values {
+			indexed, err := informer.GetIndexer().ByIndex(index, value)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, indexed...)
+		}
+	}
+	items := make([]unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		if item, ok := obj.(*unstructured.Unstructured); ok {
+			items = append(items, *item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].GetNamespace() != items[j].GetNamespace() {
+			return items[i].GetNamespace() < items[j].GetNamespace()
+		}
+		return items[i].GetName() < items[j].GetName()
+	})
+	return items, nil
+}
+
+func nameIndexFunc(obj interface{}) ([]string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	return []string{accessor.GetName()}, nil
+}
+
+func refNameIndexFunc(obj interface{}) ([]string, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object of type %T", obj)
+	}
+	refName, found, err := unstructured.NestedString(u.Object, "spec", "refName")
+	if err != nil || !found {
+		return nil, err
+	}
+	return []string{refName}, nil
+}
+
+// listFromCache returns the cached resources of the cluster and namespace,
+// optionally only those with one of the given values for an index, once the user is
+// allowed to perform the verb on them. The returned bool is false when the
+// resources must be requested from the API server instead, such as when the
+// plugin has no cache or it cannot be synced with the credentials of the plugin.
+func (s *Server) listFromCache(ctx context.Context, cluster, namespace string, gvr schema.GroupVersionResource, verb, index string, values ...string) ([]unstructured.Unstructured, bool, error) {
+	if s.resourceCache == nil {
+		return nil, false, nil
+	}
+	allowed, err := s.hasAccessTo(ctx, cluster, namespace, gvr, verb)
+	if err != nil {
+		log.Warningf("+kapp-controller unable to check the access to %s in cluster %q and namespace %q, requesting them instead: %v", gvr.Resource, cluster, namespace, err)
+		return nil, false, nil
+	}
+	if !allowed {
+		return nil, true, errors.NewForbidden(gvr.GroupResource(), "", fmt.Errorf("the user is not allowed to %s %s in the namespace %q", verb, gvr.Resource, namespace))
+	}
+	items, err := s.resourceCache.list(ctx, cluster, namespace, gvr, index, values...)
+	if err != nil {
+		log.Warningf("+kapp-controller unable to use the cache of %s in cluster %q and namespace %q, requesting them instead: %v", gvr.Resource, cluster, namespace, err)
+		return nil, false, nil
+	}
+	return items, true, nil
+}
+
+// hasAccessTo returns whether the user of the request is allowed to perform
+// the verb on the resources of the cluster and namespace.
+func (s *Server) hasAccessTo(ctx context.Context, cluster, namespace string, gvr schema.GroupVersionResource, verb string) (bool, error) {
+	typedClient, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return false, err
+	}
+	review, err := typedClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:     gvr.Group,
+				Version:   gvr.Version,
+				Resource:  gvr.Resource,
+				Verb:      verb,
+				Namespace: namespace,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
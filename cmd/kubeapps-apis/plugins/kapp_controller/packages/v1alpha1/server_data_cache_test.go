@@ -0,0 +1,380 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	datapackagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apiserver/apis/datapackaging/v1alpha1"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newCachedPkgMetadata(namespace, name string) *datapackagingv1alpha1.PackageMetadata {
+	return &datapackagingv1alpha1.PackageMetadata{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       pkgMetadataResource,
+			APIVersion: datapackagingAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: datapackagingv1alpha1.PackageMetadataSpec{
+			DisplayName:      name,
+			IconSVGBase64:    "Tm90IHJlYWxseSBTVkcK",
+			ShortDescription: "A short description",
+		},
+	}
+}
+
+func newCachedPkg(namespace, refName, version string) *datapackagingv1alpha1.Package {
+	return &datapackagingv1alpha1.Package{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       pkgResource,
+			APIVersion: datapackagingAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      fmt.Sprintf("%s.%s", refName, version),
+		},
+		Spec: datapackagingv1alpha1.PackageSpec{
+			RefName: refName,
+			Version: version,
+		},
+	}
+}
+
+// newCacheDynamicClient returns a dynamic client with the given objects and
+// the namespaces they are in, as well as the default one.
+func newCacheDynamicClient(t *testing.T, objects ...k8sruntime.Object) *dynfake.FakeDynamicClient {
+	var unstructuredObjects []k8sruntime.Object
+	namespaces := map[string]bool{"default": true}
+	for _, obj := range objects {
+		unstructuredContent, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		unstructuredObject := &unstructured.Unstructured{Object: unstructuredContent}
+		unstructuredObjects = append(unstructuredObjects, unstructuredObject)
+		namespaces[unstructuredObject.GetNamespace()] = true
+	}
+	for namespace := range namespaces {
+		unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]interface{}{"name": namespace},
+		}})
+	}
+	return dynfake.NewSimpleDynamicClientWithCustomListKinds(
+		k8sruntime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			pkgsGvr:            pkgResource + "List",
+			pkgMetadatasGvr:    pkgMetadataResource + "List",
+			pkgRepositoriesGvr: pkgRepositoryResource + "List",
+			namespacesGvr:      "NamespaceList",
+		},
+		unstructuredObjects...,
+	)
+}
+
+// newCachedServer returns a server whose cache uses the plugin client, while
+// the user client is only allowed to perform the access reviews.
+func newCachedServer(t *testing.T, pluginClient dynamic.Interface, allowed bool) (*Server, *dynfake.FakeDynamicClient) {
+	userDynamicClient := newCacheDynamicClient(t)
+	userTypedClient := typfake.NewSimpleClientset()
+	userTypedClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = allowed
+		return true, review, nil
+	})
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	return &Server{
+		pluginConfig:             defaultPluginConfig,
+		globalPackagingCluster:   "default",
+		globalPackagingNamespace: globalPackagingNamespace,
+		clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+			return clientgetter.NewBuilder().
+				WithTyped(userTypedClient).
+				WithDynamic(userDynamicClient).
+				Build(), nil
+		},
+		resourceCache: newResourceCache(func(ctx context.Context, cluster string) (dynamic.Interface, error) {
+			if pluginClient == nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "cluster [%s] has no service token configured", cluster)
+			}
+			return pluginClient, nil
+		}, stopCh),
+	}, userDynamicClient
+}
+
+func pkgMetadataNames(pkgMetadatas []*datapackagingv1alpha1.PackageMetadata) []string {
+	names := []string{}
+	for _, pkgMetadata := range pkgMetadatas {
+		names = append(names, pkgMetadata.Name)
+	}
+	return names
+}
+
+func TestGetPkgMetadatasFromCache(t *testing.T) {
+	pluginClient := newCacheDynamicClient(t,
+		newCachedPkgMetadata("default", "tetris.foo.example.com"),
+		newCachedPkgMetadata("default", "chess.foo.example.com"),
+		newCachedPkgMetadata("other", "go.foo.example.com"),
+	)
+	s, _ := newCachedServer(t, pluginClient, true)
+
+	pkgMetadatas, err := s.getPkgMetadatas(context.Background(), "default", "default")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := pkgMetadataNames(pkgMetadatas), []string{"chess.foo.example.com", "tetris.foo.example.com"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// The changes are watched rather than requested again.
+	unstructuredContent, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(newCachedPkgMetadata("default", "arcade.foo.example.com"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if _, err := pluginClient.Resource(pkgMetadatasGvr).Namespace("default").Create(context.Background(), &unstructured.Unstructured{Object: unstructuredContent}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	want := []string{"arcade.foo.example.com", "chess.foo.example.com", "tetris.foo.example.com"}
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		pkgMetadatas, err = s.getPkgMetadatas(context.Background(), "default", "default")
+		return err == nil && cmp.Equal(pkgMetadataNames(pkgMetadatas), want), err
+	})
+	if err != nil {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, pkgMetadataNames(pkgMetadatas)))
+	}
+
+	pkgMetadata, err := s.getPkgMetadata(context.Background(), "default", "default", "tetris.foo.example.com")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := pkgMetadata.Spec.DisplayName, "tetris.foo.example.com"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if _, err := s.getPkgMetadata(context.Background(), "default", "default", "go.foo.example.com"); !errors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got: %+v", err)
+	}
+}
+
+func TestGetPkgsWithFieldSelectorFromCache(t *testing.T) {
+	pluginClient := newCacheDynamicClient(t,
+		newCachedPkg("default", "tetris.foo.example.com", "1.2.3"),
+		newCachedPkg("default", "tetris.foo.example.com", "1.2.4"),
+		newCachedPkg("default", "chess.foo.example.com", "1.0.0"),
+	)
+	s, _ := newCachedServer(t, pluginClient, true)
+
+	pkgs, err := s.getPkgsWithFieldSelector(context.Background(), "default", "default", "spec.refName=tetris.foo.example.com")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var versions []string
+	for _, pkg := range pkgs {
+		versions = append(versions, pkg.Spec.Version)
+	}
+	if got, want := versions, []string{"1.2.3", "1.2.4"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestGetPkgsByRefNameFromCache(t *testing.T) {
+	pluginClient := newCacheDynamicClient(t,
+		newCachedPkg("default", "tetris.foo.example.com", "1.2.3"),
+		newCachedPkg("default", "tetris.foo.example.com", "1.2.4"),
+		newCachedPkg("default", "chess.foo.example.com", "1.0.0"),
+		newCachedPkg("default", "go.foo.example.com", "2.0.0"),
+	)
+	s, _ := newCachedServer(t, pluginClient, true)
+
+	pkgs, err := s.getPkgsByRefName(context.Background(), "default", "default", []string{"tetris.foo.example.com", "go.foo.example.com"})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	versions := map[string][]string{}
+	for refName, pkgsForRefName := range pkgs {
+		for _, pkg := range pkgsForRefName {
+			versions[refName] = append(versions[refName], pkg.Spec.Version)
+		}
+	}
+	want := map[string][]string{
+		"tetris.foo.example.com": {"1.2.3", "1.2.4"},
+		"go.foo.example.com":     {"2.0.0"},
+	}
+	if got := versions; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestGetPkgMetadatasWithoutAccess(t *testing.T) {
+	pluginClient := newCacheDynamicClient(t, newCachedPkgMetadata("default", "tetris.foo.example.com"))
+	s, _ := newCachedServer(t, pluginClient, false)
+
+	_, err := s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+		Context:       &corev1.Context{Cluster: "default", Namespace: "default"},
+		FilterOptions: &corev1.FilterOptions{},
+	})
+
+	if got, want := status.Code(err), codes.PermissionDenied; got != want {
+		t.Errorf("got: %v, want: %v, err: %+v", got, want, err)
+	}
+}
+
+func TestGetPkgMetadatasWithoutCache(t *testing.T) {
+	// The cache cannot be used without a client of the plugin for the cluster,
+	// so the package metadatas are requested with the client of the user.
+	s, userDynamicClient := newCachedServer(t, nil, true)
+	unstructuredContent, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(newCachedPkgMetadata("default", "tetris.foo.example.com"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if _, err := userDynamicClient.Resource(pkgMetadatasGvr).Namespace("default").Create(context.Background(), &unstructured.Unstructured{Object: unstructuredContent}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	pkgMetadatas, err := s.getPkgMetadatas(context.Background(), "other", "default")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if got, want := pkgMetadataNames(pkgMetadatas), []string{"tetris.foo.example.com"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestGetAvailablePackageSummariesFromCache(t *testing.T) {
+	pluginClient := newCacheDynamicClient(t,
+		newCachedPkgMetadata("default", "tetris.foo.example.com"),
+		newCachedPkgMetadata("default", "chess.foo.example.com"),
+		newCachedPkg("default", "chess.foo.example.com", "1.0.0"),
+		newCachedPkg("default", "tetris.foo.example.com", "1.2.3"),
+		newCachedPkg("default", "tetris.foo.example.com", "1.2.4"),
+	)
+	s, _ := newCachedServer(t, pluginClient, true)
+
+	var identifiers []string
+	pageToken := ""
+	for {
+		response, err := s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+			Context:           &corev1.Context{Cluster: "default", Namespace: "default"},
+			FilterOptions:     &corev1.FilterOptions{},
+			PaginationOptions: &corev1.PaginationOptions{PageSize: 1, PageToken: pageToken},
+		})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		for _, summary := range response.GetAvailablePackageSummaries() {
+			identifiers = append(identifiers, fmt.Sprintf("%s@%s", summary.GetAvailablePackageRef().GetIdentifier(), summary.GetLatestVersion().GetPkgVersion()))
+		}
+		if pageToken = response.GetNextPageToken(); pageToken == "" || len(identifiers) > 2 {
+			break
+		}
+	}
+	if got, want := identifiers, []string{"unknown/chess.foo.example.com@1.0.0", "unknown/tetris.foo.example.com@1.2.4"}; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestResourceCacheEvictsIdleInformers(t *testing.T) {
+	pluginClient := newCacheDynamicClient(t, newCachedPkgMetadata("default", "tetris.foo.example.com"))
+	s, _ := newCachedServer(t, pluginClient, true)
+
+	if _, err := s.getPkgMetadatas(context.Background(), "default", "default"); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	key := resourceCacheKey{cluster: "default", namespace: "default", gvr: pkgMetadatasGvr}
+	ri, ok := s.resourceCache.informers[key]
+	if !ok {
+		t.Fatalf("expected an informer for %+v", key)
+	}
+
+	s.resourceCache.evictIdle(time.Now().Add(-time.Minute))
+	if _, ok := s.resourceCache.informers[key]; !ok {
+		t.Errorf("expected the informer used recently to be kept")
+	}
+
+	s.resourceCache.evictIdle(time.Now().Add(time.Minute))
+	if _, ok := s.resourceCache.informers[key]; ok {
+		t.Errorf("expected the idle informer to be evicted")
+	}
+	select {
+	case <-ri.stopCh:
+	default:
+		t.Errorf("expected the idle informer to be stopped")
+	}
+}
+
+func TestResourceCacheIgnoresMissingNamespaces(t *testing.T) {
+	pluginClient := newCacheDynamicClient(t, newCachedPkgMetadata("default", "tetris.foo.example.com"))
+	s, _ := newCachedServer(t, pluginClient, true)
+
+	_, cached, err := s.listFromCache(context.Background(), "default", "missing", pkgMetadatasGvr, "list", "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if cached {
+		t.Errorf("expected the resources of a missing namespace to be requested instead")
+	}
+	if got, want := len(s.resourceCache.informers), 0; got != want {
+		t.Errorf("got: %d informers, want: %d", got, want)
+	}
+}
+
+func TestResourceCacheClearsListErrors(t *testing.T) {
+	pluginClient := newCacheDynamicClient(t, newCachedPkgMetadata("default", "tetris.foo.example.com"))
+	failures := 1
+	pluginClient.PrependReactor("list", pkgMetadatasResource, func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		if failures > 0 {
+			failures--
+			return true, nil, errors.NewServiceUnavailable("not yet")
+		}
+		return false, nil, nil
+	})
+	s, _ := newCachedServer(t, pluginClient, true)
+
+	// The failed initial list is reported, so the API server is used instead.
+	_, cached, err := s.listFromCache(context.Background(), "default", "default", pkgMetadatasGvr, "list", "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if cached {
+		t.Errorf("expected the resources to be requested while the cache cannot be synced")
+	}
+
+	// Once the informer lists them successfully, the error is cleared.
+	ri := s.resourceCache.informers[resourceCacheKey{cluster: "default", namespace: "default", gvr: pkgMetadatasGvr}]
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return ri.informer.HasSynced(), nil
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := ri.lastError(); err != nil {
+		t.Errorf("expected the error to be cleared, got: %+v", err)
+	}
+	if _, cached, err = s.listFromCache(context.Background(), "default", "default", pkgMetadatasGvr, "list", ""); err != nil || !cached {
+		t.Errorf("expected the resources to be cached, got: %t, %+v", cached, err)
+	}
+}
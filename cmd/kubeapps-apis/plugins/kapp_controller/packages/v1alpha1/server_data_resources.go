@@ -15,12 +15,16 @@ import (
 	packagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/packaging/v1alpha1"
 	datapackagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apiserver/apis/datapackaging/v1alpha1"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/k8sutils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 )
 
@@ -133,6 +137,20 @@ func (s *Server) getPkg(ctx context.Context, cluster, namespace, identifier stri
 // getPkgMetadata returns the package metadata for the given cluster, namespace and identifier
 func (s *Server) getPkgMetadata(ctx context.Context, cluster, namespace, identifier string) (*datapackagingv1alpha1.PackageMetadata, error) {
 	var pkgMetadata datapackagingv1alpha1.PackageMetadata
+	items, cached, err := s.listFromCache(ctx, cluster, namespace, pkgMetadatasGvr, "get", nameIndex, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if cached {
+		if len(items) == 0 {
+			return nil, errors.NewNotFound(pkgMetadatasGvr.GroupResource(), identifier)
+		}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(items[0].Object, &pkgMetadata)
+		if err != nil {
+			return nil, err
+		}
+		return &pkgMetadata, nil
+	}
 	resource, err := s.getPkgMetadataResource(ctx, cluster, namespace)
 	if err != nil {
 		return nil, err
@@ -215,54 +233,97 @@ func (s *Server) getSecret(ctx context.Context, cluster, namespace, name string)
 	return secret, nil
 }
 
-//  List of resources getters
-
-// getPkgs requests the packages for the given cluster and namespace and sends
-// them to the channel to be processed immediately, closing the channel
-// when finished or when an error is returned.
-func (s *Server) getPkgs(ctx context.Context, cluster, namespace string, ch chan<- *datapackagingv1alpha1.Package) error {
-	defer close(ch)
-	resource, err := s.getPkgResource(ctx, cluster, namespace)
+// get ConfigMap
+func (s *Server) getConfigMap(ctx context.Context, cluster, namespace, name string) (*k8scorev1.ConfigMap, error) {
+	client, _, err := s.GetClients(ctx, cluster)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	configMap, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
 	}
+	return configMap, nil
+}
 
-	unstructured, err := resource.List(ctx, metav1.ListOptions{})
+//  List of resources getters
+
+// getPkgsByRefName returns the packages for the given cluster and namespace
+// referring to any of the given package metadata names, grouped by refName.
+// Only the requested packages are read from the cache, using its refName
+// index, while without cache all the packages are requested and filtered.
+func (s *Server) getPkgsByRefName(ctx context.Context, cluster, namespace string, refNames []string) (map[string][]*datapackagingv1alpha1.Package, error) {
+	pkgs := map[string][]*datapackagingv1alpha1.Package{}
+	if len(refNames) == 0 {
+		return pkgs, nil
+	}
+	items, cached, err := s.listFromCache(ctx, cluster, namespace, pkgsGvr, "list", refNameIndex, refNames...)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if !cached {
+		resource, err := s.getPkgResource(ctx, cluster, namespace)
+		if err != nil {
+			return nil, err
+		}
+		list, err := resource.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		items = list.Items
 	}
 
-	for _, unstructured := range unstructured.Items {
+	wanted := make(map[string]bool, len(refNames))
+	for _, refName := range refNames {
+		wanted[refName] = true
+	}
+	for _, unstructured := range items {
 		pkg := &datapackagingv1alpha1.Package{}
 		err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured.Object, pkg)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if wanted[pkg.Spec.RefName] {
+			pkgs[pkg.Spec.RefName] = append(pkgs[pkg.Spec.RefName], pkg)
 		}
-
-		ch <- pkg
 	}
-	return nil
+	return pkgs, nil
 }
 
-// getPkgs returns the list of packages for the given cluster and namespace
+// getPkgsWithFieldSelector returns the list of packages for the given cluster and namespace
+// matching the field selector. The cache is only used when the selector is empty or only
+// selects the spec.refName, since it is indexed by it.
 func (s *Server) getPkgsWithFieldSelector(ctx context.Context, cluster, namespace, fieldSelector string) ([]*datapackagingv1alpha1.Package, error) {
-	resource, err := s.getPkgResource(ctx, cluster, namespace)
-	if err != nil {
-		return nil, err
-	}
-	listOptions := metav1.ListOptions{}
-	if fieldSelector != "" {
-		listOptions.FieldSelector = fieldSelector
+	var items []unstructured.Unstructured
+	cached := false
+	if selector, err := fields.ParseSelector(fieldSelector); err == nil {
+		if refName, ok := selector.RequiresExactMatch(refNameIndex); ok && len(selector.Requirements()) == 1 {
+			items, cached, err = s.listFromCache(ctx, cluster, namespace, pkgsGvr, "list", refNameIndex, refName)
+		} else if selector.Empty() {
+			items, cached, err = s.listFromCache(ctx, cluster, namespace, pkgsGvr, "list", "")
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
-	// TODO(agamez): this function takes way too long (1-2 seconds!). Try to reduce it
-	// More context at: https://github.com/vmware-tanzu/kubeapps/pull/3784#discussion_r756259504
-	unstructured, err := resource.List(ctx, listOptions)
-	if err != nil {
-		return nil, err
+	if !cached {
+		resource, err := s.getPkgResource(ctx, cluster, namespace)
+		if err != nil {
+			return nil, err
+		}
+		listOptions := metav1.ListOptions{}
+		if fieldSelector != "" {
+			listOptions.FieldSelector = fieldSelector
+		}
+		list, err := resource.List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		items = list.Items
 	}
 
 	var pkgs []*datapackagingv1alpha1.Package
-	for _, unstructured := range unstructured.Items {
+	for _, unstructured := range items {
 		pkg := &datapackagingv1alpha1.Package{}
 		err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured.Object, pkg)
 		if err != nil {
@@ -275,16 +336,23 @@ func (s *Server) getPkgsWithFieldSelector(ctx context.Context, cluster, namespac
 
 // getPkgMetadatas returns the list of package metadatas for the given cluster and namespace
 func (s *Server) getPkgMetadatas(ctx context.Context, cluster, namespace string) ([]*datapackagingv1alpha1.PackageMetadata, error) {
-	resource, err := s.getPkgMetadataResource(ctx, cluster, namespace)
+	items, cached, err := s.listFromCache(ctx, cluster, namespace, pkgMetadatasGvr, "list", "")
 	if err != nil {
 		return nil, err
 	}
-	unstructured, err := resource.List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+	if !cached {
+		resource, err := s.getPkgMetadataResource(ctx, cluster, namespace)
+		if err != nil {
+			return nil, err
+		}
+		list, err := resource.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		items = list.Items
 	}
 	var pkgMetadatas []*datapackagingv1alpha1.PackageMetadata
-	for _, unstructured := range unstructured.Items {
+	for _, unstructured := range items {
 		pkgMetadata := &datapackagingv1alpha1.PackageMetadata{}
 		err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured.Object, pkgMetadata)
 		if err != nil {
@@ -295,13 +363,14 @@ func (s *Server) getPkgMetadatas(ctx context.Context, cluster, namespace string)
 	return pkgMetadatas, nil
 }
 
-// getPkgInstalls returns the list of package installs for the given cluster and namespace
-func (s *Server) getPkgInstalls(ctx context.Context, cluster, namespace string) ([]*packagingv1alpha1.PackageInstall, error) {
+// getPkgInstalls returns the list of package installs for the given cluster and namespace,
+// limited to the given number of them unless it is zero
+func (s *Server) getPkgInstalls(ctx context.Context, cluster, namespace string, limit int64) ([]*packagingv1alpha1.PackageInstall, error) {
 	resource, err := s.getPkgInstallResource(ctx, cluster, namespace)
 	if err != nil {
 		return nil, err
 	}
-	unstructured, err := resource.List(ctx, metav1.ListOptions{})
+	unstructured, err := resource.List(ctx, metav1.ListOptions{Limit: limit})
 	if err != nil {
 		return nil, err
 	}
@@ -317,18 +386,42 @@ func (s *Server) getPkgInstalls(ctx context.Context, cluster, namespace string)
 	return pkgInstalls, nil
 }
 
-// getPkgRepositories returns the list of package repositories for the given cluster and namespace
-func (s *Server) getPkgRepositories(ctx context.Context, cluster, namespace string) ([]*packagingv1alpha1.PackageRepository, error) {
-	resource, err := s.getPkgRepositoryResource(ctx, cluster, namespace)
+// watchPkgInstalls returns a watch for the package installs for the given cluster and namespace,
+// starting with the existing ones, which is re-established whenever the API server closes it
+func (s *Server) watchPkgInstalls(ctx context.Context, cluster, namespace string) (watch.Interface, error) {
+	resource, err := s.getPkgInstallResource(ctx, cluster, namespace)
 	if err != nil {
 		return nil, err
 	}
-	unstructured, err := resource.List(ctx, metav1.ListOptions{})
+	return k8sutils.NewListWatcher(
+		func(options metav1.ListOptions) (runtime.Object, error) {
+			return resource.List(ctx, options)
+		},
+		func(options metav1.ListOptions) (watch.Interface, error) {
+			return resource.Watch(ctx, options)
+		},
+	)
+}
+
+// getPkgRepositories returns the list of package repositories for the given cluster and namespace
+func (s *Server) getPkgRepositories(ctx context.Context, cluster, namespace string) ([]*packagingv1alpha1.PackageRepository, error) {
+	items, cached, err := s.listFromCache(ctx, cluster, namespace, pkgRepositoriesGvr, "list", "")
 	if err != nil {
 		return nil, err
 	}
+	if !cached {
+		resource, err := s.getPkgRepositoryResource(ctx, cluster, namespace)
+		if err != nil {
+			return nil, err
+		}
+		list, err := resource.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		items = list.Items
+	}
 	var pkgRepositories []*packagingv1alpha1.PackageRepository
-	for _, unstructured := range unstructured.Items {
+	for _, unstructured := range items {
 		pkgRepository := &packagingv1alpha1.PackageRepository{}
 		err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured.Object, pkgRepository)
 		if err != nil {
@@ -417,6 +510,60 @@ func (s *Server) createPkgRepository(ctx context.Context, cluster, namespace str
 	return &pkgRepository, nil
 }
 
+// createPkgMetadata creates a package metadata for the given cluster and namespace
+func (s *Server) createPkgMetadata(ctx context.Context, cluster, namespace string, newPkgMetadata *datapackagingv1alpha1.PackageMetadata) (*datapackagingv1alpha1.PackageMetadata, error) {
+	var pkgMetadata datapackagingv1alpha1.PackageMetadata
+	resource, err := s.getPkgMetadataResource(ctx, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	unstructuredPkgMetadataContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newPkgMetadata)
+	if err != nil {
+		return nil, err
+	}
+	unstructuredPkgMetadata := unstructured.Unstructured{}
+	unstructuredPkgMetadata.SetUnstructuredContent(unstructuredPkgMetadataContent)
+
+	unstructuredNewPkgMetadata, err := resource.Create(ctx, &unstructuredPkgMetadata, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredNewPkgMetadata.Object, &pkgMetadata)
+	if err != nil {
+		return nil, err
+	}
+	return &pkgMetadata, nil
+}
+
+// createPkg creates a package for the given cluster and namespace
+func (s *Server) createPkg(ctx context.Context, cluster, namespace string, newPkg *datapackagingv1alpha1.Package) (*datapackagingv1alpha1.Package, error) {
+	var pkg datapackagingv1alpha1.Package
+	resource, err := s.getPkgResource(ctx, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	unstructuredPkgContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newPkg)
+	if err != nil {
+		return nil, err
+	}
+	unstructuredPkg := unstructured.Unstructured{}
+	unstructuredPkg.SetUnstructuredContent(unstructuredPkgContent)
+
+	unstructuredNewPkg, err := resource.Create(ctx, &unstructuredPkg, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredNewPkg.Object, &pkg)
+	if err != nil {
+		return nil, err
+	}
+	return &pkg, nil
+}
+
 // create Secret
 func (s *Server) createSecret(ctx context.Context, cluster string, secret *k8scorev1.Secret) (*k8scorev1.Secret, error) {
 	client, _, err := s.GetClients(ctx, cluster)
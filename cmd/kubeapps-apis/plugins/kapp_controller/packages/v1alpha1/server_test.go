@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"runtime"
 	"sort"
@@ -34,20 +35,27 @@ import (
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	pluginv1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	kappcorev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/kapp_controller/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugin_test"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/clientgetter"
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/pkgutils"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	disfake "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
 	dynfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
 	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"sigs.k8s.io/yaml"
 )
 
@@ -92,6 +100,9 @@ var ignoreUnexported = cmpopts.IgnoreUnexported(
 	corev1.UpdateInstalledPackageResponse{},
 	corev1.VersionReference{},
 	kappControllerPluginParsedConfig{},
+	kappcorev1.ConvertHelmPackageResponse{},
+	kappcorev1.InstalledPackageCustomDetail{},
+	kappcorev1.PackageInstallValuesSecretRef{},
 	pluginv1.Plugin{},
 )
 
@@ -1123,9 +1134,27 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 }
 
 func TestGetAvailablePackageVersions(t *testing.T) {
+	pkgWithVersion := func(version string) *datapackagingv1alpha1.Package {
+		return &datapackagingv1alpha1.Package{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "tetris.foo.example.com." + version,
+			},
+			Spec: datapackagingv1alpha1.PackageSpec{
+				RefName: "tetris.foo.example.com",
+				Version: version,
+			},
+		}
+	}
+
 	testCases := []struct {
 		name               string
 		existingObjects    []k8sruntime.Object
+		pluginConfig       *kappControllerPluginParsedConfig
 		request            *corev1.GetAvailablePackageVersionsRequest
 		expectedStatusCode codes.Code
 		expectedResponse   *corev1.GetAvailablePackageVersionsResponse
@@ -1240,6 +1269,41 @@ func TestGetAvailablePackageVersions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "it limits the package version summary with the configured versions in summary",
+			existingObjects: []k8sruntime.Object{
+				pkgWithVersion("1.2.3"),
+				pkgWithVersion("1.2.4"),
+				pkgWithVersion("1.3.0"),
+				pkgWithVersion("2.0.0"),
+				pkgWithVersion("2.0.1"),
+				pkgWithVersion("3.0.0"),
+			},
+			pluginConfig: &kappControllerPluginParsedConfig{
+				versionsInSummary: pkgutils.VersionsInSummary{Major: 2, Minor: 1, Patch: 1},
+			},
+			request: &corev1.GetAvailablePackageVersionsRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "unknown/tetris.foo.example.com",
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.GetAvailablePackageVersionsResponse{
+				PackageAppVersions: []*corev1.PackageAppVersion{
+					{
+						PkgVersion: "3.0.0",
+						AppVersion: "3.0.0",
+					},
+					{
+						PkgVersion: "2.0.1",
+						AppVersion: "2.0.1",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1250,8 +1314,13 @@ func TestGetAvailablePackageVersions(t *testing.T) {
 				unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: unstructuredContent})
 			}
 
+			pluginConfig := defaultPluginConfig
+			if tc.pluginConfig != nil {
+				pluginConfig = tc.pluginConfig
+			}
+
 			s := Server{
-				pluginConfig: defaultPluginConfig,
+				pluginConfig: pluginConfig,
 				clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
 					return clientgetter.NewBuilder().
 						WithDynamic(dynfake.NewSimpleDynamicClientWithCustomListKinds(
@@ -2807,6 +2876,187 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 	}
 }
 
+// fakeWatchInstalledPackageSummariesServer sends the events of the stream on a channel.
+type fakeWatchInstalledPackageSummariesServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *corev1.WatchInstalledPackageSummariesResponse
+}
+
+func (s *fakeWatchInstalledPackageSummariesServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchInstalledPackageSummariesServer) Send(response *corev1.WatchInstalledPackageSummariesResponse) error {
+	s.responses <- response
+	return nil
+}
+
+func TestWatchInstalledPackageSummaries(t *testing.T) {
+	toUnstructured := func(obj k8sruntime.Object) *unstructured.Unstructured {
+		unstructuredContent, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		return &unstructured.Unstructured{Object: unstructuredContent}
+	}
+	newPkgInstall := func(name, refName, version, resourceVersion string) *unstructured.Unstructured {
+		return toUnstructured(&packagingv1alpha1.PackageInstall{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgInstallResource,
+				APIVersion: packagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "default",
+				Name:            name,
+				ResourceVersion: resourceVersion,
+			},
+			Spec: packagingv1alpha1.PackageInstallSpec{
+				ServiceAccountName: "default",
+				PackageRef: &packagingv1alpha1.PackageRef{
+					RefName: refName,
+					VersionSelection: &vendirversions.VersionSelectionSemver{
+						Constraints: version,
+					},
+				},
+			},
+			Status: packagingv1alpha1.PackageInstallStatus{
+				Version:              version,
+				LastAttemptedVersion: version,
+			},
+		})
+	}
+	type watchEvent struct {
+		EventType  corev1.WatchInstalledPackageSummariesResponse_EventType
+		Identifier string
+		PkgVersion string
+	}
+
+	dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+		k8sruntime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgsResource}:         pkgResource + "List",
+			{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgMetadatasResource}: pkgMetadataResource + "List",
+			{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}:          pkgInstallResource + "List",
+		},
+		toUnstructured(&datapackagingv1alpha1.PackageMetadata{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgMetadataResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "tetris.foo.example.com",
+			},
+			Spec: datapackagingv1alpha1.PackageMetadataSpec{
+				DisplayName: "Classic Tetris",
+			},
+		}),
+		toUnstructured(&datapackagingv1alpha1.Package{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "tetris.foo.example.com.1.2.3",
+			},
+			Spec: datapackagingv1alpha1.PackageSpec{
+				RefName: "tetris.foo.example.com",
+				Version: "1.2.3",
+			},
+		}),
+		newPkgInstall("my-installation", "tetris.foo.example.com", "1.2.3", "1"),
+		// not sent, as there is no package metadata for it
+		newPkgInstall("other-installation", "unknown.foo.example.com", "1.0.0", "1"),
+	)
+	// The fake client does not set the resourceVersion of lists, which is
+	// required to watch the changes since the list.
+	dynamicClient.PrependReactor("list", pkgInstallsResource, func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		listAction := action.(k8stesting.ListActionImpl)
+		obj, err := dynamicClient.Tracker().List(listAction.GetResource(), listAction.GetKind(), listAction.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		obj.(*unstructured.UnstructuredList).SetResourceVersion("1")
+		return true, obj, nil
+	})
+	watcher := watch.NewFakeWithChanSize(10, false)
+	dynamicClient.PrependWatchReactor(pkgInstallsResource, k8stesting.DefaultWatchReactor(watcher, nil))
+
+	s := Server{
+		pluginConfig: defaultPluginConfig,
+		clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+			return clientgetter.NewBuilder().WithDynamic(dynamicClient).Build(), nil
+		},
+		globalPackagingNamespace: globalPackagingNamespace,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeWatchInstalledPackageSummariesServer{
+		ctx:       ctx,
+		responses: make(chan *corev1.WatchInstalledPackageSummariesResponse, 10),
+	}
+	errCh := make(chan error)
+	go func() {
+		errCh <- s.WatchInstalledPackageSummaries(&corev1.WatchInstalledPackageSummariesRequest{
+			Context: defaultContext,
+		}, stream)
+	}()
+
+	nextEvent := func() watchEvent {
+		select {
+		case response := <-stream.responses:
+			return watchEvent{
+				EventType:  response.GetEventType(),
+				Identifier: response.GetInstalledPackageSummary().GetInstalledPackageRef().GetIdentifier(),
+				PkgVersion: response.GetInstalledPackageSummary().GetCurrentVersion().GetPkgVersion(),
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for a watch event")
+			return watchEvent{}
+		}
+	}
+
+	// Only the existing package install with package metadata is sent as added.
+	if got, want := nextEvent(), (watchEvent{corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED, "my-installation", "1.2.3"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// A change of a package install already sent is sent as modified.
+	watcher.Modify(newPkgInstall("my-installation", "tetris.foo.example.com", "1.2.4", "2"))
+	if got, want := nextEvent(), (watchEvent{corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_MODIFIED, "my-installation", "1.2.4"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// A new package install is sent as added.
+	watcher.Add(newPkgInstall("new-installation", "tetris.foo.example.com", "1.2.3", "3"))
+	if got, want := nextEvent(), (watchEvent{corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_ADDED, "new-installation", "1.2.3"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	// The deletion of a package install never sent is not sent.
+	watcher.Delete(newPkgInstall("other-installation", "unknown.foo.example.com", "1.0.0", "4"))
+	watcher.Delete(newPkgInstall("my-installation", "tetris.foo.example.com", "1.2.4", "5"))
+	if got, want := nextEvent(), (watchEvent{EventType: corev1.WatchInstalledPackageSummariesResponse_EVENT_TYPE_DELETED, Identifier: "my-installation"}); !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the watch to end")
+	}
+	if len(stream.responses) > 0 {
+		t.Errorf("unexpected events: %+v", <-stream.responses)
+	}
+}
+
 func TestGetInstalledPackageDetail(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -3770,6 +4020,98 @@ func TestCreateInstalledPackage(t *testing.T) {
 			},
 			expectedStatusCode: codes.Internal,
 		},
+		{
+			name: "create installed package with values not matching the values schema",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+						Cluster:   "default",
+					},
+					Plugin:     &pluginDetail,
+					Identifier: "unknown/tetris.foo.example.com",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.2.3",
+				},
+				Name:   "my-installation",
+				Values: "replicas: foo",
+				TargetContext: &corev1.Context{
+					Namespace: "default",
+					Cluster:   "default",
+				},
+				ReconciliationOptions: &corev1.ReconciliationOptions{
+					ServiceAccountName: "default",
+				},
+			},
+			pluginConfig: &kappControllerPluginParsedConfig{
+				timeoutSeconds:                     1, //to avoid unnecesary test delays
+				defaultUpgradePolicy:               defaultPluginConfig.defaultUpgradePolicy,
+				defaultPrereleasesVersionSelection: defaultPluginConfig.defaultPrereleasesVersionSelection,
+				defaultAllowDowngrades:             defaultPluginConfig.defaultAllowDowngrades,
+			},
+			existingObjects: []k8sruntime.Object{
+				&datapackagingv1alpha1.PackageMetadata{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       pkgMetadataResource,
+						APIVersion: datapackagingAPIVersion,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "tetris.foo.example.com",
+					},
+					Spec: datapackagingv1alpha1.PackageMetadataSpec{
+						DisplayName:        "Classic Tetris",
+						IconSVGBase64:      "Tm90IHJlYWxseSBTVkcK",
+						ShortDescription:   "A great game for arcade gamers",
+						LongDescription:    "A few sentences but not really a readme",
+						Categories:         []string{"logging", "daemon-set"},
+						Maintainers:        []datapackagingv1alpha1.Maintainer{{Name: "person1"}, {Name: "person2"}},
+						SupportDescription: "Some support information",
+						ProviderName:       "Tetris inc.",
+					},
+				},
+				&datapackagingv1alpha1.Package{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       pkgResource,
+						APIVersion: datapackagingAPIVersion,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "tetris.foo.example.com.1.2.3",
+					},
+					Spec: datapackagingv1alpha1.PackageSpec{
+						RefName:                         "tetris.foo.example.com",
+						Version:                         "1.2.3",
+						Licenses:                        []string{"my-license"},
+						ReleaseNotes:                    "release notes",
+						CapactiyRequirementsDescription: "capacity description",
+						ReleasedAt:                      metav1.Time{time.Date(1984, time.June, 6, 0, 0, 0, 0, time.UTC)},
+						ValuesSchema: datapackagingv1alpha1.ValuesSchema{
+							OpenAPIv3: k8sruntime.RawExtension{
+								Raw: []byte(`{"type":"object","properties":{"replicas":{"type":"integer","default":1}}}`),
+							},
+						},
+					},
+				},
+			},
+			existingTypedObjects: []k8sruntime.Object{
+				&k8scorev1.ConfigMap{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "ConfigMap",
+						APIVersion: "v1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-installation-ctrl",
+					},
+					Data: map[string]string{
+						"spec": "{\"labelKey\":\"kapp.k14s.io/app\",\"labelValue\":\"my-id\"}",
+					},
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
 		{
 			name: "create installed package (with values)",
 			request: &corev1.CreateInstalledPackageRequest{
@@ -5514,6 +5856,212 @@ func TestCreateInstalledPackage(t *testing.T) {
 	}
 }
 
+func TestCreateInstalledPackageSecretOwnerReferenceFailure(t *testing.T) {
+	objects := []k8sruntime.Object{
+		&datapackagingv1alpha1.PackageMetadata{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgMetadataResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "tetris.foo.example.com",
+			},
+			Spec: datapackagingv1alpha1.PackageMetadataSpec{
+				DisplayName: "Classic Tetris",
+			},
+		},
+		&datapackagingv1alpha1.Package{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "tetris.foo.example.com.1.2.3",
+			},
+			Spec: datapackagingv1alpha1.PackageSpec{
+				RefName: "tetris.foo.example.com",
+				Version: "1.2.3",
+			},
+		},
+	}
+	var unstructuredObjects []k8sruntime.Object
+	for _, obj := range objects {
+		unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: unstructuredContent})
+	}
+	dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+		k8sruntime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgsResource}:         pkgResource + "List",
+			{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgMetadatasResource}: pkgMetadataResource + "List",
+			{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}:          pkgInstallResource + "List",
+		},
+		unstructuredObjects...,
+	)
+	typedClient := typfake.NewSimpleClientset()
+	// the owner reference cannot be set on the created secret
+	typedClient.PrependReactor("update", "secrets", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		return true, nil, errors.NewForbidden(k8scorev1.Resource("secrets"), "my-installation-default-values", fmt.Errorf("not allowed"))
+	})
+
+	s := Server{
+		pluginConfig:           defaultPluginConfig,
+		globalPackagingCluster: "default",
+		clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+			return clientgetter.NewBuilder().
+				WithTyped(typedClient).
+				WithDynamic(dynamicClient).
+				Build(), nil
+		},
+	}
+
+	_, err := s.CreateInstalledPackage(context.Background(), &corev1.CreateInstalledPackageRequest{
+		AvailablePackageRef: &corev1.AvailablePackageReference{
+			Context:    defaultContext,
+			Plugin:     &pluginDetail,
+			Identifier: "unknown/tetris.foo.example.com",
+		},
+		PkgVersionReference: &corev1.VersionReference{Version: "1.2.3"},
+		Name:                "my-installation",
+		TargetContext:       defaultContext,
+		ReconciliationOptions: &corev1.ReconciliationOptions{
+			ServiceAccountName: "default",
+		},
+	})
+
+	if got, want := status.Code(err), codes.PermissionDenied; got != want {
+		t.Fatalf("got: %v, want: %v, err: %+v", got, want, err)
+	}
+	if _, err := s.getPkgInstall(context.Background(), "default", "default", "my-installation"); !errors.IsNotFound(err) {
+		t.Errorf("expected the PackageInstall to be deleted, got: %+v", err)
+	}
+	if _, err := typedClient.CoreV1().Secrets("default").Get(context.Background(), "my-installation-default-values", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("expected the values secret to be deleted, got: %+v", err)
+	}
+}
+
+func TestCreateInstalledPackageInOtherCluster(t *testing.T) {
+	targetCluster := "other"
+	otherClusterObjects := []k8sruntime.Object{
+		&datapackagingv1alpha1.PackageMetadata{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgMetadataResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "tetris.foo.example.com",
+			},
+			Spec: datapackagingv1alpha1.PackageMetadataSpec{
+				DisplayName: "Classic Tetris",
+			},
+		},
+		&datapackagingv1alpha1.Package{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgResource,
+				APIVersion: datapackagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "tetris.foo.example.com.1.2.3",
+			},
+			Spec: datapackagingv1alpha1.PackageSpec{
+				RefName: "tetris.foo.example.com",
+				Version: "1.2.3",
+			},
+		},
+		&kappctrlv1alpha1.App{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       appResource,
+				APIVersion: kappctrlAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "my-installation",
+			},
+		},
+	}
+
+	newDynamicClient := func(objects []k8sruntime.Object) *dynfake.FakeDynamicClient {
+		var unstructuredObjects []k8sruntime.Object
+		for _, obj := range objects {
+			unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+			unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: unstructuredContent})
+		}
+		return dynfake.NewSimpleDynamicClientWithCustomListKinds(
+			k8sruntime.NewScheme(),
+			map[schema.GroupVersionResource]string{
+				{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgsResource}:         pkgResource + "List",
+				{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgMetadatasResource}: pkgMetadataResource + "List",
+				{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}:          pkgInstallResource + "List",
+			},
+			unstructuredObjects...,
+		)
+	}
+	dynamicClients := map[string]*dynfake.FakeDynamicClient{
+		"default":     newDynamicClient(nil),
+		targetCluster: newDynamicClient(otherClusterObjects),
+	}
+	typedClients := map[string]*typfake.Clientset{
+		"default":     typfake.NewSimpleClientset(),
+		targetCluster: typfake.NewSimpleClientset(),
+	}
+
+	s := Server{
+		pluginConfig:           defaultPluginConfig,
+		globalPackagingCluster: "default",
+		clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+			return clientgetter.NewBuilder().
+				WithTyped(typedClients[cluster]).
+				WithDynamic(dynamicClients[cluster]).
+				Build(), nil
+		},
+	}
+
+	response, err := s.CreateInstalledPackage(context.Background(), &corev1.CreateInstalledPackageRequest{
+		AvailablePackageRef: &corev1.AvailablePackageReference{
+			Context:    &corev1.Context{Namespace: "default", Cluster: "default"},
+			Plugin:     &pluginDetail,
+			Identifier: "unknown/tetris.foo.example.com",
+		},
+		PkgVersionReference: &corev1.VersionReference{Version: "1.2.3"},
+		Name:                "my-installation",
+		TargetContext:       &corev1.Context{Namespace: "default", Cluster: targetCluster},
+		ReconciliationOptions: &corev1.ReconciliationOptions{
+			ServiceAccountName: "default",
+		},
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	expectedRef := &corev1.InstalledPackageReference{
+		Context:    &corev1.Context{Namespace: "default", Cluster: targetCluster},
+		Plugin:     &pluginDetail,
+		Identifier: "my-installation",
+	}
+	if got, want := response.GetInstalledPackageRef(), expectedRef; !cmp.Equal(want, got, ignoreUnexported) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+	}
+
+	pkgInstall, err := s.getPkgInstall(context.Background(), targetCluster, "default", "my-installation")
+	if err != nil {
+		t.Fatalf("expected the PackageInstall in the target cluster: %+v", err)
+	}
+	if _, err := s.getPkgInstall(context.Background(), "default", "default", "my-installation"); !errors.IsNotFound(err) {
+		t.Errorf("expected no PackageInstall in the default cluster, got: %+v", err)
+	}
+	secret, err := typedClients[targetCluster].CoreV1().Secrets("default").Get(context.Background(), "my-installation-default-values", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected the values secret in the target cluster: %+v", err)
+	}
+	if !isPluginManagedValues(pkgInstall, secret) {
+		t.Errorf("expected the values secret to be managed by the plugin: %+v", secret)
+	}
+}
+
 func TestUpdateInstalledPackage(t *testing.T) {
 	testCases := []struct {
 		name                   string
@@ -5874,26 +6422,337 @@ func TestUpdateInstalledPackage(t *testing.T) {
 	}
 }
 
-func TestDeleteInstalledPackage(t *testing.T) {
+func TestUpdateInstalledPackageCustomDetail(t *testing.T) {
+	existingPkgInstall := func() *packagingv1alpha1.PackageInstall {
+		return &packagingv1alpha1.PackageInstall{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       pkgInstallResource,
+				APIVersion: packagingAPIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "my-installation",
+				UID:       "my-installation-uid",
+				Annotations: map[string]string{
+					kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey: "my-overlay",
+				},
+			},
+			Spec: packagingv1alpha1.PackageInstallSpec{
+				ServiceAccountName: "default",
+				PackageRef: &packagingv1alpha1.PackageRef{
+					RefName: "tetris.foo.example.com",
+					VersionSelection: &vendirversions.VersionSelectionSemver{
+						Constraints: "1.2.3",
+					},
+				},
+				Values: []packagingv1alpha1.PackageInstallValues{
+					{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-installation-default-values"}},
+					{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-values", Key: "extra.yaml"}},
+				},
+			},
+		}
+	}
+	existingSecret := func(name string) *k8scorev1.Secret {
+		return &k8scorev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Type:       "Opaque",
+		}
+	}
+
 	testCases := []struct {
 		name                 string
-		request              *corev1.DeleteInstalledPackageRequest
-		existingObjects      []k8sruntime.Object
-		existingTypedObjects []k8sruntime.Object
+		values               string
+		customDetail         *kappcorev1.InstalledPackageCustomDetail
 		expectedStatusCode   codes.Code
-		expectedResponse     *corev1.DeleteInstalledPackageResponse
+		expectedValues       []packagingv1alpha1.PackageInstallValues
+		expectedCustomDetail *kappcorev1.InstalledPackageCustomDetail
 	}{
 		{
-			name: "deletes installed package",
-			request: &corev1.DeleteInstalledPackageRequest{
-				InstalledPackageRef: &corev1.InstalledPackageReference{
-					Context:    defaultContext,
-					Plugin:     &pluginDetail,
-					Identifier: "my-installation",
-				},
+			name:               "preserves the values secrets and overlays without custom detail",
+			values:             "foo: bar",
+			expectedStatusCode: codes.OK,
+			expectedValues: []packagingv1alpha1.PackageInstallValues{
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-installation-default-values"}},
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-values", Key: "extra.yaml"}},
 			},
-			existingObjects: []k8sruntime.Object{
-				&packagingv1alpha1.PackageInstall{
+			expectedCustomDetail: &kappcorev1.InstalledPackageCustomDetail{
+				ValuesSecrets:  []*kappcorev1.PackageInstallValuesSecretRef{{Name: "my-values", Key: "extra.yaml"}},
+				OverlaySecrets: []string{"my-overlay"},
+			},
+		},
+		{
+			name:               "preserves the values secrets when the managed values are removed",
+			expectedStatusCode: codes.OK,
+			expectedValues: []packagingv1alpha1.PackageInstallValues{
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-values", Key: "extra.yaml"}},
+			},
+			expectedCustomDetail: &kappcorev1.InstalledPackageCustomDetail{
+				ValuesSecrets:  []*kappcorev1.PackageInstallValuesSecretRef{{Name: "my-values", Key: "extra.yaml"}},
+				OverlaySecrets: []string{"my-overlay"},
+			},
+		},
+		{
+			name:   "replaces the values secrets and overlays with the custom detail",
+			values: "foo: bar",
+			customDetail: &kappcorev1.InstalledPackageCustomDetail{
+				ValuesSecrets:  []*kappcorev1.PackageInstallValuesSecretRef{{Name: "my-other-values"}, {Name: "my-values"}},
+				OverlaySecrets: []string{"my-other-overlay", "my-overlay"},
+			},
+			expectedStatusCode: codes.OK,
+			expectedValues: []packagingv1alpha1.PackageInstallValues{
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-installation-default-values"}},
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-other-values"}},
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-values"}},
+			},
+			expectedCustomDetail: &kappcorev1.InstalledPackageCustomDetail{
+				ValuesSecrets:  []*kappcorev1.PackageInstallValuesSecretRef{{Name: "my-other-values"}, {Name: "my-values"}},
+				OverlaySecrets: []string{"my-other-overlay", "my-overlay"},
+			},
+		},
+		{
+			name:   "returns invalid argument if a referenced secret does not exist",
+			values: "foo: bar",
+			customDetail: &kappcorev1.InstalledPackageCustomDetail{
+				OverlaySecrets: []string{"missing-overlay"},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:   "returns invalid argument if the managed values secret is referenced",
+			values: "foo: bar",
+			customDetail: &kappcorev1.InstalledPackageCustomDetail{
+				ValuesSecrets: []*kappcorev1.PackageInstallValuesSecretRef{{Name: "my-installation-default-values"}},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(existingPkgInstall())
+			dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+				k8sruntime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgsResource}: pkgResource + "List",
+					{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}:  pkgInstallResource + "List",
+				},
+				&unstructured.Unstructured{Object: unstructuredContent},
+			)
+			managedSecret := existingSecret("my-installation-default-values")
+			managedSecret.Annotations = map[string]string{Annotation_ManagedBy_Key: Annotation_ManagedBy_Value}
+			setPkgInstallOwnerReference(managedSecret, existingPkgInstall())
+			typedClient := typfake.NewSimpleClientset(
+				managedSecret,
+				existingSecret("my-values"),
+				existingSecret("my-other-values"),
+				existingSecret("my-overlay"),
+				existingSecret("my-other-overlay"),
+			)
+
+			s := Server{
+				pluginConfig: defaultPluginConfig,
+				clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+					return clientgetter.NewBuilder().WithTyped(typedClient).WithDynamic(dynamicClient).Build(), nil
+				},
+			}
+
+			request := &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "my-installation",
+				},
+				PkgVersionReference: &corev1.VersionReference{Version: "1.2.3"},
+				Values:              tc.values,
+			}
+			if tc.customDetail != nil {
+				request.CustomDetail, _ = anypb.New(tc.customDetail)
+			}
+
+			_, err := s.UpdateInstalledPackage(context.Background(), request)
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %d, want: %d, err: %+v", got, want, err)
+			}
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			pkgInstall, err := s.getPkgInstall(context.Background(), "default", "default", "my-installation")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := pkgInstall.Spec.Values, tc.expectedValues; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if got, want := toInstalledPackageCustomDetail(pkgInstall), tc.expectedCustomDetail; !cmp.Equal(want, got, ignoreUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+			}
+
+			secret, err := typedClient.CoreV1().Secrets("default").Get(context.Background(), "my-installation-default-values", metav1.GetOptions{})
+			if got, want := errors.IsNotFound(err), tc.values == ""; got != want {
+				t.Errorf("got managed values secret deleted: %t, want: %t", got, want)
+			}
+			if tc.values != "" && !isPluginManagedValues(pkgInstall, secret) {
+				t.Errorf("expected the values secret to be managed by the plugin: %+v", secret)
+			}
+			if _, err := typedClient.CoreV1().Secrets("default").Get(context.Background(), "my-values", metav1.GetOptions{}); err != nil {
+				t.Errorf("expected the additional values secret to be preserved: %+v", err)
+			}
+		})
+	}
+}
+
+func TestUpdateInstalledPackageValuesSecretOwnership(t *testing.T) {
+	pkgInstall := &packagingv1alpha1.PackageInstall{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       pkgInstallResource,
+			APIVersion: packagingAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-installation",
+			UID:       "my-installation-uid",
+		},
+		Spec: packagingv1alpha1.PackageInstallSpec{
+			ServiceAccountName: "default",
+			PackageRef: &packagingv1alpha1.PackageRef{
+				RefName: "tetris.foo.example.com",
+				VersionSelection: &vendirversions.VersionSelectionSemver{
+					Constraints: "1.2.3",
+				},
+			},
+			Values: []packagingv1alpha1.PackageInstallValues{
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-installation-default-values"}},
+			},
+		},
+	}
+	otherPkgInstall := pkgInstall.DeepCopy()
+	otherPkgInstall.Name = "my-other-installation"
+	otherPkgInstall.UID = "my-other-installation-uid"
+
+	newSecret := func(managed bool, owner *packagingv1alpha1.PackageInstall, data map[string][]byte) *k8scorev1.Secret {
+		secret := &k8scorev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-installation-default-values"},
+			Type:       "Opaque",
+			Data:       data,
+		}
+		if managed {
+			secret.Annotations = map[string]string{Annotation_ManagedBy_Key: Annotation_ManagedBy_Value}
+		}
+		if owner != nil {
+			setPkgInstallOwnerReference(secret, owner)
+		}
+		return secret
+	}
+	values := map[string][]byte{"values.yaml": []byte("foo: bar")}
+
+	testCases := []struct {
+		name               string
+		existingSecret     *k8scorev1.Secret
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:               "updates the values secret managed by the plugin",
+			existingSecret:     newSecret(true, pkgInstall, values),
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "updates a values secret created by a previous version of the plugin",
+			existingSecret:     newSecret(false, nil, values),
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "creates the values secret if it does not exist",
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "does not overwrite a values secret owned by another installed package",
+			existingSecret:     newSecret(true, otherPkgInstall, values),
+			expectedStatusCode: codes.FailedPrecondition,
+		},
+		{
+			name:               "does not overwrite a secret with the name used by the plugin but other keys",
+			existingSecret:     newSecret(false, nil, map[string][]byte{"password": []byte("secret")}),
+			expectedStatusCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(pkgInstall)
+			dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+				k8sruntime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgsResource}: pkgResource + "List",
+					{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}:  pkgInstallResource + "List",
+				},
+				&unstructured.Unstructured{Object: unstructuredContent},
+			)
+			typedClient := typfake.NewSimpleClientset()
+			if tc.existingSecret != nil {
+				typedClient = typfake.NewSimpleClientset(tc.existingSecret)
+			}
+
+			s := Server{
+				pluginConfig: defaultPluginConfig,
+				clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+					return clientgetter.NewBuilder().WithTyped(typedClient).WithDynamic(dynamicClient).Build(), nil
+				},
+			}
+
+			_, err := s.UpdateInstalledPackage(context.Background(), &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "my-installation",
+				},
+				PkgVersionReference: &corev1.VersionReference{Version: "1.2.3"},
+				Values:              "foo: baz",
+			})
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %d, want: %d, err: %+v", got, want, err)
+			}
+
+			secret, err := typedClient.CoreV1().Secrets("default").Get(context.Background(), "my-installation-default-values", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if tc.expectedStatusCode != codes.OK {
+				if got, want := secret, tc.existingSecret; !cmp.Equal(want, got) {
+					t.Errorf("expected the secret to be left untouched, mismatch (-want +got):\n%s", cmp.Diff(want, got))
+				}
+				return
+			}
+			if !metav1.IsControlledBy(secret, pkgInstall) || secret.Annotations[Annotation_ManagedBy_Key] != Annotation_ManagedBy_Value {
+				t.Errorf("expected the values secret to be owned and annotated by the plugin: %+v", secret)
+			}
+			if got, want := string(secret.Data["values.yaml"]), "foo: baz"; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDeleteInstalledPackage(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		request              *corev1.DeleteInstalledPackageRequest
+		existingObjects      []k8sruntime.Object
+		existingTypedObjects []k8sruntime.Object
+		expectedStatusCode   codes.Code
+		expectedResponse     *corev1.DeleteInstalledPackageResponse
+	}{
+		{
+			name: "deletes installed package",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "my-installation",
+				},
+			},
+			existingObjects: []k8sruntime.Object{
+				&packagingv1alpha1.PackageInstall{
 					TypeMeta: metav1.TypeMeta{
 						Kind:       pkgInstallResource,
 						APIVersion: packagingAPIVersion,
@@ -6066,6 +6925,143 @@ func TestDeleteInstalledPackage(t *testing.T) {
 	}
 }
 
+func TestDeleteInstalledPackageSecretsOwnership(t *testing.T) {
+	pkgInstall := &packagingv1alpha1.PackageInstall{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       pkgInstallResource,
+			APIVersion: packagingAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-installation",
+			UID:       "my-installation-uid",
+		},
+		Spec: packagingv1alpha1.PackageInstallSpec{
+			ServiceAccountName: "default",
+			PackageRef: &packagingv1alpha1.PackageRef{
+				RefName: "tetris.foo.example.com",
+				VersionSelection: &vendirversions.VersionSelectionSemver{
+					Constraints: "1.2.3",
+				},
+			},
+			Values: []packagingv1alpha1.PackageInstallValues{
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-installation-default-values"}},
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-values"}},
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-annotated-values"}},
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-missing-values"}},
+			},
+		},
+	}
+	otherPkgInstall := pkgInstall.DeepCopy()
+	otherPkgInstall.Name = "my-other-installation"
+	otherPkgInstall.UID = "my-other-installation-uid"
+
+	newSecret := func(name string, managed bool, owner *packagingv1alpha1.PackageInstall) *k8scorev1.Secret {
+		secret := &k8scorev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Type:       "Opaque",
+		}
+		if managed {
+			secret.Annotations = map[string]string{Annotation_ManagedBy_Key: Annotation_ManagedBy_Value}
+		}
+		if owner != nil {
+			setPkgInstallOwnerReference(secret, owner)
+		}
+		return secret
+	}
+
+	testCases := []struct {
+		name           string
+		existingSecret *k8scorev1.Secret
+		expectDeleted  bool
+	}{
+		{
+			name:           "deletes the values secret managed by the plugin",
+			existingSecret: newSecret("my-installation-default-values", true, pkgInstall),
+			expectDeleted:  true,
+		},
+		{
+			name:           "preserves a values secret supplied by the user",
+			existingSecret: newSecret("my-values", false, nil),
+		},
+		{
+			name:           "preserves a values secret with the annotation but without owner reference",
+			existingSecret: newSecret("my-annotated-values", true, nil),
+		},
+		{
+			name:           "preserves a values secret owned by another installed package",
+			existingSecret: newSecret("my-annotated-values", true, otherPkgInstall),
+		},
+		{
+			name:           "preserves a values secret owned but without the annotation",
+			existingSecret: newSecret("my-values", false, pkgInstall),
+		},
+		{
+			name: "deletes a values secret created by a previous version of the plugin",
+			existingSecret: func() *k8scorev1.Secret {
+				secret := newSecret("my-installation-default-values", false, nil)
+				secret.Data = map[string][]byte{"values.yaml": []byte("foo: bar")}
+				return secret
+			}(),
+			expectDeleted: true,
+		},
+		{
+			name: "preserves a values secret with the name used by the plugin but other keys",
+			existingSecret: func() *k8scorev1.Secret {
+				secret := newSecret("my-installation-default-values", false, nil)
+				secret.Data = map[string][]byte{"values.yaml": []byte("foo: bar"), "other.yaml": []byte("bar: foo")}
+				return secret
+			}(),
+		},
+		{
+			name: "preserves a values secret with the name used by the plugin but other annotations",
+			existingSecret: func() *k8scorev1.Secret {
+				secret := newSecret("my-installation-default-values", false, nil)
+				secret.Annotations = map[string]string{"packaging.carvel.dev/package": "my-installation-default"}
+				secret.Data = map[string][]byte{"values.yaml": []byte("foo: bar")}
+				return secret
+			}(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(pkgInstall)
+			dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+				k8sruntime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}: pkgInstallResource + "List",
+				},
+				&unstructured.Unstructured{Object: unstructuredContent},
+			)
+			typedClient := typfake.NewSimpleClientset(tc.existingSecret)
+
+			s := Server{
+				pluginConfig: defaultPluginConfig,
+				clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+					return clientgetter.NewBuilder().WithTyped(typedClient).WithDynamic(dynamicClient).Build(), nil
+				},
+			}
+
+			_, err := s.DeleteInstalledPackage(context.Background(), &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "my-installation",
+				},
+			})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			_, err = typedClient.CoreV1().Secrets("default").Get(context.Background(), tc.existingSecret.Name, metav1.GetOptions{})
+			if got, want := errors.IsNotFound(err), tc.expectDeleted; got != want {
+				t.Errorf("got secret deleted: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
 func TestGetInstalledPackageResourceRefs(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -6435,63 +7431,331 @@ func TestGetInstalledPackageResourceRefs(t *testing.T) {
 				map[schema.GroupVersionResource]string{
 					{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgsResource}:         pkgResource + "List",
 					{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgMetadatasResource}: pkgMetadataResource + "List",
-					{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}:          pkgInstallResource + "List",
-					{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: appsResource}:                 appResource + "List",
-					// If more resources types are added, this will need to be updated accordingly
-					{Group: "", Version: "v1", Resource: "pods"}:       "Pod" + "List",
-					{Group: "", Version: "v1", Resource: "configmaps"}: "ConfigMap" + "List",
+					{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgInstallsResource}:          pkgInstallResource + "List",
+					{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: appsResource}:                 appResource + "List",
+					// If more resources types are added, this will need to be updated accordingly
+					{Group: "", Version: "v1", Resource: "pods"}:       "Pod" + "List",
+					{Group: "", Version: "v1", Resource: "configmaps"}: "ConfigMap" + "List",
+				},
+				unstructuredObjects...,
+			)
+
+			s := Server{
+				pluginConfig: defaultPluginConfig,
+				clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+					return clientgetter.NewBuilder().
+						WithTyped(typedClient).
+						WithDynamic(dynClient).
+						Build(), nil
+				},
+				kappClientsGetter: func(ctx context.Context, cluster, namespace string) (ctlapp.Apps, ctlres.IdentifiedResources, *kappcmdapp.FailingAPIServicesPolicy, ctlres.ResourceFilter, error) {
+					// Create a fake Kapp DepsFactory and configure there the fake k8s clients the hereinbefore created
+					depsFactory := NewFakeDepsFactoryImpl()
+					depsFactory.SetCoreClient(typedClient)
+					depsFactory.SetDynamicClient(dynClient)
+					// The rest of the logic remain unchanged as in the real server.go file (DRY it up?)
+					resourceFilterFlags := kappcmdtools.ResourceFilterFlags{}
+					resourceFilter, err := resourceFilterFlags.ResourceFilter()
+					if err != nil {
+						return ctlapp.Apps{}, ctlres.IdentifiedResources{}, nil, ctlres.ResourceFilter{}, status.Errorf(codes.FailedPrecondition, "unable to get config due to: %v", err)
+					}
+					resourceTypesFlags := kappcmdapp.ResourceTypesFlags{
+						IgnoreFailingAPIServices:         true,
+						ScopeToFallbackAllowedNamespaces: true,
+					}
+					failingAPIServicesPolicy := resourceTypesFlags.FailingAPIServicePolicy()
+					supportingNsObjs, err := kappcmdapp.FactoryClients(depsFactory, kappcmdcore.NamespaceFlags{Name: namespace}, resourceTypesFlags, logger.NewNoopLogger())
+					if err != nil {
+						return ctlapp.Apps{}, ctlres.IdentifiedResources{}, nil, ctlres.ResourceFilter{}, status.Errorf(codes.FailedPrecondition, "unable to get config due to: %v", err)
+					}
+					supportingObjs, err := kappcmdapp.FactoryClients(depsFactory, kappcmdcore.NamespaceFlags{Name: ""}, resourceTypesFlags, logger.NewNoopLogger())
+					if err != nil {
+						return ctlapp.Apps{}, ctlres.IdentifiedResources{}, nil, ctlres.ResourceFilter{}, status.Errorf(codes.FailedPrecondition, "unable to get config due to: %v", err)
+					}
+					return supportingNsObjs.Apps, supportingObjs.IdentifiedResources, failingAPIServicesPolicy, resourceFilter, nil
+				},
+			}
+
+			getInstalledPackageResourceRefsResponse, err := s.GetInstalledPackageResourceRefs(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %d, want: %d, err: %+v", got, want, err)
+			}
+			// If we were expecting an error, continue to the next test.
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+			if got, want := getInstalledPackageResourceRefsResponse, tc.expectedResponse; !cmp.Equal(want, got, ignoreUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+			}
+		})
+	}
+}
+
+func TestConvertHelmPackage(t *testing.T) {
+	helmPlugin := &pluginv1.Plugin{Name: "helm.packages", Version: "v1alpha1"}
+	helmPkgRef := &corev1.AvailablePackageReference{
+		Context:    &corev1.Context{Namespace: "kubeapps", Cluster: "default"},
+		Plugin:     helmPlugin,
+		Identifier: "bitnami/apache",
+	}
+	helmPkgDetail := &corev1.AvailablePackageDetail{
+		AvailablePackageRef: helmPkgRef,
+		Name:                "apache",
+		Version:             &corev1.PackageAppVersion{PkgVersion: "1.2.3", AppVersion: "2.4.0"},
+		RepoUrl:             "https://charts.bitnami.com/bitnami",
+		DisplayName:         "Apache",
+		ShortDescription:    "Apache HTTP Server",
+		Maintainers:         []*corev1.Maintainer{{Name: "Bitnami", Email: "containers@bitnami.com"}},
+		Categories:          []string{"Infrastructure"},
+		ValuesSchema:        `{"properties":{"foo":{"type":"string"}},"type":"object"}`,
+	}
+	expectedManifest := `apiVersion: data.packaging.carvel.dev/v1alpha1
+kind: PackageMetadata
+metadata:
+  annotations:
+    kubeapps.dev/managed-by: plugin:kapp-controller
+  name: apache.bitnami.helm.kubeapps.dev
+  namespace: default
+spec:
+  categories:
+  - Infrastructure
+  displayName: Apache
+  maintainers:
+  - name: Bitnami
+  shortDescription: Apache HTTP Server
+---
+apiVersion: data.packaging.carvel.dev/v1alpha1
+kind: Package
+metadata:
+  annotations:
+    kubeapps.dev/managed-by: plugin:kapp-controller
+  name: apache.bitnami.helm.kubeapps.dev.1.2.3
+  namespace: default
+spec:
+  refName: apache.bitnami.helm.kubeapps.dev
+  template:
+    spec:
+      deploy:
+      - kapp: {}
+      fetch:
+      - helmChart:
+          name: apache
+          repository:
+            url: https://charts.bitnami.com/bitnami
+          version: 1.2.3
+      template:
+      - helmTemplate: {}
+  valuesSchema:
+    openAPIv3:
+      properties:
+        foo:
+          type: string
+      type: object
+  version: 1.2.3
+`
+	existingPkgMetadata := &datapackagingv1alpha1.PackageMetadata{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       pkgMetadataResource,
+			APIVersion: datapackagingAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "apache.bitnami.helm.kubeapps.dev",
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		request            *kappcorev1.ConvertHelmPackageRequest
+		existingObjects    []k8sruntime.Object
+		repoAuth           *corev1.PackageRepositoryAuth
+		withoutAuth        bool
+		expectedStatusCode codes.Code
+		expectedResponse   *kappcorev1.ConvertHelmPackageResponse
+	}{
+		{
+			name: "returns the manifest without a target namespace",
+			request: &kappcorev1.ConvertHelmPackageRequest{
+				AvailablePackageRef: helmPkgRef,
+				PkgVersion:          "1.2.3",
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &kappcorev1.ConvertHelmPackageResponse{
+				Manifest: strings.ReplaceAll(expectedManifest, "  namespace: default\n", ""),
+			},
+		},
+		{
+			name: "creates the resources in the target namespace",
+			request: &kappcorev1.ConvertHelmPackageRequest{
+				AvailablePackageRef: helmPkgRef,
+				PkgVersion:          "1.2.3",
+				TargetContext:       defaultContext,
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &kappcorev1.ConvertHelmPackageResponse{
+				Manifest: expectedManifest,
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "unknown/apache.bitnami.helm.kubeapps.dev",
+				},
+			},
+		},
+		{
+			name: "creates the package when the package metadata already exists",
+			request: &kappcorev1.ConvertHelmPackageRequest{
+				AvailablePackageRef: helmPkgRef,
+				PkgVersion:          "1.2.3",
+				TargetContext:       defaultContext,
+			},
+			existingObjects:    []k8sruntime.Object{existingPkgMetadata},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &kappcorev1.ConvertHelmPackageResponse{
+				Manifest: expectedManifest,
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "unknown/apache.bitnami.helm.kubeapps.dev",
+				},
+			},
+		},
+		{
+			name: "returns invalid argument if the package does not belong to the helm plugin",
+			request: &kappcorev1.ConvertHelmPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    defaultContext,
+					Plugin:     &pluginDetail,
+					Identifier: "unknown/tetris.foo.example.com",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns invalid argument if the identifier is not a helm package identifier",
+			request: &kappcorev1.ConvertHelmPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context:    helmPkgRef.Context,
+					Plugin:     helmPlugin,
+					Identifier: "apache",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns failed precondition if the repository requires authentication",
+			request: &kappcorev1.ConvertHelmPackageRequest{
+				AvailablePackageRef: helmPkgRef,
+				PkgVersion:          "1.2.3",
+				TargetContext:       defaultContext,
+			},
+			repoAuth: &corev1.PackageRepositoryAuth{
+				Type: corev1.PackageRepositoryAuth_PACKAGE_REPOSITORY_AUTH_TYPE_BASIC_AUTH,
+			},
+			expectedStatusCode: codes.FailedPrecondition,
+		},
+		{
+			name: "returns permission denied without authorization",
+			request: &kappcorev1.ConvertHelmPackageRequest{
+				AvailablePackageRef: helmPkgRef,
+			},
+			withoutAuth:        true,
+			expectedStatusCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Serve a fake helm packages plugin through a buf connection
+			lis := bufconn.Listen(1024 * 1024)
+			grpcServer := grpc.NewServer()
+			corev1.RegisterPackagesServiceServer(grpcServer, &plugin_test.TestPackagingPluginServer{
+				Plugin:                 helmPlugin,
+				AvailablePackageDetail: helmPkgDetail,
+			})
+			corev1.RegisterRepositoriesServiceServer(grpcServer, &plugin_test.TestRepositoriesPluginServer{
+				Plugin: helmPlugin,
+				PackageRepositoryDetail: &corev1.PackageRepositoryDetail{
+					PackageRepoRef: &corev1.PackageRepositoryReference{
+						Context:    helmPkgRef.Context,
+						Plugin:     helmPlugin,
+						Identifier: "bitnami",
+					},
+					Name: "bitnami",
+					Url:  helmPkgDetail.RepoUrl,
+					Auth: tc.repoAuth,
+				},
+			})
+			go func() {
+				if err := grpcServer.Serve(lis); err != nil {
+					t.Logf("server exited with error: %v", err)
+				}
+			}()
+			defer grpcServer.Stop()
+			conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return lis.Dial()
+			}), grpc.WithInsecure())
+			if err != nil {
+				t.Fatalf("failed to dial bufnet: %v", err)
+			}
+			defer conn.Close()
+
+			var unstructuredObjects []k8sruntime.Object
+			for _, obj := range tc.existingObjects {
+				unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+				unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: unstructuredContent})
+			}
+			dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+				k8sruntime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgsResource}:         pkgResource + "List",
+					{Group: datapackagingv1alpha1.SchemeGroupVersion.Group, Version: datapackagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgMetadatasResource}: pkgMetadataResource + "List",
 				},
 				unstructuredObjects...,
 			)
 
 			s := Server{
-				pluginConfig: defaultPluginConfig,
+				pluginConfig:           defaultPluginConfig,
+				globalPackagingCluster: defaultContext.Cluster,
 				clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
-					return clientgetter.NewBuilder().
-						WithTyped(typedClient).
-						WithDynamic(dynClient).
-						Build(), nil
+					return clientgetter.NewBuilder().WithTyped(typfake.NewSimpleClientset()).WithDynamic(dynamicClient).Build(), nil
 				},
-				kappClientsGetter: func(ctx context.Context, cluster, namespace string) (ctlapp.Apps, ctlres.IdentifiedResources, *kappcmdapp.FailingAPIServicesPolicy, ctlres.ResourceFilter, error) {
-					// Create a fake Kapp DepsFactory and configure there the fake k8s clients the hereinbefore created
-					depsFactory := NewFakeDepsFactoryImpl()
-					depsFactory.SetCoreClient(typedClient)
-					depsFactory.SetDynamicClient(dynClient)
-					// The rest of the logic remain unchanged as in the real server.go file (DRY it up?)
-					resourceFilterFlags := kappcmdtools.ResourceFilterFlags{}
-					resourceFilter, err := resourceFilterFlags.ResourceFilter()
-					if err != nil {
-						return ctlapp.Apps{}, ctlres.IdentifiedResources{}, nil, ctlres.ResourceFilter{}, status.Errorf(codes.FailedPrecondition, "unable to get config due to: %v", err)
-					}
-					resourceTypesFlags := kappcmdapp.ResourceTypesFlags{
-						IgnoreFailingAPIServices:         true,
-						ScopeToFallbackAllowedNamespaces: true,
-					}
-					failingAPIServicesPolicy := resourceTypesFlags.FailingAPIServicePolicy()
-					supportingNsObjs, err := kappcmdapp.FactoryClients(depsFactory, kappcmdcore.NamespaceFlags{Name: namespace}, resourceTypesFlags, logger.NewNoopLogger())
-					if err != nil {
-						return ctlapp.Apps{}, ctlres.IdentifiedResources{}, nil, ctlres.ResourceFilter{}, status.Errorf(codes.FailedPrecondition, "unable to get config due to: %v", err)
-					}
-					supportingObjs, err := kappcmdapp.FactoryClients(depsFactory, kappcmdcore.NamespaceFlags{Name: ""}, resourceTypesFlags, logger.NewNoopLogger())
-					if err != nil {
-						return ctlapp.Apps{}, ctlres.IdentifiedResources{}, nil, ctlres.ResourceFilter{}, status.Errorf(codes.FailedPrecondition, "unable to get config due to: %v", err)
-					}
-					return supportingNsObjs.Apps, supportingObjs.IdentifiedResources, failingAPIServicesPolicy, resourceFilter, nil
+				corePackagesClientGetter: func() (corev1.PackagesServiceClient, error) {
+					return corev1.NewPackagesServiceClient(conn), nil
+				},
+				coreRepositoriesClientGetter: func() (corev1.RepositoriesServiceClient, error) {
+					return corev1.NewRepositoriesServiceClient(conn), nil
 				},
 			}
 
-			getInstalledPackageResourceRefsResponse, err := s.GetInstalledPackageResourceRefs(context.Background(), tc.request)
-
+			ctx := context.Background()
+			if !tc.withoutAuth {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer some-token"))
+			}
+			response, err := s.ConvertHelmPackage(ctx, tc.request)
 			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
 				t.Fatalf("got: %d, want: %d, err: %+v", got, want, err)
 			}
-			// If we were expecting an error, continue to the next test.
 			if tc.expectedStatusCode != codes.OK {
 				return
 			}
-			if got, want := getInstalledPackageResourceRefsResponse, tc.expectedResponse; !cmp.Equal(want, got, ignoreUnexported) {
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, ignoreUnexported) {
 				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
 			}
+
+			if tc.request.GetTargetContext() == nil {
+				return
+			}
+			if _, err := s.getPkgMetadata(ctx, defaultContext.Cluster, defaultContext.Namespace, "apache.bitnami.helm.kubeapps.dev"); err != nil {
+				t.Errorf("expected the PackageMetadata to exist: %+v", err)
+			}
+			pkg, err := s.getPkg(ctx, defaultContext.Cluster, defaultContext.Namespace, "apache.bitnami.helm.kubeapps.dev.1.2.3")
+			if err != nil {
+				t.Fatalf("expected the Package to exist: %+v", err)
+			}
+			if got, want := string(pkg.Spec.ValuesSchema.OpenAPIv3.Raw), helmPkgDetail.ValuesSchema; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
 		})
 	}
 }
@@ -6542,12 +7806,20 @@ func TestAddPackageRepository(t *testing.T) {
 		customChecks         func(t *testing.T, s *Server)
 	}{
 		{
-			name: "validate cluster",
+			name: "create in other cluster",
 			requestCustomizer: func(request *corev1.AddPackageRepositoryRequest) *corev1.AddPackageRepositoryRequest {
 				request.Context = &corev1.Context{Cluster: "other", Namespace: globalPackagingNamespace}
 				return request
 			},
-			expectedStatusCode: codes.InvalidArgument,
+			repositoryCustomizer: func(repository *packagingv1alpha1.PackageRepository) *packagingv1alpha1.PackageRepository {
+				return repository
+			},
+			expectedStatusCode: codes.OK,
+			expectedRef: &corev1.PackageRepositoryReference{
+				Context:    &corev1.Context{Cluster: "other", Namespace: globalPackagingNamespace},
+				Plugin:     &pluginDetail,
+				Identifier: "globalrepo",
+			},
 		},
 		{
 			name: "validate name",
@@ -6660,13 +7932,42 @@ func TestAddPackageRepository(t *testing.T) {
 			expectedStatusCode: codes.InvalidArgument,
 		},
 		{
-			name: "validate type (inline)",
+			name: "validate inline (url)",
+			requestCustomizer: func(request *corev1.AddPackageRepositoryRequest) *corev1.AddPackageRepositoryRequest {
+				request.Type = Type_Inline
+				return request
+			},
+			expectedStatusCode:   codes.InvalidArgument,
+			expectedStatusString: "Url is not supported",
+		},
+		{
+			name: "validate inline (no details)",
 			requestCustomizer: func(request *corev1.AddPackageRepositoryRequest) *corev1.AddPackageRepositoryRequest {
 				request.Type = Type_Inline
+				request.Url = ""
 				return request
 			},
 			expectedStatusCode: codes.InvalidArgument,
 		},
+		{
+			name: "validate inline (source does not exist)",
+			requestCustomizer: func(request *corev1.AddPackageRepositoryRequest) *corev1.AddPackageRepositoryRequest {
+				request.Type = Type_Inline
+				request.Url = ""
+				request.CustomDetail, _ = anypb.New(&kappcorev1.PackageRepositoryCustomDetail{
+					Fetch: &kappcorev1.PackageRepositoryFetch{
+						Inline: &kappcorev1.PackageRepositoryInline{
+							PathsFrom: []*kappcorev1.PackageRepositoryInline_Source{
+								{ConfigMapRef: &kappcorev1.PackageRepositoryInline_SourceRef{Name: "my-configmap"}},
+							},
+						},
+					},
+				})
+				return request
+			},
+			expectedStatusCode:   codes.InvalidArgument,
+			expectedStatusString: "not found",
+		},
 		{
 			name: "validate details (invalid type)",
 			requestCustomizer: func(request *corev1.AddPackageRepositoryRequest) *corev1.AddPackageRepositoryRequest {
@@ -6991,6 +8292,42 @@ func TestAddPackageRepository(t *testing.T) {
 			expectedStatusCode: codes.OK,
 			expectedRef:        defaultRef,
 		},
+		{
+			name: "create with details (inline)",
+			existingTypedObjects: []k8sruntime.Object{
+				&k8scorev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-configmap", Namespace: globalPackagingNamespace},
+				},
+			},
+			requestCustomizer: func(request *corev1.AddPackageRepositoryRequest) *corev1.AddPackageRepositoryRequest {
+				request.Type = Type_Inline
+				request.Url = ""
+				request.CustomDetail, _ = anypb.New(&kappcorev1.PackageRepositoryCustomDetail{
+					Fetch: &kappcorev1.PackageRepositoryFetch{
+						Inline: &kappcorev1.PackageRepositoryInline{
+							Paths: map[string]string{"packages/tetris.yaml": "---"},
+							PathsFrom: []*kappcorev1.PackageRepositoryInline_Source{
+								{ConfigMapRef: &kappcorev1.PackageRepositoryInline_SourceRef{Name: "my-configmap", DirectoryPath: "packages"}},
+							},
+						},
+					},
+				})
+				return request
+			},
+			repositoryCustomizer: func(repository *packagingv1alpha1.PackageRepository) *packagingv1alpha1.PackageRepository {
+				repository.Spec.Fetch = &packagingv1alpha1.PackageRepositoryFetch{
+					Inline: &kappctrlv1alpha1.AppFetchInline{
+						Paths: map[string]string{"packages/tetris.yaml": "---"},
+						PathsFrom: []kappctrlv1alpha1.AppFetchInlineSource{
+							{ConfigMapRef: &kappctrlv1alpha1.AppFetchInlineSourceRef{Name: "my-configmap", DirectoryPath: "packages"}},
+						},
+					},
+				}
+				return repository
+			},
+			expectedStatusCode: codes.OK,
+			expectedRef:        defaultRef,
+		},
 		{
 			name: "create with auth (user managed)",
 			existingTypedObjects: []k8sruntime.Object{
@@ -7218,12 +8555,17 @@ func TestUpdatePackageRepository(t *testing.T) {
 		customChecks         func(t *testing.T, s *Server)
 	}{
 		{
-			name: "validate cluster",
+			name: "update in other cluster",
 			requestCustomizer: func(request *corev1.UpdatePackageRepositoryRequest) *corev1.UpdatePackageRepositoryRequest {
 				request.PackageRepoRef.Context = &corev1.Context{Cluster: "other", Namespace: globalPackagingNamespace}
 				return request
 			},
-			expectedStatusCode: codes.InvalidArgument,
+			expectedStatusCode: codes.OK,
+			expectedRef: &corev1.PackageRepositoryReference{
+				Context:    &corev1.Context{Cluster: "other", Namespace: globalPackagingNamespace},
+				Plugin:     &pluginDetail,
+				Identifier: "globalrepo",
+			},
 		},
 		{
 			name: "validate name",
@@ -7668,6 +9010,47 @@ func TestUpdatePackageRepository(t *testing.T) {
 			expectedStatusCode: codes.OK,
 			expectedRef:        defaultRef,
 		},
+		{
+			name: "update with details (inline)",
+			existingTypedObjects: []k8sruntime.Object{
+				&k8scorev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: globalPackagingNamespace},
+				},
+			},
+			initialCustomizer: func(repository *packagingv1alpha1.PackageRepository) *packagingv1alpha1.PackageRepository {
+				repository.Spec.Fetch = &packagingv1alpha1.PackageRepositoryFetch{
+					Inline: &kappctrlv1alpha1.AppFetchInline{
+						Paths: map[string]string{"packages/tetris.yaml": "---"},
+					},
+				}
+				return repository
+			},
+			requestCustomizer: func(request *corev1.UpdatePackageRepositoryRequest) *corev1.UpdatePackageRepositoryRequest {
+				request.Url = ""
+				request.CustomDetail, _ = anypb.New(&kappcorev1.PackageRepositoryCustomDetail{
+					Fetch: &kappcorev1.PackageRepositoryFetch{
+						Inline: &kappcorev1.PackageRepositoryInline{
+							PathsFrom: []*kappcorev1.PackageRepositoryInline_Source{
+								{SecretRef: &kappcorev1.PackageRepositoryInline_SourceRef{Name: "my-secret"}},
+							},
+						},
+					},
+				})
+				return request
+			},
+			repositoryCustomizer: func(repository *packagingv1alpha1.PackageRepository) *packagingv1alpha1.PackageRepository {
+				repository.Spec.Fetch = &packagingv1alpha1.PackageRepositoryFetch{
+					Inline: &kappctrlv1alpha1.AppFetchInline{
+						PathsFrom: []kappctrlv1alpha1.AppFetchInlineSource{
+							{SecretRef: &kappctrlv1alpha1.AppFetchInlineSourceRef{Name: "my-secret"}},
+						},
+					},
+				}
+				return repository
+			},
+			expectedStatusCode: codes.OK,
+			expectedRef:        defaultRef,
+		},
 		{
 			name: "updated with auth (user managed, added)",
 			existingTypedObjects: []k8sruntime.Object{
@@ -8097,6 +9480,153 @@ func TestDeletePackageRepository(t *testing.T) {
 	}
 }
 
+func TestRefreshPackageRepository(t *testing.T) {
+	defaultRepository := func() *packagingv1alpha1.PackageRepository {
+		return &packagingv1alpha1.PackageRepository{
+			TypeMeta:   defaultTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{Name: "globalrepo", Namespace: globalPackagingNamespace},
+			Spec: packagingv1alpha1.PackageRepositorySpec{
+				Fetch: &packagingv1alpha1.PackageRepositoryFetch{
+					ImgpkgBundle: &kappctrlv1alpha1.AppFetchImgpkgBundle{
+						Image: "projects.registry.example.com/repo-1/main@sha256:abcd",
+					},
+				},
+			},
+			Status: packagingv1alpha1.PackageRepositoryStatus{},
+		}
+	}
+	defaultRequest := func() *corev1.RefreshPackageRepositoryRequest {
+		return &corev1.RefreshPackageRepositoryRequest{
+			PackageRepoRef: &corev1.PackageRepositoryReference{
+				Context:    defaultGlobalContext,
+				Plugin:     &pluginDetail,
+				Identifier: "globalrepo",
+			},
+		}
+	}
+
+	conflict := errors.NewConflict(schema.GroupResource{Group: packagingv1alpha1.SchemeGroupVersion.Group, Resource: pkgRepositoriesResource}, "globalrepo", fmt.Errorf("the object has been modified"))
+
+	testCases := []struct {
+		name               string
+		existingObjects    []k8sruntime.Object
+		request            *corev1.RefreshPackageRepositoryRequest
+		updateErrors       []error
+		expectedStatusCode codes.Code
+		expectedPaused     bool
+	}{
+		{
+			name:               "refresh - success",
+			existingObjects:    []k8sruntime.Object{defaultRepository()},
+			request:            defaultRequest(),
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "refresh - unpause retried on conflict",
+			existingObjects:    []k8sruntime.Object{defaultRepository()},
+			request:            defaultRequest(),
+			updateErrors:       []error{nil, conflict, conflict},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "refresh - pause failure leaves the repository unchanged",
+			existingObjects:    []k8sruntime.Object{defaultRepository()},
+			request:            defaultRequest(),
+			updateErrors:       []error{conflict},
+			expectedStatusCode: codes.Internal,
+		},
+		{
+			name:               "refresh - unpause failure is reported",
+			existingObjects:    []k8sruntime.Object{defaultRepository()},
+			request:            defaultRequest(),
+			updateErrors:       []error{nil, errors.NewForbidden(schema.GroupResource{Group: packagingv1alpha1.SchemeGroupVersion.Group, Resource: pkgRepositoriesResource}, "globalrepo", fmt.Errorf("forbidden"))},
+			expectedStatusCode: codes.PermissionDenied,
+			expectedPaused:     true,
+		},
+		{
+			name:               "refresh - not found",
+			existingObjects:    []k8sruntime.Object{},
+			request:            defaultRequest(),
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "refresh - paused repository",
+			existingObjects: []k8sruntime.Object{func(r *packagingv1alpha1.PackageRepository) *packagingv1alpha1.PackageRepository {
+				r.Spec.Paused = true
+				return r
+			}(defaultRepository())},
+			request:            defaultRequest(),
+			expectedStatusCode: codes.FailedPrecondition,
+		},
+		{
+			name:            "refresh - other cluster",
+			existingObjects: []k8sruntime.Object{defaultRepository()},
+			request: &corev1.RefreshPackageRepositoryRequest{
+				PackageRepoRef: &corev1.PackageRepositoryReference{
+					Context:    &corev1.Context{Cluster: "other", Namespace: globalPackagingNamespace},
+					Plugin:     &pluginDetail,
+					Identifier: "globalrepo",
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var unstructuredObjects []k8sruntime.Object
+			for _, obj := range tc.existingObjects {
+				unstructuredContent, _ := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+				unstructuredObjects = append(unstructuredObjects, &unstructured.Unstructured{Object: unstructuredContent})
+			}
+
+			dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+				k8sruntime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Group: packagingv1alpha1.SchemeGroupVersion.Group, Version: packagingv1alpha1.SchemeGroupVersion.Version, Resource: pkgRepositoriesResource}: pkgRepositoryResource + "List",
+				},
+				unstructuredObjects...,
+			)
+			// each update fails with the next error, if any
+			updateErrors := tc.updateErrors
+			dynamicClient.PrependReactor("update", pkgRepositoriesResource, func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+				if len(updateErrors) == 0 {
+					return false, nil, nil
+				}
+				err := updateErrors[0]
+				updateErrors = updateErrors[1:]
+				return err != nil, nil, err
+			})
+			s := Server{
+				pluginConfig: defaultPluginConfig,
+				clientGetter: func(ctx context.Context, cluster string) (clientgetter.ClientInterfaces, error) {
+					return clientgetter.NewBuilder().
+						WithDynamic(dynamicClient).Build(), nil
+				},
+				globalPackagingCluster:   defaultGlobalContext.Cluster,
+				globalPackagingNamespace: defaultGlobalContext.Namespace,
+			}
+
+			_, err := s.RefreshPackageRepository(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %d, want: %d, err: %+v", got, want, err)
+			}
+			if tc.expectedStatusCode != codes.OK && !tc.expectedPaused {
+				return
+			}
+
+			pkgRepository, err := s.getPkgRepository(context.Background(), defaultGlobalContext.Cluster, defaultGlobalContext.Namespace, "globalrepo")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := pkgRepository.Spec.Paused, tc.expectedPaused; got != want {
+				t.Errorf("got paused: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
 func TestGetPackageRepositoryDetail(t *testing.T) {
 	defaultRequest := func() *corev1.GetPackageRepositoryDetailRequest {
 		return &corev1.GetPackageRepositoryDetailRequest{
@@ -4,6 +4,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -12,15 +13,22 @@ import (
 	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	k8scorev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
 
 	"github.com/Masterminds/semver/v3"
 	kappcmdcore "github.com/k14s/kapp/pkg/kapp/cmd/core"
 	kappctrlv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/kappctrl/v1alpha1"
 	packagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/packaging/v1alpha1"
 	datapackagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apiserver/apis/datapackaging/v1alpha1"
+	kappctrlpackageinstall "github.com/vmware-tanzu/carvel-kapp-controller/pkg/packageinstall"
 	vendirversions "github.com/vmware-tanzu/carvel-vendir/pkg/vendir/versions/v1alpha1"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	kappcorev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/kapp_controller/packages/v1alpha1"
@@ -29,6 +37,8 @@ import (
 
 const REPO_REF_ANNOTATION = "packaging.carvel.dev/package-repository-ref"
 const DEFAULT_REPO_NAME = "unknown"
+const HELM_PKG_REF_NAME_SUFFIX = "helm.kubeapps.dev"
+const HELM_PLUGIN_NAME = "helm.packages"
 
 type pkgSemver struct {
 	pkg     *datapackagingv1alpha1.Package
@@ -451,6 +461,27 @@ func toPkgFetchHttp(from *kappcorev1.PackageRepositoryHttp, to *kappctrlv1alpha1
 	to.SHA256 = from.Sha256
 }
 
+func toPkgFetchInline(from *kappcorev1.PackageRepositoryInline, to *kappctrlv1alpha1.AppFetchInline) {
+	to.Paths = from.Paths
+	to.PathsFrom = nil
+	for _, pf := range from.PathsFrom {
+		pathfrom := kappctrlv1alpha1.AppFetchInlineSource{}
+		if pf.SecretRef != nil {
+			pathfrom.SecretRef = &kappctrlv1alpha1.AppFetchInlineSourceRef{
+				Name:          pf.SecretRef.Name,
+				DirectoryPath: pf.SecretRef.DirectoryPath,
+			}
+		}
+		if pf.ConfigMapRef != nil {
+			pathfrom.ConfigMapRef = &kappctrlv1alpha1.AppFetchInlineSourceRef{
+				Name:          pf.ConfigMapRef.Name,
+				DirectoryPath: pf.ConfigMapRef.DirectoryPath,
+			}
+		}
+		to.PathsFrom = append(to.PathsFrom, pathfrom)
+	}
+}
+
 func toPkgVersionSelection(version *kappcorev1.VersionSelection) *vendirversions.VersionSelection {
 	if version == nil || version.Semver == nil {
 		return nil
@@ -558,3 +589,184 @@ func setOwnerReference(pkgSecret *k8scorev1.Secret, pkgRepository *packagingv1al
 			}),
 	}
 }
+
+//
+//		Utils for installed packages
+
+// valuesSecretName returns the name of the secret holding the values managed by this plugin.
+// Using this pattern as per:
+// https://github.com/vmware-tanzu/carvel-kapp-controller/blob/v0.36.1/cli/pkg/kctrl/cmd/package/installed/created_resource_annotations.go#L19
+func valuesSecretName(installedPackageName, namespace string) string {
+	return fmt.Sprintf("%s-%s-values", installedPackageName, namespace)
+}
+
+// isPluginManagedValues returns whether the values secret has been created by this plugin for the
+// given PackageInstall. Any other secret is a user-supplied reference and must be left untouched.
+func isPluginManagedValues(pkgInstall *packagingv1alpha1.PackageInstall, pkgSecret *k8scorev1.Secret) bool {
+	if len(pkgSecret.GetOwnerReferences()) == 0 && len(pkgSecret.GetAnnotations()) == 0 {
+		return isLegacyPluginManagedValues(pkgInstall, pkgSecret)
+	}
+	if !metav1.IsControlledBy(pkgSecret, pkgInstall) {
+		return false
+	}
+	if managedby := pkgSecret.GetAnnotations()[Annotation_ManagedBy_Key]; managedby != Annotation_ManagedBy_Value {
+		return false
+	}
+	return true
+}
+
+// isLegacyPluginManagedValues returns whether the values secret, which has neither the annotation
+// nor the owner reference, has been created by a previous version of this plugin, which did not set
+// them, for the given PackageInstall. Such a secret has the name and the single "values.yaml" key
+// used by this plugin.
+func isLegacyPluginManagedValues(pkgInstall *packagingv1alpha1.PackageInstall, pkgSecret *k8scorev1.Secret) bool {
+	if pkgSecret.GetName() != valuesSecretName(pkgInstall.GetName(), pkgInstall.GetNamespace()) {
+		return false
+	}
+	if pkgSecret.Type != k8scorev1.SecretTypeOpaque || len(pkgSecret.Data) != 1 {
+		return false
+	}
+	_, ok := pkgSecret.Data["values.yaml"]
+	return ok
+}
+
+func setPkgInstallOwnerReference(pkgSecret *k8scorev1.Secret, pkgInstall *packagingv1alpha1.PackageInstall) {
+	pkgSecret.OwnerReferences = []metav1.OwnerReference{
+		*metav1.NewControllerRef(
+			pkgInstall,
+			schema.GroupVersionKind{
+				Group:   packagingv1alpha1.SchemeGroupVersion.Group,
+				Version: packagingv1alpha1.SchemeGroupVersion.Version,
+				Kind:    pkgInstallResource,
+			}),
+	}
+}
+
+// overlaySecretNames returns the names of the secrets with ytt overlays referenced by the
+// annotations of a PackageInstall, sorted by the annotation suffix as kapp-controller does.
+func overlaySecretNames(pkgInstall *packagingv1alpha1.PackageInstall) []string {
+	suffixes := []string{}
+	secretNames := map[string]string{}
+	for key, secretName := range pkgInstall.GetAnnotations() {
+		if key == kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey || strings.HasPrefix(key, kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey+".") {
+			suffix := strings.TrimPrefix(key, kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey)
+			suffixes = append(suffixes, suffix)
+			secretNames[suffix] = secretName
+		}
+	}
+	sort.Strings(suffixes)
+
+	var names []string
+	for _, suffix := range suffixes {
+		names = append(names, secretNames[suffix])
+	}
+	return names
+}
+
+// toInstalledPackageCustomDetail returns the values secrets, other than the one managed by this plugin,
+// and the ytt overlays of a PackageInstall, or nil if there are none.
+func toInstalledPackageCustomDetail(pkgInstall *packagingv1alpha1.PackageInstall) *kappcorev1.InstalledPackageCustomDetail {
+	managedSecretName := valuesSecretName(pkgInstall.GetName(), pkgInstall.GetNamespace())
+	detail := &kappcorev1.InstalledPackageCustomDetail{}
+	for _, value := range pkgInstall.Spec.Values {
+		if value.SecretRef == nil || value.SecretRef.Name == managedSecretName {
+			continue
+		}
+		detail.ValuesSecrets = append(detail.ValuesSecrets, &kappcorev1.PackageInstallValuesSecretRef{
+			Name: value.SecretRef.Name,
+			Key:  value.SecretRef.Key,
+		})
+	}
+	detail.OverlaySecrets = overlaySecretNames(pkgInstall)
+	if len(detail.ValuesSecrets) == 0 && len(detail.OverlaySecrets) == 0 {
+		return nil
+	}
+	return detail
+}
+
+// toPkgInstallCustomDetail replaces the values secrets, other than the one managed by this plugin which
+// is kept first, and the ytt overlays of a PackageInstall with the ones in the custom detail.
+func toPkgInstallCustomDetail(detail *kappcorev1.InstalledPackageCustomDetail, pkgInstall *packagingv1alpha1.PackageInstall) {
+	managedSecretName := valuesSecretName(pkgInstall.GetName(), pkgInstall.GetNamespace())
+	values := []packagingv1alpha1.PackageInstallValues{}
+	for _, value := range pkgInstall.Spec.Values {
+		if value.SecretRef != nil && value.SecretRef.Name == managedSecretName {
+			values = append(values, value)
+		}
+	}
+	for _, secretRef := range detail.GetValuesSecrets() {
+		values = append(values, packagingv1alpha1.PackageInstallValues{
+			SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{
+				Name: secretRef.GetName(),
+				Key:  secretRef.GetKey(),
+			},
+		})
+	}
+	if len(values) == 0 {
+		values = nil
+	}
+	pkgInstall.Spec.Values = values
+
+	annotations := pkgInstall.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	for key := range annotations {
+		if key == kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey || strings.HasPrefix(key, kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey+".") {
+			delete(annotations, key)
+		}
+	}
+	// kapp-controller sorts the annotations by their suffix, so it is zero-padded to keep the order
+	for i, secretName := range detail.GetOverlaySecrets() {
+		annotations[fmt.Sprintf("%s.%03d", kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey, i)] = secretName
+	}
+	pkgInstall.SetAnnotations(annotations)
+}
+
+//
+//		Utils for helm packages
+
+// helmPkgRefName returns the Carvel package name for a Helm chart, which needs to be a
+// fully qualified name, as per: https://carvel.dev/kapp-controller/docs/v0.38.0/packaging/#package-metadata
+func helmPkgRefName(repoName, chartName string) string {
+	return strings.ToLower(fmt.Sprintf("%s.%s.%s", chartName, repoName, HELM_PKG_REF_NAME_SUFFIX))
+}
+
+// toYAMLManifest returns a multi-document YAML manifest with the given objects,
+// dropping the empty fields that are otherwise serialized as null.
+func toYAMLManifest(objects ...interface{}) (string, error) {
+	documents := []string{}
+	for _, object := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		if err != nil {
+			return "", err
+		}
+		unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(content, "spec", "releasedAt")
+		if valuesSchema, found, _ := unstructured.NestedFieldNoCopy(content, "spec", "valuesSchema", "openAPIv3"); found && valuesSchema == nil {
+			unstructured.RemoveNestedField(content, "spec", "valuesSchema")
+		}
+		document, err := yaml.Marshal(content)
+		if err != nil {
+			return "", err
+		}
+		documents = append(documents, string(document))
+	}
+	return strings.Join(documents, "---\n"), nil
+}
+
+// copyAuthorizationMetadataForOutgoing copies the authorization header of the incoming request
+// so that the requests to other plugins are performed on behalf of the same user.
+func copyAuthorizationMetadataForOutgoing(ctx context.Context) (context.Context, error) {
+	notAllowedErr := status.Errorf(codes.PermissionDenied, "unable to get authorization from request context")
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, notAllowedErr
+	}
+	if len(md["authorization"]) == 0 {
+		return nil, notAllowedErr
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, "authorization", md["authorization"][0]), nil
+}
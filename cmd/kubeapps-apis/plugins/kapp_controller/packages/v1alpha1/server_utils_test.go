@@ -12,9 +12,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	kappctrlv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/kappctrl/v1alpha1"
+	packagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apis/packaging/v1alpha1"
 	datapackagingv1alpha1 "github.com/vmware-tanzu/carvel-kapp-controller/pkg/apiserver/apis/datapackaging/v1alpha1"
+	kappctrlpackageinstall "github.com/vmware-tanzu/carvel-kapp-controller/pkg/packageinstall"
 	vendirversions "github.com/vmware-tanzu/carvel-vendir/pkg/vendir/versions/v1alpha1"
 	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	kappcorev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/kapp_controller/packages/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -830,3 +833,57 @@ func TestFilterMetadatas(t *testing.T) {
 	}
 
 }
+
+func TestInstalledPackageCustomDetail(t *testing.T) {
+	pkgInstall := &packagingv1alpha1.PackageInstall{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-installation",
+			Annotations: map[string]string{
+				kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey + ".1": "second-overlay",
+				kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey:        "first-overlay",
+				kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey + ".2": "third-overlay",
+				kappctrlpackageinstall.DowngradableAnnKey:                     "",
+			},
+		},
+		Spec: packagingv1alpha1.PackageInstallSpec{
+			Values: []packagingv1alpha1.PackageInstallValues{
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-installation-default-values"}},
+				{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-values", Key: "values.yaml"}},
+			},
+		},
+	}
+
+	expectedDetail := &kappcorev1.InstalledPackageCustomDetail{
+		ValuesSecrets:  []*kappcorev1.PackageInstallValuesSecretRef{{Name: "my-values", Key: "values.yaml"}},
+		OverlaySecrets: []string{"first-overlay", "second-overlay", "third-overlay"},
+	}
+	if got, want := toInstalledPackageCustomDetail(pkgInstall), expectedDetail; !cmp.Equal(want, got, ignoreUnexported) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoreUnexported))
+	}
+
+	toPkgInstallCustomDetail(&kappcorev1.InstalledPackageCustomDetail{
+		ValuesSecrets:  []*kappcorev1.PackageInstallValuesSecretRef{{Name: "other-values"}},
+		OverlaySecrets: []string{"other-overlay"},
+	}, pkgInstall)
+
+	expectedValues := []packagingv1alpha1.PackageInstallValues{
+		{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "my-installation-default-values"}},
+		{SecretRef: &packagingv1alpha1.PackageInstallValuesSecretRef{Name: "other-values"}},
+	}
+	if got, want := pkgInstall.Spec.Values, expectedValues; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	expectedAnnotations := map[string]string{
+		kappctrlpackageinstall.ExtYttPathsFromSecretNameAnnKey + ".000": "other-overlay",
+		kappctrlpackageinstall.DowngradableAnnKey:                       "",
+	}
+	if got, want := pkgInstall.GetAnnotations(), expectedAnnotations; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	toPkgInstallCustomDetail(&kappcorev1.InstalledPackageCustomDetail{}, pkgInstall)
+	if got := toInstalledPackageCustomDetail(pkgInstall); got != nil {
+		t.Errorf("expected no custom detail, got: %+v", got)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/core"
 	"github.com/vmware-tanzu/kubeapps/pkg/agent"
+	kubeappsKube "github.com/vmware-tanzu/kubeapps/pkg/kube"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"helm.sh/helm/v3/pkg/action"
@@ -133,8 +134,8 @@ func NewBackgroundClientGetter(configGetter core.KubernetesConfigGetter, options
 		if configGetter == nil {
 			return nil, status.Errorf(codes.Internal, "configGetter arg required")
 		}
-		// The Flux plugin currently supports interactions with the default (kubeapps)
-		// cluster only:
+		// this is only for the default (kubeapps) cluster, see
+		// NewBackgroundClientGetterForCluster for the others
 		if config, err := rest.InClusterConfig(); err != nil {
 			code := codes.FailedPrecondition
 			if status.Code(err) == codes.Unauthenticated {
@@ -148,6 +149,35 @@ func NewBackgroundClientGetter(configGetter core.KubernetesConfigGetter, options
 	}
 }
 
+// NewBackgroundClientGetterForCluster is like NewBackgroundClientGetter but
+// for any of the configured clusters. Out-of-request interactions with an
+// additional cluster use the service token configured for that cluster, since
+// the kubeapps-apis service account only exists on the cluster on which Kubeapps
+// is installed
+func NewBackgroundClientGetterForCluster(configGetter core.KubernetesConfigGetter, clustersConfig kubeappsKube.ClustersConfig, cluster string, options Options) BackgroundClientGetterFunc {
+	if cluster == "" || cluster == clustersConfig.KubeappsClusterName {
+		return NewBackgroundClientGetter(configGetter, options)
+	}
+	return func(ctx context.Context) (ClientInterfaces, error) {
+		clusterConfig, ok := clustersConfig.Clusters[cluster]
+		if !ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "cluster [%s] has no configuration", cluster)
+		} else if clusterConfig.ServiceToken == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "cluster [%s] has no service token configured", cluster)
+		}
+		inClusterConfig, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "unable to get in cluster config due to: %v", err)
+		}
+		config, err := kubeappsKube.NewClusterConfig(inClusterConfig, "", cluster, clustersConfig)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "unable to get config for cluster [%s] due to: %v", cluster, err)
+		}
+		config.BearerToken = clusterConfig.ServiceToken
+		return clientGetterHelper(config, options)
+	}
+}
+
 // just a convenience func as a shortcut to get API Extension client in one line
 func (cg BackgroundClientGetterFunc) ApiExt(ctx context.Context) (apiext.Interface, error) {
 	if clientInterfaces, err := cg(ctx); err != nil {
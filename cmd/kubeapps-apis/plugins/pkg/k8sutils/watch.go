@@ -0,0 +1,105 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sutils
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchutil "k8s.io/client-go/tools/watch"
+)
+
+// listWatcher is a watch.Interface which first sends an ADDED event for each
+// listed resource, followed by the events of a RetryWatcher started at the
+// resourceVersion of the list.
+type listWatcher struct {
+	result       chan watch.Event
+	retryWatcher *watchutil.RetryWatcher
+	stopCh       chan struct{}
+	stopOnce     sync.Once
+}
+
+// NewListWatcher returns a watch which first sends an ADDED event for each of
+// the resources returned by listFunc, followed by the changes since that list.
+// The changes are watched with a RetryWatcher, so the watch is re-established
+// from the last received resourceVersion whenever the API server closes it,
+// such as on its regular watch timeout. The result channel is only closed when
+// the watch is stopped or when the RetryWatcher gives up, such as when the
+// resourceVersion is too old (HTTP 410 GONE), in which case an error event is
+// sent first.
+func NewListWatcher(listFunc cache.ListFunc, watchFunc cache.WatchFunc) (watch.Interface, error) {
+	list, err := listFunc(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	retryWatcher, err := watchutil.NewRetryWatcher(listMeta.GetResourceVersion(), &cache.ListWatch{WatchFunc: watchFunc})
+	if err != nil {
+		return nil, err
+	}
+
+	w := &listWatcher{
+		result:       make(chan watch.Event),
+		retryWatcher: retryWatcher,
+		stopCh:       make(chan struct{}),
+	}
+	go w.run(items)
+	return w, nil
+}
+
+func (w *listWatcher) run(items []runtime.Object) {
+	defer close(w.result)
+	defer func() {
+		w.retryWatcher.Stop()
+		<-w.retryWatcher.Done()
+	}()
+
+	for _, item := range items {
+		if !w.send(watch.Event{Type: watch.Added, Object: item}) {
+			return
+		}
+	}
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case event, ok := <-w.retryWatcher.ResultChan():
+			if !ok || !w.send(event) {
+				return
+			}
+		}
+	}
+}
+
+func (w *listWatcher) send(event watch.Event) bool {
+	select {
+	case w.result <- event:
+		return true
+	case <-w.stopCh:
+		return false
+	}
+}
+
+// ResultChan implements watch.Interface.
+func (w *listWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+// Stop implements watch.Interface.
+func (w *listWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+	})
+}
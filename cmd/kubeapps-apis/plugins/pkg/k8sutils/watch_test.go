@@ -0,0 +1,219 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sutils
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// fakeWatches records the options of each watch, returning a new fake
+// watcher each time.
+type fakeWatches struct {
+	mu       sync.Mutex
+	options  []metav1.ListOptions
+	watchers chan *watch.FakeWatcher
+}
+
+func newFakeWatches() *fakeWatches {
+	return &fakeWatches{watchers: make(chan *watch.FakeWatcher, 10)}
+}
+
+func (f *fakeWatches) watch(options metav1.ListOptions) (watch.Interface, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.options = append(f.options, options)
+	watcher := watch.NewFakeWithChanSize(10, false)
+	f.watchers <- watcher
+	return watcher, nil
+}
+
+func (f *fakeWatches) next(t *testing.T) *watch.FakeWatcher {
+	select {
+	case watcher := <-f.watchers:
+		return watcher
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for a watch")
+		return nil
+	}
+}
+
+func (f *fakeWatches) resourceVersions() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var resourceVersions []string
+	for _, options := range f.options {
+		resourceVersions = append(resourceVersions, options.ResourceVersion)
+	}
+	return resourceVersions
+}
+
+func newSecret(name, resourceVersion string) *k8scorev1.Secret {
+	return &k8scorev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            name,
+			ResourceVersion: resourceVersion,
+		},
+	}
+}
+
+func listSecrets(resourceVersion string, secrets ...*k8scorev1.Secret) func(metav1.ListOptions) (runtime.Object, error) {
+	return func(metav1.ListOptions) (runtime.Object, error) {
+		list := &k8scorev1.SecretList{ListMeta: metav1.ListMeta{ResourceVersion: resourceVersion}}
+		for _, s := range secrets {
+			list.Items = append(list.Items, *s)
+		}
+		return list, nil
+	}
+}
+
+type receivedEvent struct {
+	Type watch.EventType
+	Name string
+}
+
+func receive(t *testing.T, w watch.Interface) (receivedEvent, bool) {
+	select {
+	case event, ok := <-w.ResultChan():
+		if !ok {
+			return receivedEvent{}, false
+		}
+		if event.Type == watch.Error {
+			return receivedEvent{Type: event.Type}, true
+		}
+		return receivedEvent{Type: event.Type, Name: event.Object.(*k8scorev1.Secret).Name}, true
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for an event")
+		return receivedEvent{}, false
+	}
+}
+
+func TestNewListWatcher(t *testing.T) {
+	t.Run("it sends the listed resources followed by the changes since the list", func(t *testing.T) {
+		watches := newFakeWatches()
+		w, err := NewListWatcher(listSecrets("10", newSecret("secret-1", "5"), newSecret("secret-2", "10")), watches.watch)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		defer w.Stop()
+
+		watcher := watches.next(t)
+		watcher.Modify(newSecret("secret-1", "11"))
+		watcher.Add(newSecret("secret-3", "12"))
+		watcher.Delete(newSecret("secret-2", "13"))
+
+		var got []receivedEvent
+		for i := 0; i < 5; i++ {
+			event, ok := receive(t, w)
+			if !ok {
+				t.Fatalf("the watch was closed")
+			}
+			got = append(got, event)
+		}
+		want := []receivedEvent{
+			{watch.Added, "secret-1"},
+			{watch.Added, "secret-2"},
+			{watch.Modified, "secret-1"},
+			{watch.Added, "secret-3"},
+			{watch.Deleted, "secret-2"},
+		}
+		if !cmp.Equal(got, want) {
+			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+		}
+		if got, want := watches.resourceVersions(), []string{"10"}; !cmp.Equal(got, want) {
+			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+		}
+	})
+
+	t.Run("it re-establishes the watch from the last resource version when closed", func(t *testing.T) {
+		watches := newFakeWatches()
+		w, err := NewListWatcher(listSecrets("10"), watches.watch)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		defer w.Stop()
+
+		watcher := watches.next(t)
+		watcher.Add(newSecret("secret-1", "11"))
+		if got, want := mustReceive(t, w), (receivedEvent{watch.Added, "secret-1"}); got != want {
+			t.Errorf("got: %+v, want: %+v", got, want)
+		}
+
+		// The API server closes the watch, such as on its regular timeout.
+		watcher.Stop()
+
+		watcher = watches.next(t)
+		watcher.Modify(newSecret("secret-1", "12"))
+		if got, want := mustReceive(t, w), (receivedEvent{watch.Modified, "secret-1"}); got != want {
+			t.Errorf("got: %+v, want: %+v", got, want)
+		}
+		if got, want := watches.resourceVersions(), []string{"10", "11"}; !cmp.Equal(got, want) {
+			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+		}
+	})
+
+	t.Run("it sends an error and closes the watch when the resource version is too old", func(t *testing.T) {
+		watches := newFakeWatches()
+		w, err := NewListWatcher(listSecrets("10"), watches.watch)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		defer w.Stop()
+
+		watcher := watches.next(t)
+		watcher.Error(&metav1.Status{
+			Status: metav1.StatusFailure,
+			Code:   http.StatusGone,
+			Reason: metav1.StatusReasonGone,
+		})
+
+		if got, want := mustReceive(t, w), (receivedEvent{Type: watch.Error}); got != want {
+			t.Errorf("got: %+v, want: %+v", got, want)
+		}
+		if _, ok := receive(t, w); ok {
+			t.Errorf("expected the watch to be closed")
+		}
+	})
+
+	t.Run("it closes the watch when stopped", func(t *testing.T) {
+		watches := newFakeWatches()
+		w, err := NewListWatcher(listSecrets("10", newSecret("secret-1", "5")), watches.watch)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+
+		w.Stop()
+
+		// The listed resource may or may not be sent before the watch is closed.
+		for {
+			if _, ok := receive(t, w); !ok {
+				break
+			}
+		}
+	})
+
+	t.Run("it returns an error when the list has no resource version", func(t *testing.T) {
+		watches := newFakeWatches()
+		if _, err := NewListWatcher(listSecrets(""), watches.watch); err == nil {
+			t.Errorf("expected an error but got none")
+		}
+	})
+}
+
+func mustReceive(t *testing.T, w watch.Interface) receivedEvent {
+	event, ok := receive(t, w)
+	if !ok {
+		t.Fatalf("the watch was closed")
+	}
+	return event
+}
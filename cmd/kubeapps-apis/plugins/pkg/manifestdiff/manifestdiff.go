@@ -0,0 +1,179 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package manifestdiff compares rendered yaml manifests resource by resource,
+// so that plugins can show what an update would change before applying it.
+package manifestdiff
+
+import (
+	goerrs "errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// documentSeparator matches the separator between yaml documents, as used
+// by helm when splitting a release manifest.
+var documentSeparator = regexp.MustCompile(`(?:^|\s*\n)---\s*`)
+
+type yamlMetadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+type yamlResource struct {
+	APIVersion string       `json:"apiVersion"`
+	Kind       string       `json:"kind"`
+	Metadata   yamlMetadata `json:"metadata"`
+}
+
+// resource is a single resource of a manifest together with the yaml
+// document from which it was decoded.
+type resource struct {
+	ref      *corev1.ResourceRef
+	document string
+}
+
+// ResourceDiffs returns the differences between the current and the desired
+// manifests for each resource which would be added, modified or removed. The
+// diffs are sorted by the kind, namespace and name of the resources.
+func ResourceDiffs(current, desired, pkgNamespace string) ([]*corev1.ResourceDiff, error) {
+	currentResources, err := resourcesFromManifest(current, pkgNamespace)
+	if err != nil {
+		return nil, err
+	}
+	desiredResources, err := resourcesFromManifest(desired, pkgNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := []*corev1.ResourceDiff{}
+	for key, d := range desiredResources {
+		c, ok := currentResources[key]
+		if !ok {
+			diffs = append(diffs, &corev1.ResourceDiff{
+				ResourceRef: d.ref,
+				ChangeType:  corev1.ResourceDiff_CHANGE_TYPE_ADDED,
+				Diff:        lineDiff("", d.document),
+			})
+		} else if c.document != d.document {
+			diffs = append(diffs, &corev1.ResourceDiff{
+				ResourceRef: d.ref,
+				ChangeType:  corev1.ResourceDiff_CHANGE_TYPE_MODIFIED,
+				Diff:        lineDiff(c.document, d.document),
+			})
+		}
+	}
+	for key, c := range currentResources {
+		if _, ok := desiredResources[key]; !ok {
+			diffs = append(diffs, &corev1.ResourceDiff{
+				ResourceRef: c.ref,
+				ChangeType:  corev1.ResourceDiff_CHANGE_TYPE_REMOVED,
+				Diff:        lineDiff(c.document, ""),
+			})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return resourceKey(diffs[i].ResourceRef) < resourceKey(diffs[j].ResourceRef)
+	})
+	return diffs, nil
+}
+
+// resourcesFromManifest returns the resources of a yaml manifest keyed by
+// their kind, namespace and name. Documents without a kind, such as those
+// only containing comments, are ignored.
+func resourcesFromManifest(m, pkgNamespace string) (map[string]resource, error) {
+	resources := map[string]resource{}
+	for _, document := range documentSeparator.Split(m, -1) {
+		document = strings.TrimSpace(document)
+		if document == "" {
+			continue
+		}
+		doc := yamlResource{}
+		err := yaml.NewYAMLToJSONDecoder(strings.NewReader(document)).Decode(&doc)
+		if err != nil {
+			if goerrs.Is(err, io.EOF) {
+				continue
+			}
+			return nil, status.Errorf(codes.Internal, "Unable to decode yaml manifest: %v", err)
+		}
+		if doc.Kind == "" {
+			continue
+		}
+		namespace := doc.Metadata.Namespace
+		if namespace == "" {
+			namespace = pkgNamespace
+		}
+		ref := &corev1.ResourceRef{
+			ApiVersion: doc.APIVersion,
+			Kind:       doc.Kind,
+			Name:       doc.Metadata.Name,
+			Namespace:  namespace,
+		}
+		resources[resourceKey(ref)] = resource{ref: ref, document: document}
+	}
+	return resources, nil
+}
+
+// resourceKey identifies a resource independently of its api version, so
+// that a resource moving to a new api version is reported as modified.
+func resourceKey(ref *corev1.ResourceRef) string {
+	return fmt.Sprintf("%s/%s/%s", ref.Kind, ref.Namespace, ref.Name)
+}
+
+// lineDiff returns a line-based diff of two texts, based on their longest
+// common subsequence of lines.
+func lineDiff(a, b string) string {
+	aLines, bLines := splitLines(a), splitLines(b)
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// aLines[i:] and bLines[j:].
+	lcs := make([][]int, len(aLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bLines)+1)
+	}
+	for i := len(aLines) - 1; i >= 0; i-- {
+		for j := len(bLines) - 1; j >= 0; j-- {
+			if aLines[i] == bLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var sb strings.Builder
+	i, j := 0, 0
+	for i < len(aLines) || j < len(bLines) {
+		switch {
+		case i < len(aLines) && j < len(bLines) && aLines[i] == bLines[j]:
+			sb.WriteString(" " + aLines[i] + "\n")
+			i++
+			j++
+		case i < len(aLines) && (j == len(bLines) || lcs[i+1][j] >= lcs[i][j+1]):
+			sb.WriteString("-" + aLines[i] + "\n")
+			i++
+		default:
+			sb.WriteString("+" + bLines[j] + "\n")
+			j++
+		}
+	}
+	return sb.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
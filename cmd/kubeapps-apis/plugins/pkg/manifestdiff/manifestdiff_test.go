@@ -0,0 +1,185 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifestdiff
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+)
+
+func TestResourceDiffs(t *testing.T) {
+	const (
+		configMap = `# Source: my-chart/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+data:
+  key: value`
+		configMapUpdated = `# Source: my-chart/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+data:
+  key: other-value`
+		deployment = `# Source: my-chart/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-deployment
+  namespace: other-namespace`
+		service = `# Source: my-chart/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-service`
+	)
+
+	testCases := []struct {
+		name          string
+		current       string
+		desired       string
+		expectedDiffs []*corev1.ResourceDiff
+	}{
+		{
+			name:          "returns no diffs for identical manifests",
+			current:       "---\n" + configMap + "\n---\n" + service,
+			desired:       "---\n" + configMap + "\n---\n" + service,
+			expectedDiffs: []*corev1.ResourceDiff{},
+		},
+		{
+			name:    "returns a diff for a modified resource",
+			current: "---\n" + configMap + "\n---\n" + service,
+			desired: "---\n" + configMapUpdated + "\n---\n" + service,
+			expectedDiffs: []*corev1.ResourceDiff{
+				{
+					ResourceRef: &corev1.ResourceRef{
+						ApiVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "my-config",
+						Namespace:  "default",
+					},
+					ChangeType: corev1.ResourceDiff_CHANGE_TYPE_MODIFIED,
+					Diff: ` # Source: my-chart/templates/configmap.yaml
+ apiVersion: v1
+ kind: ConfigMap
+ metadata:
+   name: my-config
+ data:
+-  key: value
++  key: other-value
+`,
+				},
+			},
+		},
+		{
+			name:    "returns diffs for added and removed resources",
+			current: "---\n" + configMap + "\n---\n" + service,
+			desired: "---\n" + configMap + "\n---\n" + deployment,
+			expectedDiffs: []*corev1.ResourceDiff{
+				{
+					ResourceRef: &corev1.ResourceRef{
+						ApiVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-deployment",
+						Namespace:  "other-namespace",
+					},
+					ChangeType: corev1.ResourceDiff_CHANGE_TYPE_ADDED,
+					Diff: `+# Source: my-chart/templates/deployment.yaml
++apiVersion: apps/v1
++kind: Deployment
++metadata:
++  name: my-deployment
++  namespace: other-namespace
+`,
+				},
+				{
+					ResourceRef: &corev1.ResourceRef{
+						ApiVersion: "v1",
+						Kind:       "Service",
+						Name:       "my-service",
+						Namespace:  "default",
+					},
+					ChangeType: corev1.ResourceDiff_CHANGE_TYPE_REMOVED,
+					Diff: `-# Source: my-chart/templates/service.yaml
+-apiVersion: v1
+-kind: Service
+-metadata:
+-  name: my-service
+`,
+				},
+			},
+		},
+		{
+			name:    "returns all resources as added for an empty current manifest",
+			current: "",
+			desired: service,
+			expectedDiffs: []*corev1.ResourceDiff{
+				{
+					ResourceRef: &corev1.ResourceRef{
+						ApiVersion: "v1",
+						Kind:       "Service",
+						Name:       "my-service",
+						Namespace:  "default",
+					},
+					ChangeType: corev1.ResourceDiff_CHANGE_TYPE_ADDED,
+					Diff: `+# Source: my-chart/templates/service.yaml
++apiVersion: v1
++kind: Service
++metadata:
++  name: my-service
+`,
+				},
+			},
+		},
+	}
+
+	opts := cmpopts.IgnoreUnexported(corev1.ResourceDiff{}, corev1.ResourceRef{})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diffs, err := ResourceDiffs(tc.current, tc.desired, "default")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got, want := diffs, tc.expectedDiffs; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
+func TestLineDiff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        string
+		b        string
+		expected string
+	}{
+		{
+			name:     "returns unchanged lines prefixed with a space",
+			a:        "a\nb",
+			b:        "a\nb",
+			expected: " a\n b\n",
+		},
+		{
+			name:     "returns removed lines before added lines",
+			a:        "a\nb\nc",
+			b:        "a\nd\nc\ne",
+			expected: " a\n-b\n+d\n c\n+e\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := lineDiff(tc.a, tc.b), tc.expected; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pkgfilter
+
+import (
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+)
+
+// IsEmpty returns true if the filter options do not filter out any installed
+// package, so that callers can keep using a more efficient code path.
+func IsEmpty(filter *corev1.InstalledPackageFilterOptions) bool {
+	return filter.GetQuery() == "" &&
+		len(filter.GetStatusReasons()) == 0 &&
+		len(filter.GetPlugins()) == 0 &&
+		len(filter.GetPkgNames()) == 0 &&
+		!filter.GetUpgradeAvailable()
+}
+
+// MatchesPluginName returns true if installed packages managed by the named
+// plugin can match the filter options.
+func MatchesPluginName(filter *corev1.InstalledPackageFilterOptions, pluginName string) bool {
+	if len(filter.GetPlugins()) == 0 {
+		return true
+	}
+	for _, p := range filter.GetPlugins() {
+		if p.GetName() == pluginName {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesInstalledPackageSummary returns true if the installed package
+// summary matches all of the filter options.
+func MatchesInstalledPackageSummary(filter *corev1.InstalledPackageFilterOptions, summary *corev1.InstalledPackageSummary) bool {
+	if query := filter.GetQuery(); query != "" && !strings.Contains(strings.ToLower(summary.GetName()), strings.ToLower(query)) {
+		return false
+	}
+
+	if reasons := filter.GetStatusReasons(); len(reasons) > 0 {
+		matched := false
+		for _, reason := range reasons {
+			if summary.GetStatus().GetReason() == reason {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if !MatchesPluginName(filter, summary.GetInstalledPackageRef().GetPlugin().GetName()) {
+		return false
+	}
+
+	if pkgNames := filter.GetPkgNames(); len(pkgNames) > 0 {
+		matched := false
+		for _, pkgName := range pkgNames {
+			if strings.EqualFold(summary.GetPkgDisplayName(), pkgName) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if filter.GetUpgradeAvailable() && !IsUpgradeAvailable(summary) {
+		return false
+	}
+
+	return true
+}
+
+// IsUpgradeAvailable returns true if the latest version of the installed
+// package is greater than its current version. Versions which are not valid
+// semantic versions are never considered upgrades.
+func IsUpgradeAvailable(summary *corev1.InstalledPackageSummary) bool {
+	latest, err := semver.NewVersion(summary.GetLatestVersion().GetPkgVersion())
+	if err != nil {
+		return false
+	}
+	current, err := semver.NewVersion(summary.GetCurrentVersion().GetPkgVersion())
+	if err != nil {
+		return false
+	}
+	return latest.GreaterThan(current)
+}
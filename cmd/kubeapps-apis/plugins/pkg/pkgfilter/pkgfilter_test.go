@@ -0,0 +1,177 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package pkgfilter
+
+import (
+	"testing"
+
+	corev1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+)
+
+func makeSummary(name, pkgName, current, latest string, reason corev1.InstalledPackageStatus_StatusReason) *corev1.InstalledPackageSummary {
+	return &corev1.InstalledPackageSummary{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Identifier: name,
+			Plugin:     &plugins.Plugin{Name: "helm.packages", Version: "v1alpha1"},
+		},
+		Name:           name,
+		PkgDisplayName: pkgName,
+		CurrentVersion: &corev1.PackageAppVersion{PkgVersion: current},
+		LatestVersion:  &corev1.PackageAppVersion{PkgVersion: latest},
+		Status:         &corev1.InstalledPackageStatus{Reason: reason},
+	}
+}
+
+func TestIsEmpty(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filter   *corev1.InstalledPackageFilterOptions
+		expected bool
+	}{
+		{
+			name:     "nil filter options",
+			filter:   nil,
+			expected: true,
+		},
+		{
+			name:     "empty filter options",
+			filter:   &corev1.InstalledPackageFilterOptions{},
+			expected: true,
+		},
+		{
+			name:     "a query",
+			filter:   &corev1.InstalledPackageFilterOptions{Query: "foo"},
+			expected: false,
+		},
+		{
+			name:     "upgrade available",
+			filter:   &corev1.InstalledPackageFilterOptions{UpgradeAvailable: true},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := IsEmpty(tc.filter), tc.expected; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
+
+func TestMatchesInstalledPackageSummary(t *testing.T) {
+	summary := makeSummary("my-Apache", "apache", "1.0.0", "1.2.0", corev1.InstalledPackageStatus_STATUS_REASON_FAILED)
+	upToDate := makeSummary("my-apache", "apache", "1.2.0", "1.2.0", corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED)
+
+	testCases := []struct {
+		name     string
+		filter   *corev1.InstalledPackageFilterOptions
+		summary  *corev1.InstalledPackageSummary
+		expected bool
+	}{
+		{
+			name:     "nil filter options match",
+			filter:   nil,
+			summary:  summary,
+			expected: true,
+		},
+		{
+			name:     "query matches case-insensitively",
+			filter:   &corev1.InstalledPackageFilterOptions{Query: "apa"},
+			summary:  summary,
+			expected: true,
+		},
+		{
+			name:     "query does not match",
+			filter:   &corev1.InstalledPackageFilterOptions{Query: "nginx"},
+			summary:  summary,
+			expected: false,
+		},
+		{
+			name: "status reason matches one of several",
+			filter: &corev1.InstalledPackageFilterOptions{
+				StatusReasons: []corev1.InstalledPackageStatus_StatusReason{
+					corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+					corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
+				},
+			},
+			summary:  summary,
+			expected: true,
+		},
+		{
+			name: "status reason does not match",
+			filter: &corev1.InstalledPackageFilterOptions{
+				StatusReasons: []corev1.InstalledPackageStatus_StatusReason{
+					corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+				},
+			},
+			summary:  summary,
+			expected: false,
+		},
+		{
+			name: "plugin matches",
+			filter: &corev1.InstalledPackageFilterOptions{
+				Plugins: []*plugins.Plugin{{Name: "helm.packages"}},
+			},
+			summary:  summary,
+			expected: true,
+		},
+		{
+			name: "plugin does not match",
+			filter: &corev1.InstalledPackageFilterOptions{
+				Plugins: []*plugins.Plugin{{Name: "fluxv2.packages"}},
+			},
+			summary:  summary,
+			expected: false,
+		},
+		{
+			name:     "package name matches case-insensitively",
+			filter:   &corev1.InstalledPackageFilterOptions{PkgNames: []string{"nginx", "Apache"}},
+			summary:  summary,
+			expected: true,
+		},
+		{
+			name:     "package name does not match",
+			filter:   &corev1.InstalledPackageFilterOptions{PkgNames: []string{"nginx"}},
+			summary:  summary,
+			expected: false,
+		},
+		{
+			name:     "upgrade available",
+			filter:   &corev1.InstalledPackageFilterOptions{UpgradeAvailable: true},
+			summary:  summary,
+			expected: true,
+		},
+		{
+			name:     "no upgrade available",
+			filter:   &corev1.InstalledPackageFilterOptions{UpgradeAvailable: true},
+			summary:  upToDate,
+			expected: false,
+		},
+		{
+			name:     "no upgrade available without a latest version",
+			filter:   &corev1.InstalledPackageFilterOptions{UpgradeAvailable: true},
+			summary:  makeSummary("my-apache", "apache", "1.0.0", "", corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED),
+			expected: false,
+		},
+		{
+			name: "all filters must match",
+			filter: &corev1.InstalledPackageFilterOptions{
+				Query:            "apache",
+				UpgradeAvailable: true,
+			},
+			summary:  upToDate,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := MatchesInstalledPackageSummary(tc.filter, tc.summary), tc.expected; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package valuesschema validates the values supplied when installing or
+// updating a package against the values schema of the package, so that
+// invalid values are rejected before any resource is created or updated.
+package valuesschema
+
+import (
+	"fmt"
+
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/yaml"
+)
+
+// rootField is the field reported by gojsonschema for the values themselves.
+const rootField = "(root)"
+
+// Validate checks the given yaml or json values, merged over the default
+// values of the package, against the values schema of the package. The
+// schema can be either a json or a yaml document.
+//
+// An InvalidArgument error is returned if the values cannot be parsed or do
+// not satisfy the schema, in which case the error details include a
+// BadRequest listing a violation for each invalid field. Nothing is
+// validated if the package has no schema.
+func Validate(values string, defaults map[string]interface{}, schema []byte) error {
+	if len(schema) == 0 {
+		return nil
+	}
+	schemaJSON, err := yaml.YAMLToJSON(schema)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to parse the values schema of the package: %v", err)
+	}
+
+	userValues := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(values), &userValues); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Unable to parse the values: %v", err)
+	}
+
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewBytesLoader(schemaJSON),
+		gojsonschema.NewGoLoader(mergeValues(defaults, userValues)),
+	)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to validate the values against the values schema of the package: %v", err)
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(result.Errors()))
+	for _, e := range result.Errors() {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       fieldPath(e),
+			Description: e.Description(),
+		})
+	}
+	st, err := status.New(codes.InvalidArgument, "The values are invalid according to the values schema of the package").
+		WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to add the field violations to the error: %v", err)
+	}
+	return st.Err()
+}
+
+// fieldPath returns the path of the invalid field within the values, such
+// as "values.image.tag". Missing required properties are reported by
+// gojsonschema on the parent object, so the missing property is appended.
+func fieldPath(e gojsonschema.ResultError) string {
+	field := "values"
+	if e.Field() != rootField {
+		field = fmt.Sprintf("%s.%s", field, e.Field())
+	}
+	if e.Type() == "required" {
+		if property, ok := e.Details()["property"]; ok {
+			field = fmt.Sprintf("%s.%v", field, property)
+		}
+	}
+	return field
+}
+
+// mergeValues returns the defaults recursively overridden by the values,
+// without modifying either of them.
+func mergeValues(defaults, values map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaults)+len(values))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range values {
+		if valueMap, ok := v.(map[string]interface{}); ok {
+			if defaultMap, ok := merged[k].(map[string]interface{}); ok {
+				merged[k] = mergeValues(defaultMap, valueMap)
+				continue
+			}
+		}
+		merged[k] = v
+	}
+	return merged
+}
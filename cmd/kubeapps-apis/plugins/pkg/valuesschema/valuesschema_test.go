@@ -0,0 +1,191 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package valuesschema
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const jsonSchema = `{
+  "$schema": "http://json-schema.org/schema#",
+  "type": "object",
+  "required": ["image"],
+  "properties": {
+    "replicaCount": {"type": "integer", "minimum": 1},
+    "image": {
+      "type": "object",
+      "required": ["repository"],
+      "properties": {
+        "repository": {"type": "string"},
+        "tag": {"type": "string"}
+      }
+    }
+  }
+}`
+
+const yamlSchema = `properties:
+  replicaCount:
+    type: integer
+    minimum: 1
+`
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name               string
+		values             string
+		defaults           map[string]interface{}
+		schema             string
+		expectedStatusCode codes.Code
+		expectedViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name:               "accepts any values without a schema",
+			values:             "replicaCount: foo",
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "accepts valid yaml values",
+			values:             "replicaCount: 2\nimage:\n  repository: bitnami/apache\n",
+			schema:             jsonSchema,
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "accepts valid json values",
+			values:             `{"image": {"repository": "bitnami/apache", "tag": "2.4"}}`,
+			schema:             jsonSchema,
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:   "accepts values which are valid once merged over the defaults",
+			values: "image:\n  tag: \"2.4\"\n",
+			defaults: map[string]interface{}{
+				"image": map[string]interface{}{
+					"repository": "bitnami/apache",
+				},
+			},
+			schema:             jsonSchema,
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "accepts valid values against a yaml schema",
+			values:             "replicaCount: 2",
+			schema:             yamlSchema,
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "returns invalid argument for values which cannot be parsed",
+			values:             "replicaCount: [",
+			schema:             jsonSchema,
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:               "returns a violation for each invalid field",
+			values:             "replicaCount: 0\nimage:\n  tag: 2\n",
+			schema:             jsonSchema,
+			expectedStatusCode: codes.InvalidArgument,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{
+					Field:       "values.replicaCount",
+					Description: "Must be greater than or equal to 1",
+				},
+				{
+					Field:       "values.image.repository",
+					Description: "repository is required",
+				},
+				{
+					Field:       "values.image.tag",
+					Description: "Invalid type. Expected: string, given: integer",
+				},
+			},
+		},
+		{
+			name:               "returns a violation for a missing top-level field",
+			values:             "replicaCount: 1",
+			schema:             jsonSchema,
+			expectedStatusCode: codes.InvalidArgument,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{
+					Field:       "values.image",
+					Description: "image is required",
+				},
+			},
+		},
+		{
+			name:               "returns a violation against a yaml schema",
+			values:             "replicaCount: 0",
+			schema:             yamlSchema,
+			expectedStatusCode: codes.InvalidArgument,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{
+					Field:       "values.replicaCount",
+					Description: "Must be greater than or equal to 1",
+				},
+			},
+		},
+	}
+
+	opts := []cmp.Option{
+		cmpopts.IgnoreUnexported(errdetails.BadRequest_FieldViolation{}),
+		cmpopts.SortSlices(func(a, b *errdetails.BadRequest_FieldViolation) bool {
+			return a.Field < b.Field
+		}),
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.values, tc.defaults, []byte(tc.schema))
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			var violations []*errdetails.BadRequest_FieldViolation
+			for _, detail := range status.Convert(err).Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					violations = append(violations, badRequest.GetFieldViolations()...)
+				}
+			}
+			if got, want := violations, tc.expectedViolations; !cmp.Equal(want, got, opts...) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts...))
+			}
+		})
+	}
+}
+
+func TestMergeValues(t *testing.T) {
+	defaults := map[string]interface{}{
+		"replicaCount": 1,
+		"image": map[string]interface{}{
+			"repository": "bitnami/apache",
+			"tag":        "2.4",
+		},
+	}
+	values := map[string]interface{}{
+		"image": map[string]interface{}{
+			"tag": "2.5",
+		},
+		"service": "ClusterIP",
+	}
+	expected := map[string]interface{}{
+		"replicaCount": 1,
+		"image": map[string]interface{}{
+			"repository": "bitnami/apache",
+			"tag":        "2.5",
+		},
+		"service": "ClusterIP",
+	}
+
+	if got, want := mergeValues(defaults, values), expected; !cmp.Equal(want, got) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	// The defaults are left untouched.
+	if got, want := defaults["image"].(map[string]interface{})["tag"], "2.4"; got != want {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
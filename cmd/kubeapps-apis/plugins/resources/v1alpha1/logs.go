@@ -0,0 +1,311 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"sort"
+	"sync"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	log "k8s.io/klog/v2"
+)
+
+// maxLogLineSize is the maximum size of a single log line being streamed,
+// longer lines cause the stream of the container logs to end.
+const maxLogLineSize = 1024 * 1024
+
+// GetInstalledPackageLogs streams the logs of the containers of the pods
+// belonging to the workloads of an installed package.
+func (s *Server) GetInstalledPackageLogs(r *v1alpha1.GetInstalledPackageLogsRequest, stream v1alpha1.ResourcesService_GetInstalledPackageLogsServer) error {
+	namespace := r.GetInstalledPackageRef().GetContext().GetNamespace()
+	cluster := r.GetInstalledPackageRef().GetContext().GetCluster()
+	log.InfoS("+resources GetInstalledPackageLogs ", "cluster", cluster, "namespace", namespace, "id", r.GetInstalledPackageRef().GetIdentifier())
+
+	if r.GetInstalledPackageRef() == nil {
+		return status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+	if r.GetTailLines() < 0 {
+		return status.Errorf(codes.InvalidArgument, "tail lines must be a non-negative number, got: %d", r.GetTailLines())
+	}
+
+	ctx, err := copyAuthorizationMetadataForOutgoing(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	// First we grab the resource references for the specified installed package,
+	// so that the logs are only streamed if the user can access the package.
+	coreClient, err := s.corePackagesClientGetter()
+	if err != nil {
+		return err
+	}
+	refsResponse, err := coreClient.GetInstalledPackageResourceRefs(ctx, &pkgsGRPCv1alpha1.GetInstalledPackageResourceRefsRequest{
+		InstalledPackageRef: r.InstalledPackageRef,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Then find the pods of the referenced workloads.
+	typedClient, _, err := s.clientGetter(stream.Context(), cluster)
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to get the k8s client: '%v'", err)
+	}
+	pods, err := podsForResourceRefs(stream.Context(), typedClient, refsResponse.GetResourceRefs())
+	if err != nil {
+		return err
+	}
+
+	logOptions := &core.PodLogOptions{
+		Container: r.GetContainer(),
+		Follow:    r.GetFollow(),
+	}
+	if r.GetSinceTime() != nil {
+		sinceTime := metav1.NewTime(r.GetSinceTime().AsTime())
+		logOptions.SinceTime = &sinceTime
+	}
+	if r.GetTailLines() > 0 {
+		tailLines := r.GetTailLines()
+		logOptions.TailLines = &tailLines
+	}
+
+	var containerLogs []*containerLog
+	for _, pod := range pods {
+		containers := append(append([]core.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+		for _, container := range containers {
+			if r.GetContainer() != "" && container.Name != r.GetContainer() {
+				continue
+			}
+			containerLogs = append(containerLogs, &containerLog{pod: pod, container: container.Name})
+		}
+	}
+	if r.GetContainer() != "" && len(pods) > 0 && len(containerLogs) == 0 {
+		return status.Errorf(codes.InvalidArgument, "the container %q does not belong to any pod of the installed package", r.GetContainer())
+	}
+
+	// Finally multiplex the logs of every container into the stream.
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	lines, errs := mergeContainerLogs(ctx, typedClient, containerLogs, logOptions)
+	var failed, notStarted int
+	var lastErr error
+	for lines != nil || errs != nil {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				lines = nil
+				continue
+			}
+			if err := stream.Send(line); err != nil {
+				return status.Errorf(codes.Internal, "unable to send GetInstalledPackageLogsResponse: %s", err.Error())
+			}
+		case e, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			switch {
+			case errors.IsForbidden(e.err) || errors.IsUnauthorized(e.err):
+				return statuserror.FromK8sError("get", "logs of the Pod", e.pod.Name, e.err)
+			case errors.IsBadRequest(e.err):
+				// Containers which have not started yet have no logs to stream.
+				log.Infof("skipping the logs of container %q of pod %s/%s: %v", e.container, e.pod.Namespace, e.pod.Name, e.err)
+				notStarted++
+			default:
+				log.Warningf("unable to stream the logs of container %q of pod %s/%s: %v", e.container, e.pod.Namespace, e.pod.Name, e.err)
+				failed++
+				lastErr = e.err
+			}
+		}
+	}
+	if failed > 0 && failed+notStarted == len(containerLogs) {
+		return status.Errorf(codes.Internal, "unable to stream the logs of any container of the installed package: %v", lastErr)
+	}
+
+	return nil
+}
+
+// podsForResourceRefs returns the pods referenced directly or through the
+// selector of a workload in the given resource refs, sorted by namespace and name.
+func podsForResourceRefs(ctx context.Context, typedClient kubernetes.Interface, refs []*pkgsGRPCv1alpha1.ResourceRef) ([]*core.Pod, error) {
+	podsByKey := map[string]*core.Pod{}
+	for _, ref := range refs {
+		groupVersion, err := schema.ParseGroupVersion(ref.GetApiVersion())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to parse group version from %q: %s", ref.GetApiVersion(), err.Error())
+		}
+
+		if groupVersion.Group == core.GroupName && ref.GetKind() == "Pod" {
+			pod, err := typedClient.CoreV1().Pods(ref.GetNamespace()).Get(ctx, ref.GetName(), metav1.GetOptions{})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return nil, statuserror.FromK8sError("get", "Pod", ref.GetName(), err)
+			}
+			podsByKey[pod.Namespace+"/"+pod.Name] = pod
+			continue
+		}
+
+		selector, err := workloadSelector(ctx, typedClient, groupVersion.Group, ref)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, statuserror.FromK8sError("get", ref.GetKind(), ref.GetName(), err)
+		}
+		// Not a workload, or a workload without selector.
+		if selector == nil {
+			continue
+		}
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to parse the selector of %s %q: %s", ref.GetKind(), ref.GetName(), err.Error())
+		}
+		podList, err := typedClient.CoreV1().Pods(ref.GetNamespace()).List(ctx, metav1.ListOptions{
+			LabelSelector: labelSelector.String(),
+		})
+		if err != nil {
+			return nil, statuserror.FromK8sError("list", "Pods", "", err)
+		}
+		for i := range podList.Items {
+			pod := &podList.Items[i]
+			podsByKey[pod.Namespace+"/"+pod.Name] = pod
+		}
+	}
+
+	keys := make([]string, 0, len(podsByKey))
+	for key := range podsByKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pods := make([]*core.Pod, 0, len(keys))
+	for _, key := range keys {
+		pods = append(pods, podsByKey[key])
+	}
+	return pods, nil
+}
+
+// workloadSelector returns the pod selector of the workload referenced by ref,
+// or nil if the referenced resource is not a supported workload.
+func workloadSelector(ctx context.Context, typedClient kubernetes.Interface, group string, ref *pkgsGRPCv1alpha1.ResourceRef) (*metav1.LabelSelector, error) {
+	namespace, name := ref.GetNamespace(), ref.GetName()
+	switch {
+	case group == "apps" && ref.GetKind() == "Deployment":
+		deployment, err := typedClient.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return deployment.Spec.Selector, nil
+	case group == "apps" && ref.GetKind() == "StatefulSet":
+		statefulSet, err := typedClient.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return statefulSet.Spec.Selector, nil
+	case group == "apps" && ref.GetKind() == "DaemonSet":
+		daemonSet, err := typedClient.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return daemonSet.Spec.Selector, nil
+	case group == "apps" && ref.GetKind() == "ReplicaSet":
+		replicaSet, err := typedClient.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return replicaSet.Spec.Selector, nil
+	case group == "batch" && ref.GetKind() == "Job":
+		job, err := typedClient.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return job.Spec.Selector, nil
+	}
+	return nil, nil
+}
+
+// containerLog identifies the logs of a single container of a pod.
+type containerLog struct {
+	pod       *core.Pod
+	container string
+}
+
+// containerLogError is the error returned by the k8s api when requesting the
+// logs of a container.
+type containerLogError struct {
+	*containerLog
+	err error
+}
+
+// mergeContainerLogs streams the logs of every container, returning a single
+// channel with the log lines and another with the containers whose logs could
+// not be requested, both closed once every stream ends.
+// Inspired by the fan-in merge at https://go.dev/blog/pipelines
+func mergeContainerLogs(ctx context.Context, typedClient kubernetes.Interface, containerLogs []*containerLog, logOptions *core.PodLogOptions) (<-chan *v1alpha1.GetInstalledPackageLogsResponse, <-chan containerLogError) {
+	var wg sync.WaitGroup
+	out := make(chan *v1alpha1.GetInstalledPackageLogsResponse)
+	// Buffered so that the output goroutines never block on an error.
+	errs := make(chan containerLogError, len(containerLogs))
+
+	output := func(c *containerLog) {
+		defer wg.Done()
+		options := logOptions.DeepCopy()
+		options.Container = c.container
+		logStream, err := typedClient.CoreV1().Pods(c.pod.Namespace).GetLogs(c.pod.Name, options).Stream(ctx)
+		if err != nil {
+			errs <- containerLogError{containerLog: c, err: err}
+			return
+		}
+		defer logStream.Close()
+
+		podRef := &pkgsGRPCv1alpha1.ResourceRef{
+			ApiVersion: "v1",
+			Kind:       "Pod",
+			Name:       c.pod.Name,
+			Namespace:  c.pod.Namespace,
+		}
+		scanner := bufio.NewScanner(logStream)
+		scanner.Buffer(make([]byte, bufio.MaxScanTokenSize), maxLogLineSize)
+		for scanner.Scan() {
+			select {
+			case out <- &v1alpha1.GetInstalledPackageLogsResponse{
+				PodRef:    podRef,
+				Container: c.container,
+				Line:      scanner.Text(),
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil && ctx.Err() == nil {
+			log.Warningf("unable to read the logs of container %q of pod %s/%s: %v", c.container, c.pod.Namespace, c.pod.Name, err)
+		}
+	}
+	wg.Add(len(containerLogs))
+	for _, c := range containerLogs {
+		go output(c)
+	}
+
+	// Start a goroutine to close out and errs once all the output goroutines
+	// are done.  This must start after the wg.Add call.
+	go func() {
+		wg.Wait()
+		close(errs)
+		close(out)
+	}()
+
+	return out, errs
+}
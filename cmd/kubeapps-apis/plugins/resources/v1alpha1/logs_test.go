@@ -0,0 +1,426 @@
+// Copyright 2022 the Kubeapps contributors.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	fakerest "k8s.io/client-go/rest/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	pkgsGRPCv1alpha1 "github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/gen/plugins/resources/v1alpha1"
+	"github.com/vmware-tanzu/kubeapps/cmd/kubeapps-apis/plugin_test"
+)
+
+// logErrorsClient is a typed client whose log requests fail with the status
+// code set for the container, if any.
+type logErrorsClient struct {
+	kubernetes.Interface
+	statusCodes map[string]int
+}
+
+func (c *logErrorsClient) CoreV1() corev1client.CoreV1Interface {
+	return &logErrorsCoreV1{CoreV1Interface: c.Interface.CoreV1(), statusCodes: c.statusCodes}
+}
+
+type logErrorsCoreV1 struct {
+	corev1client.CoreV1Interface
+	statusCodes map[string]int
+}
+
+func (c *logErrorsCoreV1) Pods(namespace string) corev1client.PodInterface {
+	return &logErrorsPods{PodInterface: c.CoreV1Interface.Pods(namespace), statusCodes: c.statusCodes}
+}
+
+type logErrorsPods struct {
+	corev1client.PodInterface
+	statusCodes map[string]int
+}
+
+func (c *logErrorsPods) GetLogs(name string, opts *core.PodLogOptions) *rest.Request {
+	request := c.PodInterface.GetLogs(name, opts)
+	statusCode, ok := c.statusCodes[opts.Container]
+	if !ok {
+		return request
+	}
+	fakeClient := &fakerest.RESTClient{
+		Client: fakerest.CreateHTTPClient(func(*http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: statusCode,
+				Body:       io.NopCloser(strings.NewReader(http.StatusText(statusCode))),
+			}, nil
+		}),
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		GroupVersion:         core.SchemeGroupVersion,
+	}
+	return fakeClient.Request()
+}
+
+// getLogsClient starts a GRPC server, serving both the resources service,
+// and a test core packages service returning the given resource refs, with
+// a fake typed client prepped with the specified objects. The log requests of
+// the containers in logStatusCodes fail with the given status code.
+func getLogsClient(t *testing.T, refs []*pkgsGRPCv1alpha1.ResourceRef, logStatusCodes map[string]int, objects ...runtime.Object) (v1alpha1.ResourcesServiceClient, *typfake.Clientset, func()) {
+	lis := bufconn.Listen(bufSize)
+	s := grpc.NewServer()
+	bufDialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+
+	pkgsGRPCv1alpha1.RegisterPackagesServiceServer(s, &plugin_test.TestPackagingPluginServer{
+		Plugin:       fakePkgsPlugin,
+		ResourceRefs: refs,
+	})
+
+	fakeTypedClient := typfake.NewSimpleClientset(objects...)
+	v1alpha1.RegisterResourcesServiceServer(s, &Server{
+		clientGetter: func(context.Context, string) (kubernetes.Interface, dynamic.Interface, error) {
+			return &logErrorsClient{Interface: fakeTypedClient, statusCodes: logStatusCodes}, nil, nil
+		},
+		corePackagesClientGetter: func() (pkgsGRPCv1alpha1.PackagesServiceClient, error) {
+			return pkgsGRPCv1alpha1.NewPackagesServiceClient(conn), nil
+		},
+	})
+
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			// Only valid error should be when the listener is closed.
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrClosedPipe) {
+				return
+			}
+		}
+	}()
+
+	return v1alpha1.NewResourcesServiceClient(conn), fakeTypedClient, func() {
+		conn.Close()
+		lis.Close()
+	}
+}
+
+func TestGetInstalledPackageLogs(t *testing.T) {
+	deployment := &apps.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-deployment", Namespace: "default"},
+		Spec: apps.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "some-app"}},
+		},
+	}
+	newPod := func(name string, labels map[string]string, initContainers []string, containers ...string) *core.Pod {
+		pod := &core.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		}
+		for _, c := range initContainers {
+			pod.Spec.InitContainers = append(pod.Spec.InitContainers, core.Container{Name: c})
+		}
+		for _, c := range containers {
+			pod.Spec.Containers = append(pod.Spec.Containers, core.Container{Name: c})
+		}
+		return pod
+	}
+	clusterObjects := []runtime.Object{
+		deployment,
+		newPod("some-app-1", map[string]string{"app": "some-app"}, []string{"init"}, "app", "sidecar"),
+		newPod("some-app-2", map[string]string{"app": "some-app"}, nil, "app", "sidecar"),
+		newPod("other-app", map[string]string{"app": "other-app"}, nil, "app"),
+	}
+	deploymentRef := &pkgsGRPCv1alpha1.ResourceRef{
+		ApiVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "some-deployment",
+		Namespace:  "default",
+	}
+	podRef := func(name string) *pkgsGRPCv1alpha1.ResourceRef {
+		return &pkgsGRPCv1alpha1.ResourceRef{
+			ApiVersion: "v1",
+			Kind:       "Pod",
+			Name:       name,
+			Namespace:  "default",
+		}
+	}
+	logLine := func(pod, container string) *v1alpha1.GetInstalledPackageLogsResponse {
+		return &v1alpha1.GetInstalledPackageLogsResponse{
+			PodRef:    podRef(pod),
+			Container: container,
+			// The fake client returns this same line for any container.
+			Line: "fake logs",
+		}
+	}
+	installedPackageRef := &pkgsGRPCv1alpha1.InstalledPackageReference{
+		Context: &pkgsGRPCv1alpha1.Context{
+			Cluster:   "default",
+			Namespace: "default",
+		},
+		Plugin:     fakePkgsPlugin,
+		Identifier: "some-package",
+	}
+	sinceTime := time.Date(2022, time.May, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name               string
+		request            *v1alpha1.GetInstalledPackageLogsRequest
+		resourceRefs       []*pkgsGRPCv1alpha1.ResourceRef
+		withoutAuthz       bool
+		logStatusCodes     map[string]int
+		expectedErrorCode  codes.Code
+		expectedLines      []*v1alpha1.GetInstalledPackageLogsResponse
+		expectedLogOptions *core.PodLogOptions
+	}{
+		{
+			name: "streams the logs of every container of the pods of a workload",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			resourceRefs: []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef},
+			expectedLines: []*v1alpha1.GetInstalledPackageLogsResponse{
+				logLine("some-app-1", "app"),
+				logLine("some-app-1", "init"),
+				logLine("some-app-1", "sidecar"),
+				logLine("some-app-2", "app"),
+				logLine("some-app-2", "sidecar"),
+			},
+		},
+		{
+			name: "streams the logs of the requested container only",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+				Container:           "sidecar",
+			},
+			resourceRefs: []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef},
+			expectedLines: []*v1alpha1.GetInstalledPackageLogsResponse{
+				logLine("some-app-1", "sidecar"),
+				logLine("some-app-2", "sidecar"),
+			},
+		},
+		{
+			name: "streams the logs of a pod referenced directly once",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			resourceRefs: []*pkgsGRPCv1alpha1.ResourceRef{
+				podRef("other-app"),
+				podRef("other-app"),
+				{ApiVersion: "v1", Kind: "Service", Name: "some-service", Namespace: "default"},
+			},
+			expectedLines: []*v1alpha1.GetInstalledPackageLogsResponse{
+				logLine("other-app", "app"),
+			},
+		},
+		{
+			name: "ignores the workloads which no longer exist",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			resourceRefs: []*pkgsGRPCv1alpha1.ResourceRef{
+				{ApiVersion: "apps/v1", Kind: "StatefulSet", Name: "some-statefulset", Namespace: "default"},
+			},
+		},
+		{
+			name: "passes the log options to the k8s api",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+				Container:           "app",
+				Follow:              true,
+				SinceTime:           timestamppb.New(sinceTime),
+				TailLines:           10,
+			},
+			resourceRefs: []*pkgsGRPCv1alpha1.ResourceRef{podRef("other-app")},
+			expectedLines: []*v1alpha1.GetInstalledPackageLogsResponse{
+				logLine("other-app", "app"),
+			},
+			expectedLogOptions: &core.PodLogOptions{
+				Container: "app",
+				Follow:    true,
+				SinceTime: &metav1.Time{Time: sinceTime},
+				TailLines: func() *int64 { lines := int64(10); return &lines }(),
+			},
+		},
+		{
+			name: "returns invalid argument if the container does not exist",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+				Container:           "unknown",
+			},
+			resourceRefs:      []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns invalid argument if the tail lines are negative",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+				TailLines:           -1,
+			},
+			resourceRefs:      []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef},
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns permission denied if the user has no authorization",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			resourceRefs:      []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef},
+			withoutAuthz:      true,
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name: "skips the containers which have not started yet",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			resourceRefs:   []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef},
+			logStatusCodes: map[string]int{"sidecar": http.StatusBadRequest},
+			expectedLines: []*v1alpha1.GetInstalledPackageLogsResponse{
+				logLine("some-app-1", "app"),
+				logLine("some-app-1", "init"),
+				logLine("some-app-2", "app"),
+			},
+		},
+		{
+			name: "streams the logs of the other containers if some fail",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			resourceRefs:   []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef},
+			logStatusCodes: map[string]int{"sidecar": http.StatusInternalServerError},
+			expectedLines: []*v1alpha1.GetInstalledPackageLogsResponse{
+				logLine("some-app-1", "app"),
+				logLine("some-app-1", "init"),
+				logLine("some-app-2", "app"),
+			},
+		},
+		{
+			name: "returns internal if the logs of every container fail",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			resourceRefs: []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef},
+			logStatusCodes: map[string]int{
+				"app":     http.StatusInternalServerError,
+				"init":    http.StatusBadRequest,
+				"sidecar": http.StatusInternalServerError,
+			},
+			expectedErrorCode: codes.Internal,
+		},
+		{
+			name: "returns permission denied if the user cannot get the logs",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			resourceRefs:      []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef},
+			logStatusCodes:    map[string]int{"sidecar": http.StatusForbidden},
+			expectedErrorCode: codes.PermissionDenied,
+		},
+		{
+			name: "returns unauthenticated if the user is not authenticated to get the logs",
+			request: &v1alpha1.GetInstalledPackageLogsRequest{
+				InstalledPackageRef: installedPackageRef,
+			},
+			resourceRefs:      []*pkgsGRPCv1alpha1.ResourceRef{deploymentRef},
+			logStatusCodes:    map[string]int{"app": http.StatusUnauthorized},
+			expectedErrorCode: codes.Unauthenticated,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		v1alpha1.GetInstalledPackageLogsResponse{},
+		pkgsGRPCv1alpha1.ResourceRef{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, fakeTypedClient, cleanup := getLogsClient(t, tc.resourceRefs, tc.logStatusCodes, clusterObjects...)
+			defer cleanup()
+
+			// Use a context with a timeout to ensure that if a test unexpectedly
+			// waits beyond expectations, we'll see the failure.
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer cancel()
+			if !tc.withoutAuthz {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "some-auth-token")
+			}
+
+			responseStream, err := client.GetInstalledPackageLogs(ctx, tc.request)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			var lines []*v1alpha1.GetInstalledPackageLogsResponse
+			for {
+				line, err := responseStream.Recv()
+				if err == io.EOF {
+					break
+				}
+				// The lines of other containers may be streamed before an error.
+				if err != nil {
+					if got, want := status.Code(err), tc.expectedErrorCode; got != want {
+						t.Fatalf("got: %s, want: %s, err: %+v", got, want, err)
+					}
+					// If it was an expected error, we continue to the next test.
+					return
+				}
+				lines = append(lines, line)
+			}
+			if tc.expectedErrorCode != codes.OK {
+				t.Fatalf("got: OK, want: %s", tc.expectedErrorCode)
+			}
+
+			// The logs of the containers are multiplexed, so the order is not guaranteed.
+			sort.Slice(lines, func(i, j int) bool {
+				if lines[i].GetPodRef().GetName() != lines[j].GetPodRef().GetName() {
+					return lines[i].GetPodRef().GetName() < lines[j].GetPodRef().GetName()
+				}
+				return lines[i].GetContainer() < lines[j].GetContainer()
+			})
+			if got, want := lines, tc.expectedLines; !cmp.Equal(got, want, ignoredUnexported, cmpopts.EquateEmpty()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported, cmpopts.EquateEmpty()))
+			}
+
+			if tc.expectedLogOptions != nil {
+				logRequests := 0
+				for _, action := range fakeTypedClient.Actions() {
+					if action.GetSubresource() != "log" {
+						continue
+					}
+					logRequests++
+					logOptions := action.(k8stesting.GenericActionImpl).Value
+					if got, want := logOptions, tc.expectedLogOptions; !cmp.Equal(got, want) {
+						t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+					}
+				}
+				if logRequests == 0 {
+					t.Errorf("expected the logs to be requested from the k8s api")
+				}
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -25,6 +26,9 @@ import (
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 	klogv2 "k8s.io/klog/v2"
 )
 
@@ -96,7 +100,7 @@ func Serve(serveOpts core.ServeOptions) error {
 	}
 	if err = registerPluginsServiceServer(grpcSrv, pluginsServer, gwArgs); err != nil {
 		return err
-	} else if err = registerPackagesServiceServer(grpcSrv, pluginsServer, gwArgs); err != nil {
+	} else if err = registerPackagesServiceServer(grpcSrv, pluginsServer, gwArgs, serveOpts); err != nil {
 		return err
 	} else if err = registerRepositoriesServiceServer(grpcSrv, pluginsServer, gwArgs); err != nil {
 		return err
@@ -174,7 +178,7 @@ func registerPluginsServiceServer(grpcSrv *grpc.Server, pluginsServer *pluginsv1
 	return nil
 }
 
-func registerPackagesServiceServer(grpcSrv *grpc.Server, pluginsServer *pluginsv1alpha1.PluginsServer, gwArgs core.GatewayHandlerArgs) error {
+func registerPackagesServiceServer(grpcSrv *grpc.Server, pluginsServer *pluginsv1alpha1.PluginsServer, gwArgs core.GatewayHandlerArgs, serveOpts core.ServeOptions) error {
 	// Ask the plugins server for plugins with GRPC servers that fulfil the core
 	// packaging v1alpha1 API, then pass to the constructor below.
 	// The argument for the reflect.TypeOf is based on what grpc-go
@@ -182,8 +186,22 @@ func registerPackagesServiceServer(grpcSrv *grpc.Server, pluginsServer *pluginsv
 	// https://github.com/grpc/grpc-go/blob/v1.38.0/server.go#L621
 	packagingPlugins := pluginsServer.GetPluginsSatisfyingInterface(reflect.TypeOf((*packagesGRPCv1alpha1.PackagesServiceServer)(nil)).Elem())
 
+	// Create the core.packages operations server, which runs the async
+	// requests of the packages server, and register it for both grpc and http.
+	operationStore, err := newOperationStore(serveOpts)
+	if err != nil {
+		return fmt.Errorf("failed to create the store for core.packages.v1alpha1 operations: %w", err)
+	}
+	operationsServer := packagesv1alpha1.NewOperationsServer(operationStore, packagesv1alpha1.DefaultOperationHeartbeatInterval)
+	packagesGRPCv1alpha1.RegisterOperationsServiceServer(grpcSrv, operationsServer)
+	err = packagesGRPCv1alpha1.RegisterOperationsServiceHandlerFromEndpoint(gwArgs.Ctx, gwArgs.Mux, gwArgs.Addr, gwArgs.DialOptions)
+	if err != nil {
+		return fmt.Errorf("failed to register core.packages operations handler for gateway: %v", err)
+	}
+
 	// Create the core.packages server and register it for both grpc and http.
-	packagesServer, err := packagesv1alpha1.NewPackagesServer(packagingPlugins)
+	pluginTimeout := time.Duration(serveOpts.PluginTimeoutSeconds) * time.Second
+	packagesServer, err := packagesv1alpha1.NewPackagesServer(packagingPlugins, pluginTimeout, operationsServer)
 	if err != nil {
 		return fmt.Errorf("failed to create core.packages.v1alpha1 server: %w", err)
 	}
@@ -212,6 +230,32 @@ func registerRepositoriesServiceServer(grpcSrv *grpc.Server, pluginsServer *plug
 	return nil
 }
 
+// newOperationStore returns the store of the async operations. Unless an
+// operations namespace is configured, the operations are only kept in memory
+// and are lost when the server restarts.
+func newOperationStore(serveOpts core.ServeOptions) (packagesv1alpha1.OperationStore, error) {
+	if serveOpts.OperationsNamespace == "" {
+		klogv2.Warning("No operations namespace configured, so the state of asynchronous operations will be kept in memory only.")
+		return packagesv1alpha1.NewMemoryOperationStore(), nil
+	}
+
+	var restConfig *rest.Config
+	var err error
+	if serveOpts.UnsafeLocalDevKubeconfig {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+	} else {
+		restConfig, err = rest.InClusterConfig()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the config for the operations store: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the client for the operations store: %w", err)
+	}
+	return packagesv1alpha1.NewConfigMapOperationStore(clientset.CoreV1(), serveOpts.OperationsNamespace), nil
+}
+
 // Create a gateway mux that does not emit unpopulated fields.
 func gatewayMux() (*runtime.ServeMux, error) {
 	gwmux := runtime.NewServeMux(
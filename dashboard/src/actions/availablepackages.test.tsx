@@ -96,6 +96,7 @@ const fetchAvailablePackageSummariesTestCases: IfetchAvailablePackageSummariesTe
       availablePackageSummaries: [defaultAvailablePackageSummary],
       nextPageToken,
       categories: ["foo"],
+      pluginErrors: [],
     },
     requestedRepos: "",
     requestedPageToken: currentPageToken,
@@ -125,6 +126,7 @@ const fetchAvailablePackageSummariesTestCases: IfetchAvailablePackageSummariesTe
       availablePackageSummaries: [defaultAvailablePackageSummary],
       nextPageToken,
       categories: ["foo"],
+      pluginErrors: [],
     },
     requestedRepos: repos,
     requestedPageToken: "",
@@ -150,6 +152,7 @@ const fetchAvailablePackageSummariesTestCases: IfetchAvailablePackageSummariesTe
       availablePackageSummaries: [defaultAvailablePackageSummary],
       nextPageToken,
       categories: ["foo"],
+      pluginErrors: [],
     },
     requestedRepos: repos,
     requestedPageToken: currentPageToken,
@@ -178,6 +181,7 @@ const fetchAvailablePackageSummariesTestCases: IfetchAvailablePackageSummariesTe
       availablePackageSummaries: [defaultAvailablePackageSummary],
       nextPageToken: "",
       categories: ["foo"],
+      pluginErrors: [],
     },
     requestedRepos: repos,
     requestedPageToken: currentPageToken,
@@ -206,6 +210,7 @@ const fetchAvailablePackageSummariesTestCases: IfetchAvailablePackageSummariesTe
       availablePackageSummaries: [defaultAvailablePackageSummary],
       nextPageToken,
       categories: ["foo"],
+      pluginErrors: [],
     },
     requestedRepos: repos,
     requestedPageToken: currentPageToken,
@@ -234,6 +239,7 @@ const fetchAvailablePackageSummariesTestCases: IfetchAvailablePackageSummariesTe
       availablePackageSummaries: [defaultAvailablePackageSummary],
       nextPageToken: "3",
       categories: ["foo"],
+      pluginErrors: [],
     },
     requestedRepos: repos,
     requestedPageToken: "next-page-token",
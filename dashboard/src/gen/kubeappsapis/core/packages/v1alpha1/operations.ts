@@ -0,0 +1,502 @@
+/* eslint-disable */
+import Long from "long";
+import { grpc } from "@improbable-eng/grpc-web";
+import * as _m0 from "protobufjs/minimal";
+import { Operation } from "../../../../kubeappsapis/core/packages/v1alpha1/packages";
+import { Observable } from "rxjs";
+import { BrowserHeaders } from "browser-headers";
+import { share } from "rxjs/operators";
+
+export const protobufPackage = "kubeappsapis.core.packages.v1alpha1";
+
+/**
+ * GetOperationRequest
+ *
+ * Request for GetOperation
+ */
+export interface GetOperationRequest {
+  /** The identifier of the operation, as returned by the async request. */
+  operationId: string;
+}
+
+/**
+ * WatchOperationRequest
+ *
+ * Request for WatchOperation
+ */
+export interface WatchOperationRequest {
+  /** The identifier of the operation, as returned by the async request. */
+  operationId: string;
+}
+
+/**
+ * GetOperationResponse
+ *
+ * Response for GetOperation
+ */
+export interface GetOperationResponse {
+  operation?: Operation;
+}
+
+/**
+ * WatchOperationResponse
+ *
+ * Response for WatchOperation, sent each time the operation changes. The
+ * stream ends once the operation has succeeded or failed.
+ */
+export interface WatchOperationResponse {
+  operation?: Operation;
+}
+
+function createBaseGetOperationRequest(): GetOperationRequest {
+  return { operationId: "" };
+}
+
+export const GetOperationRequest = {
+  encode(message: GetOperationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.operationId !== "") {
+      writer.uint32(10).string(message.operationId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetOperationRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetOperationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.operationId = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetOperationRequest {
+    return {
+      operationId: isSet(object.operationId) ? String(object.operationId) : "",
+    };
+  },
+
+  toJSON(message: GetOperationRequest): unknown {
+    const obj: any = {};
+    message.operationId !== undefined && (obj.operationId = message.operationId);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetOperationRequest>, I>>(
+    object: I,
+  ): GetOperationRequest {
+    const message = createBaseGetOperationRequest();
+    message.operationId = object.operationId ?? "";
+    return message;
+  },
+};
+
+function createBaseWatchOperationRequest(): WatchOperationRequest {
+  return { operationId: "" };
+}
+
+export const WatchOperationRequest = {
+  encode(message: WatchOperationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.operationId !== "") {
+      writer.uint32(10).string(message.operationId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WatchOperationRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWatchOperationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.operationId = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WatchOperationRequest {
+    return {
+      operationId: isSet(object.operationId) ? String(object.operationId) : "",
+    };
+  },
+
+  toJSON(message: WatchOperationRequest): unknown {
+    const obj: any = {};
+    message.operationId !== undefined && (obj.operationId = message.operationId);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<WatchOperationRequest>, I>>(
+    object: I,
+  ): WatchOperationRequest {
+    const message = createBaseWatchOperationRequest();
+    message.operationId = object.operationId ?? "";
+    return message;
+  },
+};
+
+function createBaseGetOperationResponse(): GetOperationResponse {
+  return { operation: undefined };
+}
+
+export const GetOperationResponse = {
+  encode(message: GetOperationResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.operation !== undefined) {
+      Operation.encode(message.operation, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetOperationResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetOperationResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.operation = Operation.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetOperationResponse {
+    return {
+      operation: isSet(object.operation) ? Operation.fromJSON(object.operation) : undefined,
+    };
+  },
+
+  toJSON(message: GetOperationResponse): unknown {
+    const obj: any = {};
+    message.operation !== undefined &&
+      (obj.operation = message.operation ? Operation.toJSON(message.operation) : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetOperationResponse>, I>>(
+    object: I,
+  ): GetOperationResponse {
+    const message = createBaseGetOperationResponse();
+    message.operation =
+      object.operation !== undefined && object.operation !== null
+        ? Operation.fromPartial(object.operation)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseWatchOperationResponse(): WatchOperationResponse {
+  return { operation: undefined };
+}
+
+export const WatchOperationResponse = {
+  encode(message: WatchOperationResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.operation !== undefined) {
+      Operation.encode(message.operation, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WatchOperationResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWatchOperationResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.operation = Operation.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WatchOperationResponse {
+    return {
+      operation: isSet(object.operation) ? Operation.fromJSON(object.operation) : undefined,
+    };
+  },
+
+  toJSON(message: WatchOperationResponse): unknown {
+    const obj: any = {};
+    message.operation !== undefined &&
+      (obj.operation = message.operation ? Operation.toJSON(message.operation) : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<WatchOperationResponse>, I>>(
+    object: I,
+  ): WatchOperationResponse {
+    const message = createBaseWatchOperationResponse();
+    message.operation =
+      object.operation !== undefined && object.operation !== null
+        ? Operation.fromPartial(object.operation)
+        : undefined;
+    return message;
+  },
+};
+
+/**
+ * This protobuf file defines the request and response types for API calls
+ * following the long-running operations started by async requests, as well as
+ * the OperationsService. Unlike the other services, it is implemented by the
+ * core only, since the operations are run and stored by the core.
+ */
+export interface OperationsService {
+  GetOperation(
+    request: DeepPartial<GetOperationRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetOperationResponse>;
+  WatchOperation(
+    request: DeepPartial<WatchOperationRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<WatchOperationResponse>;
+}
+
+export class OperationsServiceClientImpl implements OperationsService {
+  private readonly rpc: Rpc;
+
+  constructor(rpc: Rpc) {
+    this.rpc = rpc;
+    this.GetOperation = this.GetOperation.bind(this);
+    this.WatchOperation = this.WatchOperation.bind(this);
+  }
+
+  GetOperation(
+    request: DeepPartial<GetOperationRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetOperationResponse> {
+    return this.rpc.unary(
+      OperationsServiceGetOperationDesc,
+      GetOperationRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  WatchOperation(
+    request: DeepPartial<WatchOperationRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<WatchOperationResponse> {
+    return this.rpc.invoke(
+      OperationsServiceWatchOperationDesc,
+      WatchOperationRequest.fromPartial(request),
+      metadata,
+    );
+  }
+}
+
+export const OperationsServiceDesc = {
+  serviceName: "kubeappsapis.core.packages.v1alpha1.OperationsService",
+};
+
+export const OperationsServiceGetOperationDesc: UnaryMethodDefinitionish = {
+  methodName: "GetOperation",
+  service: OperationsServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetOperationRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetOperationResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const OperationsServiceWatchOperationDesc: UnaryMethodDefinitionish = {
+  methodName: "WatchOperation",
+  service: OperationsServiceDesc,
+  requestStream: false,
+  responseStream: true,
+  requestType: {
+    serializeBinary() {
+      return WatchOperationRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...WatchOperationResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
+  requestStream: any;
+  responseStream: any;
+}
+
+type UnaryMethodDefinitionish = UnaryMethodDefinitionishR;
+
+interface Rpc {
+  unary<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Promise<any>;
+  invoke<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Observable<any>;
+}
+
+export class GrpcWebImpl {
+  private host: string;
+  private options: {
+    transport?: grpc.TransportFactory;
+    streamingTransport?: grpc.TransportFactory;
+    debug?: boolean;
+    metadata?: grpc.Metadata;
+  };
+
+  constructor(
+    host: string,
+    options: {
+      transport?: grpc.TransportFactory;
+      streamingTransport?: grpc.TransportFactory;
+      debug?: boolean;
+      metadata?: grpc.Metadata;
+    },
+  ) {
+    this.host = host;
+    this.options = options;
+  }
+
+  unary<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    _request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Promise<any> {
+    const request = { ..._request, ...methodDesc.requestType };
+    const maybeCombinedMetadata =
+      metadata && this.options.metadata
+        ? new BrowserHeaders({
+            ...this.options?.metadata.headersMap,
+            ...metadata?.headersMap,
+          })
+        : metadata || this.options.metadata;
+    return new Promise((resolve, reject) => {
+      grpc.unary(methodDesc, {
+        request,
+        host: this.host,
+        metadata: maybeCombinedMetadata,
+        transport: this.options.transport,
+        debug: this.options.debug,
+        onEnd: function (response) {
+          if (response.status === grpc.Code.OK) {
+            resolve(response.message);
+          } else {
+            const err = new Error(response.statusMessage) as any;
+            err.code = response.status;
+            err.metadata = response.trailers;
+            reject(err);
+          }
+        },
+      });
+    });
+  }
+
+  invoke<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    _request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Observable<any> {
+    // Status Response Codes (https://developers.google.com/maps-booking/reference/grpc-api/status_codes)
+    const upStreamCodes = [2, 4, 8, 9, 10, 13, 14, 15];
+    const DEFAULT_TIMEOUT_TIME: number = 3_000;
+    const request = { ..._request, ...methodDesc.requestType };
+    const maybeCombinedMetadata =
+      metadata && this.options.metadata
+        ? new BrowserHeaders({
+            ...this.options?.metadata.headersMap,
+            ...metadata?.headersMap,
+          })
+        : metadata || this.options.metadata;
+    return new Observable(observer => {
+      const upStream = () => {
+        const client = grpc.invoke(methodDesc, {
+          host: this.host,
+          request,
+          transport: this.options.streamingTransport || this.options.transport,
+          metadata: maybeCombinedMetadata,
+          debug: this.options.debug,
+          onMessage: next => observer.next(next),
+          onEnd: (code: grpc.Code, message: string) => {
+            if (code === 0) {
+              observer.complete();
+            } else if (upStreamCodes.includes(code)) {
+              setTimeout(upStream, DEFAULT_TIMEOUT_TIME);
+            } else {
+              observer.error(new Error(`Error ${code} ${message}`));
+            }
+          },
+        });
+        observer.add(() => client.close());
+      };
+      upStream();
+    }).pipe(share());
+  }
+}
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin
+  ? T
+  : T extends Array<infer U>
+  ? Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U>
+  ? ReadonlyArray<DeepPartial<U>>
+  : T extends {}
+  ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+type KeysOfUnion<T> = T extends T ? keyof T : never;
+export type Exact<P, I extends P> = P extends Builtin
+  ? P
+  : P & { [K in keyof P]: Exact<P[K], I[K]> } & Record<Exclude<keyof I, KeysOfUnion<P>>, never>;
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
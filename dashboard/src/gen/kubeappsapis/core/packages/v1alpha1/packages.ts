@@ -4,7 +4,10 @@ import { grpc } from "@improbable-eng/grpc-web";
 import * as _m0 from "protobufjs/minimal";
 import { Any } from "../../../../google/protobuf/any";
 import { Plugin } from "../../../../kubeappsapis/core/plugins/v1alpha1/plugins";
+import { Timestamp } from "../../../../google/protobuf/timestamp";
+import { Observable } from "rxjs";
 import { BrowserHeaders } from "browser-headers";
+import { share } from "rxjs/operators";
 
 export const protobufPackage = "kubeappsapis.core.packages.v1alpha1";
 
@@ -71,6 +74,8 @@ export interface GetInstalledPackageSummariesRequest {
   context?: Context;
   /** Pagination options specifying where to start and how many results to include. */
   paginationOptions?: PaginationOptions;
+  /** The filters used for the request. Pagination is applied to the filtered results. */
+  filterOptions?: InstalledPackageFilterOptions;
 }
 
 /**
@@ -115,6 +120,28 @@ export interface CreateInstalledPackageRequest {
    * the package on the cluster.
    */
   reconciliationOptions?: ReconciliationOptions;
+  /**
+   * An optional flag to render the package without installing it. When set,
+   * nothing is created on the cluster and the response includes the rendered
+   * manifest.
+   */
+  dryRun: boolean;
+  /**
+   * An optional flag to return as soon as the installation is started rather
+   * than once it completes. When set, the response includes an operation which
+   * can be used to follow the progress of the installation.
+   */
+  async: boolean;
+  /**
+   * Custom data relevant for the plugin
+   *
+   * A plugin can define custom details for data which is not yet, or never will
+   * be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
+   * of an `Any` field means that each plugin can define the structure of this
+   * message as required, while still satisfying the core interface.
+   * See https://developers.google.com/protocol-buffers/docs/proto3#any
+   */
+  customDetail?: Any;
 }
 
 /**
@@ -156,6 +183,28 @@ export interface UpdateInstalledPackageRequest {
    * the package on the cluster.
    */
   reconciliationOptions?: ReconciliationOptions;
+  /**
+   * An optional flag to render the updated package without applying it. When
+   * set, nothing is changed on the cluster and the response includes the
+   * rendered manifest together with the differences for each resource.
+   */
+  dryRun: boolean;
+  /**
+   * An optional flag to return as soon as the update is started rather than
+   * once it completes. When set, the response includes an operation which can
+   * be used to follow the progress of the update.
+   */
+  async: boolean;
+  /**
+   * Custom data relevant for the plugin
+   *
+   * A plugin can define custom details for data which is not yet, or never will
+   * be specified in the core.packaging.UpdateInstalledPackageRequest fields. The use
+   * of an `Any` field means that each plugin can define the structure of this
+   * message as required, while still satisfying the core interface.
+   * See https://developers.google.com/protocol-buffers/docs/proto3#any
+   */
+  customDetail?: Any;
 }
 
 /**
@@ -166,6 +215,12 @@ export interface UpdateInstalledPackageRequest {
 export interface DeleteInstalledPackageRequest {
   /** A reference to uniquely identify the installed package to be deleted. */
   installedPackageRef?: InstalledPackageReference;
+  /**
+   * An optional flag to return as soon as the deletion is started rather than
+   * once it completes. When set, the response includes an operation which can
+   * be used to follow the progress of the deletion.
+   */
+  async: boolean;
 }
 
 /**
@@ -177,6 +232,50 @@ export interface GetInstalledPackageResourceRefsRequest {
   installedPackageRef?: InstalledPackageReference;
 }
 
+/**
+ * WatchInstalledPackageSummariesRequest
+ *
+ * Request for WatchInstalledPackageSummaries
+ */
+export interface WatchInstalledPackageSummariesRequest {
+  /**
+   * The context (cluster/namespace) for the request. An empty namespace
+   * watches installed packages across all namespaces.
+   */
+  context?: Context;
+}
+
+/**
+ * GetInstalledPackageRevisionsRequest
+ *
+ * Request for GetInstalledPackageRevisions
+ */
+export interface GetInstalledPackageRevisionsRequest {
+  /** A reference to uniquely identify the installed package. */
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * RollbackInstalledPackageRequest
+ *
+ * Request for RollbackInstalledPackage
+ */
+export interface RollbackInstalledPackageRequest {
+  /**
+   * Installed package reference
+   *
+   * A reference uniquely identifying the installed package.
+   */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * ReleaseRevision
+   *
+   * A number identifying the revision to which to rollback, as returned
+   * by GetInstalledPackageRevisions.
+   */
+  releaseRevision: number;
+}
+
 /**
  * GetAvailablePackageSummariesResponse
  *
@@ -202,6 +301,14 @@ export interface GetAvailablePackageSummariesResponse {
    * This optional field contains the distinct category names considering the FilterOptions.
    */
   categories: string[];
+  /**
+   * Plugin errors
+   *
+   * Errors returned by individual plugins while aggregating the results. When
+   * present, the results only include those from the plugins which responded
+   * successfully.
+   */
+  pluginErrors: PluginError[];
 }
 
 /**
@@ -271,6 +378,14 @@ export interface GetInstalledPackageSummariesResponse {
    * results. If the value is "", it means no further results for the request.
    */
   nextPageToken: string;
+  /**
+   * Plugin errors
+   *
+   * Errors returned by individual plugins while aggregating the results. When
+   * present, the results only include those from the plugins which responded
+   * successfully.
+   */
+  pluginErrors: PluginError[];
 }
 
 /**
@@ -294,6 +409,18 @@ export interface GetInstalledPackageDetailResponse {
  */
 export interface CreateInstalledPackageResponse {
   installedPackageRef?: InstalledPackageReference;
+  /**
+   * Manifest
+   *
+   * The rendered manifest of the package, only set for dry-run requests.
+   */
+  manifest: string;
+  /**
+   * Operation
+   *
+   * The operation installing the package, only set for async requests.
+   */
+  operation?: Operation;
 }
 
 /**
@@ -303,6 +430,25 @@ export interface CreateInstalledPackageResponse {
  */
 export interface UpdateInstalledPackageResponse {
   installedPackageRef?: InstalledPackageReference;
+  /**
+   * Manifest
+   *
+   * The rendered manifest of the updated package, only set for dry-run requests.
+   */
+  manifest: string;
+  /**
+   * Resource diffs
+   *
+   * The differences between the live manifest and the rendered manifest for
+   * each added, modified or removed resource, only set for dry-run requests.
+   */
+  resourceDiffs: ResourceDiff[];
+  /**
+   * Operation
+   *
+   * The operation updating the package, only set for async requests.
+   */
+  operation?: Operation;
 }
 
 /**
@@ -310,7 +456,14 @@ export interface UpdateInstalledPackageResponse {
  *
  * Response for DeleteInstalledPackage
  */
-export interface DeleteInstalledPackageResponse {}
+export interface DeleteInstalledPackageResponse {
+  /**
+   * Operation
+   *
+   * The operation deleting the package, only set for async requests.
+   */
+  operation?: Operation;
+}
 
 /**
  * GetInstalledPackageResourceRefsResponse
@@ -322,6 +475,146 @@ export interface GetInstalledPackageResourceRefsResponse {
   resourceRefs: ResourceRef[];
 }
 
+/**
+ * WatchInstalledPackageSummariesResponse
+ *
+ * Response for WatchInstalledPackageSummaries. Each response is either a
+ * single event for an installed package summary or the error of a plugin.
+ */
+export interface WatchInstalledPackageSummariesResponse {
+  eventType: WatchInstalledPackageSummariesResponse_EventType;
+  /**
+   * The installed package summary after the change. For deleted installed
+   * packages only the installed package reference is guaranteed to be set.
+   */
+  installedPackageSummary?: InstalledPackageSummary;
+  /**
+   * The error of a plugin whose watch failed, after which no more events are
+   * sent for that plugin while the watches of the other plugins continue.
+   * Only set when the event type is unspecified.
+   */
+  pluginError?: PluginError;
+}
+
+/** An enum indicating the type of change for the installed package. */
+export enum WatchInstalledPackageSummariesResponse_EventType {
+  EVENT_TYPE_UNSPECIFIED = 0,
+  EVENT_TYPE_ADDED = 1,
+  EVENT_TYPE_MODIFIED = 2,
+  EVENT_TYPE_DELETED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function watchInstalledPackageSummariesResponse_EventTypeFromJSON(
+  object: any,
+): WatchInstalledPackageSummariesResponse_EventType {
+  switch (object) {
+    case 0:
+    case "EVENT_TYPE_UNSPECIFIED":
+      return WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_UNSPECIFIED;
+    case 1:
+    case "EVENT_TYPE_ADDED":
+      return WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_ADDED;
+    case 2:
+    case "EVENT_TYPE_MODIFIED":
+      return WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_MODIFIED;
+    case 3:
+    case "EVENT_TYPE_DELETED":
+      return WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_DELETED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return WatchInstalledPackageSummariesResponse_EventType.UNRECOGNIZED;
+  }
+}
+
+export function watchInstalledPackageSummariesResponse_EventTypeToJSON(
+  object: WatchInstalledPackageSummariesResponse_EventType,
+): string {
+  switch (object) {
+    case WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_UNSPECIFIED:
+      return "EVENT_TYPE_UNSPECIFIED";
+    case WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_ADDED:
+      return "EVENT_TYPE_ADDED";
+    case WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_MODIFIED:
+      return "EVENT_TYPE_MODIFIED";
+    case WatchInstalledPackageSummariesResponse_EventType.EVENT_TYPE_DELETED:
+      return "EVENT_TYPE_DELETED";
+    case WatchInstalledPackageSummariesResponse_EventType.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+/**
+ * GetInstalledPackageRevisionsResponse
+ *
+ * Response for GetInstalledPackageRevisions
+ */
+export interface GetInstalledPackageRevisionsResponse {
+  /**
+   * Revisions
+   *
+   * The revisions of the installed package, ordered from the most recent
+   * to the oldest.
+   */
+  revisions: InstalledPackageRevision[];
+}
+
+/**
+ * RollbackInstalledPackageResponse
+ *
+ * Response for RollbackInstalledPackage
+ */
+export interface RollbackInstalledPackageResponse {
+  /**
+   * TODO: add example for API docs
+   * option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_schema) = {
+   *   example: '{"installed_package_ref": {}}'
+   * };
+   */
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * InstalledPackageRevision
+ *
+ * An InstalledPackageRevision describes a single revision in the history
+ * of an installed package.
+ */
+export interface InstalledPackageRevision {
+  /**
+   * ReleaseRevision
+   *
+   * A number identifying the revision, which can be used to rollback to it.
+   */
+  releaseRevision: number;
+  /**
+   * Version
+   *
+   * The package version and app version of the package for this revision.
+   */
+  version?: PackageAppVersion;
+  /**
+   * Values applied
+   *
+   * The values applied for this revision, when available.
+   */
+  valuesApplied: string;
+  /**
+   * Status
+   *
+   * The status of the installed package for this revision.
+   */
+  status?: InstalledPackageStatus;
+  /**
+   * Updated
+   *
+   * The time at which this revision was deployed.
+   */
+  updated?: Date;
+}
+
 /**
  * AvailablePackageSummary
  *
@@ -762,6 +1055,47 @@ export interface FilterOptions {
   appVersion: string;
 }
 
+/**
+ * InstalledPackageFilterOptions
+ *
+ * FilterOptions available when requesting summaries of installed packages
+ */
+export interface InstalledPackageFilterOptions {
+  /**
+   * Text query
+   *
+   * Case-insensitive text which the installed package name must contain
+   */
+  query: string;
+  /**
+   * Status reasons
+   *
+   * Collection of status reasons, one of which the installed package must have
+   */
+  statusReasons: InstalledPackageStatus_StatusReason[];
+  /**
+   * Plugins
+   *
+   * Collection of plugins, one of which must manage the installed package.
+   * Only the plugin name is compared.
+   */
+  plugins: Plugin[];
+  /**
+   * Package names
+   *
+   * Collection of package display names, one of which the installed package
+   * must be an instance of (case-insensitive)
+   */
+  pkgNames: string[];
+  /**
+   * Upgrade available
+   *
+   * When true, only installed packages whose latest version is greater than
+   * the current version are included
+   */
+  upgradeAvailable: boolean;
+}
+
 /**
  * PaginationOptions
  *
@@ -998,71 +1332,301 @@ export interface ResourceRef {
   namespace: string;
 }
 
-function createBaseGetAvailablePackageSummariesRequest(): GetAvailablePackageSummariesRequest {
-  return {
-    context: undefined,
-    filterOptions: undefined,
-    paginationOptions: undefined,
-  };
+/**
+ * ResourceDiff
+ *
+ * A ResourceDiff describes how a single resource of an installed package
+ * would change when the package is updated.
+ */
+export interface ResourceDiff {
+  /** The resource which would change. */
+  resourceRef?: ResourceRef;
+  changeType: ResourceDiff_ChangeType;
+  /**
+   * Diff
+   *
+   * A line-based diff of the resource manifest, in which removed lines are
+   * prefixed with "-", added lines with "+" and unchanged lines with a space.
+   */
+  diff: string;
 }
 
-export const GetAvailablePackageSummariesRequest = {
-  encode(
-    message: GetAvailablePackageSummariesRequest,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.context !== undefined) {
-      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.filterOptions !== undefined) {
-      FilterOptions.encode(message.filterOptions, writer.uint32(18).fork()).ldelim();
-    }
-    if (message.paginationOptions !== undefined) {
-      PaginationOptions.encode(message.paginationOptions, writer.uint32(26).fork()).ldelim();
-    }
-    return writer;
-  },
+/** An enum indicating how the resource would change. */
+export enum ResourceDiff_ChangeType {
+  CHANGE_TYPE_UNSPECIFIED = 0,
+  CHANGE_TYPE_ADDED = 1,
+  CHANGE_TYPE_MODIFIED = 2,
+  CHANGE_TYPE_REMOVED = 3,
+  UNRECOGNIZED = -1,
+}
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageSummariesRequest {
-    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetAvailablePackageSummariesRequest();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          message.context = Context.decode(reader, reader.uint32());
-          break;
-        case 2:
-          message.filterOptions = FilterOptions.decode(reader, reader.uint32());
-          break;
-        case 3:
-          message.paginationOptions = PaginationOptions.decode(reader, reader.uint32());
-          break;
-        default:
-          reader.skipType(tag & 7);
-          break;
-      }
-    }
-    return message;
-  },
+export function resourceDiff_ChangeTypeFromJSON(object: any): ResourceDiff_ChangeType {
+  switch (object) {
+    case 0:
+    case "CHANGE_TYPE_UNSPECIFIED":
+      return ResourceDiff_ChangeType.CHANGE_TYPE_UNSPECIFIED;
+    case 1:
+    case "CHANGE_TYPE_ADDED":
+      return ResourceDiff_ChangeType.CHANGE_TYPE_ADDED;
+    case 2:
+    case "CHANGE_TYPE_MODIFIED":
+      return ResourceDiff_ChangeType.CHANGE_TYPE_MODIFIED;
+    case 3:
+    case "CHANGE_TYPE_REMOVED":
+      return ResourceDiff_ChangeType.CHANGE_TYPE_REMOVED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ResourceDiff_ChangeType.UNRECOGNIZED;
+  }
+}
 
-  fromJSON(object: any): GetAvailablePackageSummariesRequest {
-    return {
-      context: isSet(object.context) ? Context.fromJSON(object.context) : undefined,
-      filterOptions: isSet(object.filterOptions)
-        ? FilterOptions.fromJSON(object.filterOptions)
-        : undefined,
-      paginationOptions: isSet(object.paginationOptions)
-        ? PaginationOptions.fromJSON(object.paginationOptions)
-        : undefined,
-    };
-  },
+export function resourceDiff_ChangeTypeToJSON(object: ResourceDiff_ChangeType): string {
+  switch (object) {
+    case ResourceDiff_ChangeType.CHANGE_TYPE_UNSPECIFIED:
+      return "CHANGE_TYPE_UNSPECIFIED";
+    case ResourceDiff_ChangeType.CHANGE_TYPE_ADDED:
+      return "CHANGE_TYPE_ADDED";
+    case ResourceDiff_ChangeType.CHANGE_TYPE_MODIFIED:
+      return "CHANGE_TYPE_MODIFIED";
+    case ResourceDiff_ChangeType.CHANGE_TYPE_REMOVED:
+      return "CHANGE_TYPE_REMOVED";
+    case ResourceDiff_ChangeType.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
 
-  toJSON(message: GetAvailablePackageSummariesRequest): unknown {
-    const obj: any = {};
-    message.context !== undefined &&
-      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
+/**
+ * Operation
+ *
+ * A long-running operation on an installed package, started by an async
+ * CreateInstalledPackage, UpdateInstalledPackage or DeleteInstalledPackage
+ * request. The state of the operations is stored by the core, so that it can
+ * be retrieved from any replica and after a restart of the server.
+ */
+export interface Operation {
+  /** The identifier of the operation, used to get or watch it. */
+  id: string;
+  type: Operation_OperationType;
+  state: Operation_OperationState;
+  /** The installed package on which the operation is run. */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * Events
+   *
+   * The progress of the operation, oldest first, including the changes of
+   * the status of the installed package reported by the plugin while the
+   * operation runs.
+   */
+  events: OperationEvent[];
+  /**
+   * The gRPC status code of the error when the operation failed, eg. 4 for
+   * DEADLINE_EXCEEDED.
+   */
+  errorCode: number;
+  /** The error message when the operation failed. */
+  errorMessage: string;
+  /** The time at which the operation was started. */
+  created?: Date;
+  /**
+   * The time at which the operation was last updated. Running operations are
+   * updated periodically, so that those interrupted by a restart of the server
+   * can be detected.
+   */
+  updated?: Date;
+  /**
+   * The cluster and namespace of the request which started the operation.
+   * Only callers with access to the installed package in this context can
+   * get or watch the operation.
+   */
+  context?: Context;
+}
+
+/** An enum indicating the kind of change made by the operation. */
+export enum Operation_OperationType {
+  OPERATION_TYPE_UNSPECIFIED = 0,
+  OPERATION_TYPE_INSTALL = 1,
+  OPERATION_TYPE_UPDATE = 2,
+  OPERATION_TYPE_DELETE = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function operation_OperationTypeFromJSON(object: any): Operation_OperationType {
+  switch (object) {
+    case 0:
+    case "OPERATION_TYPE_UNSPECIFIED":
+      return Operation_OperationType.OPERATION_TYPE_UNSPECIFIED;
+    case 1:
+    case "OPERATION_TYPE_INSTALL":
+      return Operation_OperationType.OPERATION_TYPE_INSTALL;
+    case 2:
+    case "OPERATION_TYPE_UPDATE":
+      return Operation_OperationType.OPERATION_TYPE_UPDATE;
+    case 3:
+    case "OPERATION_TYPE_DELETE":
+      return Operation_OperationType.OPERATION_TYPE_DELETE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Operation_OperationType.UNRECOGNIZED;
+  }
+}
+
+export function operation_OperationTypeToJSON(object: Operation_OperationType): string {
+  switch (object) {
+    case Operation_OperationType.OPERATION_TYPE_UNSPECIFIED:
+      return "OPERATION_TYPE_UNSPECIFIED";
+    case Operation_OperationType.OPERATION_TYPE_INSTALL:
+      return "OPERATION_TYPE_INSTALL";
+    case Operation_OperationType.OPERATION_TYPE_UPDATE:
+      return "OPERATION_TYPE_UPDATE";
+    case Operation_OperationType.OPERATION_TYPE_DELETE:
+      return "OPERATION_TYPE_DELETE";
+    case Operation_OperationType.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+/** An enum indicating the state of the operation. */
+export enum Operation_OperationState {
+  OPERATION_STATE_UNSPECIFIED = 0,
+  OPERATION_STATE_RUNNING = 1,
+  OPERATION_STATE_SUCCEEDED = 2,
+  OPERATION_STATE_FAILED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function operation_OperationStateFromJSON(object: any): Operation_OperationState {
+  switch (object) {
+    case 0:
+    case "OPERATION_STATE_UNSPECIFIED":
+      return Operation_OperationState.OPERATION_STATE_UNSPECIFIED;
+    case 1:
+    case "OPERATION_STATE_RUNNING":
+      return Operation_OperationState.OPERATION_STATE_RUNNING;
+    case 2:
+    case "OPERATION_STATE_SUCCEEDED":
+      return Operation_OperationState.OPERATION_STATE_SUCCEEDED;
+    case 3:
+    case "OPERATION_STATE_FAILED":
+      return Operation_OperationState.OPERATION_STATE_FAILED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Operation_OperationState.UNRECOGNIZED;
+  }
+}
+
+export function operation_OperationStateToJSON(object: Operation_OperationState): string {
+  switch (object) {
+    case Operation_OperationState.OPERATION_STATE_UNSPECIFIED:
+      return "OPERATION_STATE_UNSPECIFIED";
+    case Operation_OperationState.OPERATION_STATE_RUNNING:
+      return "OPERATION_STATE_RUNNING";
+    case Operation_OperationState.OPERATION_STATE_SUCCEEDED:
+      return "OPERATION_STATE_SUCCEEDED";
+    case Operation_OperationState.OPERATION_STATE_FAILED:
+      return "OPERATION_STATE_FAILED";
+    case Operation_OperationState.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+/**
+ * Operation event
+ *
+ * A single progress event of an operation.
+ */
+export interface OperationEvent {
+  /** The time at which the event happened. */
+  time?: Date;
+  /** A human-readable description of the event. */
+  message: string;
+}
+
+/**
+ * Plugin error
+ *
+ * An error returned by a single plugin when the results of several plugins
+ * are aggregated, so that partial results can be returned.
+ */
+export interface PluginError {
+  /** The plugin which returned the error */
+  plugin?: Plugin;
+  /** The gRPC status code of the error, eg. 4 for DEADLINE_EXCEEDED. */
+  code: number;
+  /** The error message returned by the plugin */
+  message: string;
+}
+
+function createBaseGetAvailablePackageSummariesRequest(): GetAvailablePackageSummariesRequest {
+  return {
+    context: undefined,
+    filterOptions: undefined,
+    paginationOptions: undefined,
+  };
+}
+
+export const GetAvailablePackageSummariesRequest = {
+  encode(
+    message: GetAvailablePackageSummariesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.filterOptions !== undefined) {
+      FilterOptions.encode(message.filterOptions, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.paginationOptions !== undefined) {
+      PaginationOptions.encode(message.paginationOptions, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageSummariesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetAvailablePackageSummariesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.context = Context.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.filterOptions = FilterOptions.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.paginationOptions = PaginationOptions.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageSummariesRequest {
+    return {
+      context: isSet(object.context) ? Context.fromJSON(object.context) : undefined,
+      filterOptions: isSet(object.filterOptions)
+        ? FilterOptions.fromJSON(object.filterOptions)
+        : undefined,
+      paginationOptions: isSet(object.paginationOptions)
+        ? PaginationOptions.fromJSON(object.paginationOptions)
+        : undefined,
+    };
+  },
+
+  toJSON(message: GetAvailablePackageSummariesRequest): unknown {
+    const obj: any = {};
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
     message.filterOptions !== undefined &&
       (obj.filterOptions = message.filterOptions
         ? FilterOptions.toJSON(message.filterOptions)
@@ -1243,7 +1807,11 @@ export const GetAvailablePackageVersionsRequest = {
 };
 
 function createBaseGetInstalledPackageSummariesRequest(): GetInstalledPackageSummariesRequest {
-  return { context: undefined, paginationOptions: undefined };
+  return {
+    context: undefined,
+    paginationOptions: undefined,
+    filterOptions: undefined,
+  };
 }
 
 export const GetInstalledPackageSummariesRequest = {
@@ -1257,6 +1825,12 @@ export const GetInstalledPackageSummariesRequest = {
     if (message.paginationOptions !== undefined) {
       PaginationOptions.encode(message.paginationOptions, writer.uint32(18).fork()).ldelim();
     }
+    if (message.filterOptions !== undefined) {
+      InstalledPackageFilterOptions.encode(
+        message.filterOptions,
+        writer.uint32(26).fork(),
+      ).ldelim();
+    }
     return writer;
   },
 
@@ -1273,6 +1847,9 @@ export const GetInstalledPackageSummariesRequest = {
         case 2:
           message.paginationOptions = PaginationOptions.decode(reader, reader.uint32());
           break;
+        case 3:
+          message.filterOptions = InstalledPackageFilterOptions.decode(reader, reader.uint32());
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -1287,6 +1864,9 @@ export const GetInstalledPackageSummariesRequest = {
       paginationOptions: isSet(object.paginationOptions)
         ? PaginationOptions.fromJSON(object.paginationOptions)
         : undefined,
+      filterOptions: isSet(object.filterOptions)
+        ? InstalledPackageFilterOptions.fromJSON(object.filterOptions)
+        : undefined,
     };
   },
 
@@ -1298,6 +1878,10 @@ export const GetInstalledPackageSummariesRequest = {
       (obj.paginationOptions = message.paginationOptions
         ? PaginationOptions.toJSON(message.paginationOptions)
         : undefined);
+    message.filterOptions !== undefined &&
+      (obj.filterOptions = message.filterOptions
+        ? InstalledPackageFilterOptions.toJSON(message.filterOptions)
+        : undefined);
     return obj;
   },
 
@@ -1313,6 +1897,10 @@ export const GetInstalledPackageSummariesRequest = {
       object.paginationOptions !== undefined && object.paginationOptions !== null
         ? PaginationOptions.fromPartial(object.paginationOptions)
         : undefined;
+    message.filterOptions =
+      object.filterOptions !== undefined && object.filterOptions !== null
+        ? InstalledPackageFilterOptions.fromPartial(object.filterOptions)
+        : undefined;
     return message;
   },
 };
@@ -1390,6 +1978,9 @@ function createBaseCreateInstalledPackageRequest(): CreateInstalledPackageReques
     pkgVersionReference: undefined,
     values: "",
     reconciliationOptions: undefined,
+    dryRun: false,
+    async: false,
+    customDetail: undefined,
   };
 }
 
@@ -1422,6 +2013,15 @@ export const CreateInstalledPackageRequest = {
         writer.uint32(50).fork(),
       ).ldelim();
     }
+    if (message.dryRun === true) {
+      writer.uint32(56).bool(message.dryRun);
+    }
+    if (message.async === true) {
+      writer.uint32(64).bool(message.async);
+    }
+    if (message.customDetail !== undefined) {
+      Any.encode(message.customDetail, writer.uint32(74).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1450,6 +2050,15 @@ export const CreateInstalledPackageRequest = {
         case 6:
           message.reconciliationOptions = ReconciliationOptions.decode(reader, reader.uint32());
           break;
+        case 7:
+          message.dryRun = reader.bool();
+          break;
+        case 8:
+          message.async = reader.bool();
+          break;
+        case 9:
+          message.customDetail = Any.decode(reader, reader.uint32());
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -1474,6 +2083,9 @@ export const CreateInstalledPackageRequest = {
       reconciliationOptions: isSet(object.reconciliationOptions)
         ? ReconciliationOptions.fromJSON(object.reconciliationOptions)
         : undefined,
+      dryRun: isSet(object.dryRun) ? Boolean(object.dryRun) : false,
+      async: isSet(object.async) ? Boolean(object.async) : false,
+      customDetail: isSet(object.customDetail) ? Any.fromJSON(object.customDetail) : undefined,
     };
   },
 
@@ -1497,6 +2109,10 @@ export const CreateInstalledPackageRequest = {
       (obj.reconciliationOptions = message.reconciliationOptions
         ? ReconciliationOptions.toJSON(message.reconciliationOptions)
         : undefined);
+    message.dryRun !== undefined && (obj.dryRun = message.dryRun);
+    message.async !== undefined && (obj.async = message.async);
+    message.customDetail !== undefined &&
+      (obj.customDetail = message.customDetail ? Any.toJSON(message.customDetail) : undefined);
     return obj;
   },
 
@@ -1522,6 +2138,12 @@ export const CreateInstalledPackageRequest = {
       object.reconciliationOptions !== undefined && object.reconciliationOptions !== null
         ? ReconciliationOptions.fromPartial(object.reconciliationOptions)
         : undefined;
+    message.dryRun = object.dryRun ?? false;
+    message.async = object.async ?? false;
+    message.customDetail =
+      object.customDetail !== undefined && object.customDetail !== null
+        ? Any.fromPartial(object.customDetail)
+        : undefined;
     return message;
   },
 };
@@ -1532,6 +2154,9 @@ function createBaseUpdateInstalledPackageRequest(): UpdateInstalledPackageReques
     pkgVersionReference: undefined,
     values: "",
     reconciliationOptions: undefined,
+    dryRun: false,
+    async: false,
+    customDetail: undefined,
   };
 }
 
@@ -1558,6 +2183,15 @@ export const UpdateInstalledPackageRequest = {
         writer.uint32(34).fork(),
       ).ldelim();
     }
+    if (message.dryRun === true) {
+      writer.uint32(40).bool(message.dryRun);
+    }
+    if (message.async === true) {
+      writer.uint32(48).bool(message.async);
+    }
+    if (message.customDetail !== undefined) {
+      Any.encode(message.customDetail, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1580,6 +2214,15 @@ export const UpdateInstalledPackageRequest = {
         case 4:
           message.reconciliationOptions = ReconciliationOptions.decode(reader, reader.uint32());
           break;
+        case 5:
+          message.dryRun = reader.bool();
+          break;
+        case 6:
+          message.async = reader.bool();
+          break;
+        case 7:
+          message.customDetail = Any.decode(reader, reader.uint32());
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -1600,6 +2243,9 @@ export const UpdateInstalledPackageRequest = {
       reconciliationOptions: isSet(object.reconciliationOptions)
         ? ReconciliationOptions.fromJSON(object.reconciliationOptions)
         : undefined,
+      dryRun: isSet(object.dryRun) ? Boolean(object.dryRun) : false,
+      async: isSet(object.async) ? Boolean(object.async) : false,
+      customDetail: isSet(object.customDetail) ? Any.fromJSON(object.customDetail) : undefined,
     };
   },
 
@@ -1618,6 +2264,10 @@ export const UpdateInstalledPackageRequest = {
       (obj.reconciliationOptions = message.reconciliationOptions
         ? ReconciliationOptions.toJSON(message.reconciliationOptions)
         : undefined);
+    message.dryRun !== undefined && (obj.dryRun = message.dryRun);
+    message.async !== undefined && (obj.async = message.async);
+    message.customDetail !== undefined &&
+      (obj.customDetail = message.customDetail ? Any.toJSON(message.customDetail) : undefined);
     return obj;
   },
 
@@ -1638,12 +2288,18 @@ export const UpdateInstalledPackageRequest = {
       object.reconciliationOptions !== undefined && object.reconciliationOptions !== null
         ? ReconciliationOptions.fromPartial(object.reconciliationOptions)
         : undefined;
+    message.dryRun = object.dryRun ?? false;
+    message.async = object.async ?? false;
+    message.customDetail =
+      object.customDetail !== undefined && object.customDetail !== null
+        ? Any.fromPartial(object.customDetail)
+        : undefined;
     return message;
   },
 };
 
 function createBaseDeleteInstalledPackageRequest(): DeleteInstalledPackageRequest {
-  return { installedPackageRef: undefined };
+  return { installedPackageRef: undefined, async: false };
 }
 
 export const DeleteInstalledPackageRequest = {
@@ -1657,6 +2313,9 @@ export const DeleteInstalledPackageRequest = {
         writer.uint32(10).fork(),
       ).ldelim();
     }
+    if (message.async === true) {
+      writer.uint32(16).bool(message.async);
+    }
     return writer;
   },
 
@@ -1670,6 +2329,9 @@ export const DeleteInstalledPackageRequest = {
         case 1:
           message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
+        case 2:
+          message.async = reader.bool();
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -1683,6 +2345,7 @@ export const DeleteInstalledPackageRequest = {
       installedPackageRef: isSet(object.installedPackageRef)
         ? InstalledPackageReference.fromJSON(object.installedPackageRef)
         : undefined,
+      async: isSet(object.async) ? Boolean(object.async) : false,
     };
   },
 
@@ -1692,6 +2355,7 @@ export const DeleteInstalledPackageRequest = {
       (obj.installedPackageRef = message.installedPackageRef
         ? InstalledPackageReference.toJSON(message.installedPackageRef)
         : undefined);
+    message.async !== undefined && (obj.async = message.async);
     return obj;
   },
 
@@ -1703,6 +2367,7 @@ export const DeleteInstalledPackageRequest = {
       object.installedPackageRef !== undefined && object.installedPackageRef !== null
         ? InstalledPackageReference.fromPartial(object.installedPackageRef)
         : undefined;
+    message.async = object.async ?? false;
     return message;
   },
 };
@@ -1772,44 +2437,30 @@ export const GetInstalledPackageResourceRefsRequest = {
   },
 };
 
-function createBaseGetAvailablePackageSummariesResponse(): GetAvailablePackageSummariesResponse {
-  return { availablePackageSummaries: [], nextPageToken: "", categories: [] };
+function createBaseWatchInstalledPackageSummariesRequest(): WatchInstalledPackageSummariesRequest {
+  return { context: undefined };
 }
 
-export const GetAvailablePackageSummariesResponse = {
+export const WatchInstalledPackageSummariesRequest = {
   encode(
-    message: GetAvailablePackageSummariesResponse,
+    message: WatchInstalledPackageSummariesRequest,
     writer: _m0.Writer = _m0.Writer.create(),
   ): _m0.Writer {
-    for (const v of message.availablePackageSummaries) {
-      AvailablePackageSummary.encode(v!, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.nextPageToken !== "") {
-      writer.uint32(18).string(message.nextPageToken);
-    }
-    for (const v of message.categories) {
-      writer.uint32(26).string(v!);
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageSummariesResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): WatchInstalledPackageSummariesRequest {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetAvailablePackageSummariesResponse();
+    const message = createBaseWatchInstalledPackageSummariesRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.availablePackageSummaries.push(
-            AvailablePackageSummary.decode(reader, reader.uint32()),
-          );
-          break;
-        case 2:
-          message.nextPageToken = reader.string();
-          break;
-        case 3:
-          message.categories.push(reader.string());
+          message.context = Context.decode(reader, reader.uint32());
           break;
         default:
           reader.skipType(tag & 7);
@@ -1819,50 +2470,282 @@ export const GetAvailablePackageSummariesResponse = {
     return message;
   },
 
-  fromJSON(object: any): GetAvailablePackageSummariesResponse {
+  fromJSON(object: any): WatchInstalledPackageSummariesRequest {
     return {
-      availablePackageSummaries: Array.isArray(object?.availablePackageSummaries)
-        ? object.availablePackageSummaries.map((e: any) => AvailablePackageSummary.fromJSON(e))
-        : [],
-      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
-      categories: Array.isArray(object?.categories)
-        ? object.categories.map((e: any) => String(e))
-        : [],
+      context: isSet(object.context) ? Context.fromJSON(object.context) : undefined,
     };
   },
 
-  toJSON(message: GetAvailablePackageSummariesResponse): unknown {
+  toJSON(message: WatchInstalledPackageSummariesRequest): unknown {
     const obj: any = {};
-    if (message.availablePackageSummaries) {
-      obj.availablePackageSummaries = message.availablePackageSummaries.map(e =>
-        e ? AvailablePackageSummary.toJSON(e) : undefined,
-      );
-    } else {
-      obj.availablePackageSummaries = [];
-    }
-    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
-    if (message.categories) {
-      obj.categories = message.categories.map(e => e);
-    } else {
-      obj.categories = [];
-    }
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<GetAvailablePackageSummariesResponse>, I>>(
+  fromPartial<I extends Exact<DeepPartial<WatchInstalledPackageSummariesRequest>, I>>(
     object: I,
-  ): GetAvailablePackageSummariesResponse {
-    const message = createBaseGetAvailablePackageSummariesResponse();
-    message.availablePackageSummaries =
-      object.availablePackageSummaries?.map(e => AvailablePackageSummary.fromPartial(e)) || [];
-    message.nextPageToken = object.nextPageToken ?? "";
-    message.categories = object.categories?.map(e => e) || [];
+  ): WatchInstalledPackageSummariesRequest {
+    const message = createBaseWatchInstalledPackageSummariesRequest();
+    message.context =
+      object.context !== undefined && object.context !== null
+        ? Context.fromPartial(object.context)
+        : undefined;
     return message;
   },
 };
 
-function createBaseGetAvailablePackageDetailResponse(): GetAvailablePackageDetailResponse {
-  return { availablePackageDetail: undefined };
+function createBaseGetInstalledPackageRevisionsRequest(): GetInstalledPackageRevisionsRequest {
+  return { installedPackageRef: undefined };
+}
+
+export const GetInstalledPackageRevisionsRequest = {
+  encode(
+    message: GetInstalledPackageRevisionsRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageRevisionsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetInstalledPackageRevisionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageRevisionsRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: GetInstalledPackageRevisionsRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageRevisionsRequest>, I>>(
+    object: I,
+  ): GetInstalledPackageRevisionsRequest {
+    const message = createBaseGetInstalledPackageRevisionsRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseRollbackInstalledPackageRequest(): RollbackInstalledPackageRequest {
+  return { installedPackageRef: undefined, releaseRevision: 0 };
+}
+
+export const RollbackInstalledPackageRequest = {
+  encode(
+    message: RollbackInstalledPackageRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.releaseRevision !== 0) {
+      writer.uint32(16).int32(message.releaseRevision);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RollbackInstalledPackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRollbackInstalledPackageRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.releaseRevision = reader.int32();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RollbackInstalledPackageRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      releaseRevision: isSet(object.releaseRevision) ? Number(object.releaseRevision) : 0,
+    };
+  },
+
+  toJSON(message: RollbackInstalledPackageRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.releaseRevision !== undefined &&
+      (obj.releaseRevision = Math.round(message.releaseRevision));
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<RollbackInstalledPackageRequest>, I>>(
+    object: I,
+  ): RollbackInstalledPackageRequest {
+    const message = createBaseRollbackInstalledPackageRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.releaseRevision = object.releaseRevision ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetAvailablePackageSummariesResponse(): GetAvailablePackageSummariesResponse {
+  return {
+    availablePackageSummaries: [],
+    nextPageToken: "",
+    categories: [],
+    pluginErrors: [],
+  };
+}
+
+export const GetAvailablePackageSummariesResponse = {
+  encode(
+    message: GetAvailablePackageSummariesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.availablePackageSummaries) {
+      AvailablePackageSummary.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    for (const v of message.categories) {
+      writer.uint32(26).string(v!);
+    }
+    for (const v of message.pluginErrors) {
+      PluginError.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetAvailablePackageSummariesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetAvailablePackageSummariesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageSummaries.push(
+            AvailablePackageSummary.decode(reader, reader.uint32()),
+          );
+          break;
+        case 2:
+          message.nextPageToken = reader.string();
+          break;
+        case 3:
+          message.categories.push(reader.string());
+          break;
+        case 4:
+          message.pluginErrors.push(PluginError.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetAvailablePackageSummariesResponse {
+    return {
+      availablePackageSummaries: Array.isArray(object?.availablePackageSummaries)
+        ? object.availablePackageSummaries.map((e: any) => AvailablePackageSummary.fromJSON(e))
+        : [],
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
+      categories: Array.isArray(object?.categories)
+        ? object.categories.map((e: any) => String(e))
+        : [],
+      pluginErrors: Array.isArray(object?.pluginErrors)
+        ? object.pluginErrors.map((e: any) => PluginError.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: GetAvailablePackageSummariesResponse): unknown {
+    const obj: any = {};
+    if (message.availablePackageSummaries) {
+      obj.availablePackageSummaries = message.availablePackageSummaries.map(e =>
+        e ? AvailablePackageSummary.toJSON(e) : undefined,
+      );
+    } else {
+      obj.availablePackageSummaries = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    if (message.categories) {
+      obj.categories = message.categories.map(e => e);
+    } else {
+      obj.categories = [];
+    }
+    if (message.pluginErrors) {
+      obj.pluginErrors = message.pluginErrors.map(e => (e ? PluginError.toJSON(e) : undefined));
+    } else {
+      obj.pluginErrors = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetAvailablePackageSummariesResponse>, I>>(
+    object: I,
+  ): GetAvailablePackageSummariesResponse {
+    const message = createBaseGetAvailablePackageSummariesResponse();
+    message.availablePackageSummaries =
+      object.availablePackageSummaries?.map(e => AvailablePackageSummary.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    message.categories = object.categories?.map(e => e) || [];
+    message.pluginErrors = object.pluginErrors?.map(e => PluginError.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGetAvailablePackageDetailResponse(): GetAvailablePackageDetailResponse {
+  return { availablePackageDetail: undefined };
 }
 
 export const GetAvailablePackageDetailResponse = {
@@ -1990,7 +2873,7 @@ export const GetAvailablePackageVersionsResponse = {
 };
 
 function createBaseGetInstalledPackageSummariesResponse(): GetInstalledPackageSummariesResponse {
-  return { installedPackageSummaries: [], nextPageToken: "" };
+  return { installedPackageSummaries: [], nextPageToken: "", pluginErrors: [] };
 }
 
 export const GetInstalledPackageSummariesResponse = {
@@ -2004,6 +2887,9 @@ export const GetInstalledPackageSummariesResponse = {
     if (message.nextPageToken !== "") {
       writer.uint32(18).string(message.nextPageToken);
     }
+    for (const v of message.pluginErrors) {
+      PluginError.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2022,6 +2908,9 @@ export const GetInstalledPackageSummariesResponse = {
         case 2:
           message.nextPageToken = reader.string();
           break;
+        case 3:
+          message.pluginErrors.push(PluginError.decode(reader, reader.uint32()));
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -2036,6 +2925,9 @@ export const GetInstalledPackageSummariesResponse = {
         ? object.installedPackageSummaries.map((e: any) => InstalledPackageSummary.fromJSON(e))
         : [],
       nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
+      pluginErrors: Array.isArray(object?.pluginErrors)
+        ? object.pluginErrors.map((e: any) => PluginError.fromJSON(e))
+        : [],
     };
   },
 
@@ -2049,6 +2941,11 @@ export const GetInstalledPackageSummariesResponse = {
       obj.installedPackageSummaries = [];
     }
     message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    if (message.pluginErrors) {
+      obj.pluginErrors = message.pluginErrors.map(e => (e ? PluginError.toJSON(e) : undefined));
+    } else {
+      obj.pluginErrors = [];
+    }
     return obj;
   },
 
@@ -2059,6 +2956,7 @@ export const GetInstalledPackageSummariesResponse = {
     message.installedPackageSummaries =
       object.installedPackageSummaries?.map(e => InstalledPackageSummary.fromPartial(e)) || [];
     message.nextPageToken = object.nextPageToken ?? "";
+    message.pluginErrors = object.pluginErrors?.map(e => PluginError.fromPartial(e)) || [];
     return message;
   },
 };
@@ -2129,7 +3027,7 @@ export const GetInstalledPackageDetailResponse = {
 };
 
 function createBaseCreateInstalledPackageResponse(): CreateInstalledPackageResponse {
-  return { installedPackageRef: undefined };
+  return { installedPackageRef: undefined, manifest: "", operation: undefined };
 }
 
 export const CreateInstalledPackageResponse = {
@@ -2143,6 +3041,12 @@ export const CreateInstalledPackageResponse = {
         writer.uint32(10).fork(),
       ).ldelim();
     }
+    if (message.manifest !== "") {
+      writer.uint32(18).string(message.manifest);
+    }
+    if (message.operation !== undefined) {
+      Operation.encode(message.operation, writer.uint32(26).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2156,6 +3060,12 @@ export const CreateInstalledPackageResponse = {
         case 1:
           message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
+        case 2:
+          message.manifest = reader.string();
+          break;
+        case 3:
+          message.operation = Operation.decode(reader, reader.uint32());
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -2169,6 +3079,8 @@ export const CreateInstalledPackageResponse = {
       installedPackageRef: isSet(object.installedPackageRef)
         ? InstalledPackageReference.fromJSON(object.installedPackageRef)
         : undefined,
+      manifest: isSet(object.manifest) ? String(object.manifest) : "",
+      operation: isSet(object.operation) ? Operation.fromJSON(object.operation) : undefined,
     };
   },
 
@@ -2178,6 +3090,9 @@ export const CreateInstalledPackageResponse = {
       (obj.installedPackageRef = message.installedPackageRef
         ? InstalledPackageReference.toJSON(message.installedPackageRef)
         : undefined);
+    message.manifest !== undefined && (obj.manifest = message.manifest);
+    message.operation !== undefined &&
+      (obj.operation = message.operation ? Operation.toJSON(message.operation) : undefined);
     return obj;
   },
 
@@ -2189,12 +3104,22 @@ export const CreateInstalledPackageResponse = {
       object.installedPackageRef !== undefined && object.installedPackageRef !== null
         ? InstalledPackageReference.fromPartial(object.installedPackageRef)
         : undefined;
+    message.manifest = object.manifest ?? "";
+    message.operation =
+      object.operation !== undefined && object.operation !== null
+        ? Operation.fromPartial(object.operation)
+        : undefined;
     return message;
   },
 };
 
 function createBaseUpdateInstalledPackageResponse(): UpdateInstalledPackageResponse {
-  return { installedPackageRef: undefined };
+  return {
+    installedPackageRef: undefined,
+    manifest: "",
+    resourceDiffs: [],
+    operation: undefined,
+  };
 }
 
 export const UpdateInstalledPackageResponse = {
@@ -2208,6 +3133,15 @@ export const UpdateInstalledPackageResponse = {
         writer.uint32(10).fork(),
       ).ldelim();
     }
+    if (message.manifest !== "") {
+      writer.uint32(18).string(message.manifest);
+    }
+    for (const v of message.resourceDiffs) {
+      ResourceDiff.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.operation !== undefined) {
+      Operation.encode(message.operation, writer.uint32(34).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2221,6 +3155,15 @@ export const UpdateInstalledPackageResponse = {
         case 1:
           message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
           break;
+        case 2:
+          message.manifest = reader.string();
+          break;
+        case 3:
+          message.resourceDiffs.push(ResourceDiff.decode(reader, reader.uint32()));
+          break;
+        case 4:
+          message.operation = Operation.decode(reader, reader.uint32());
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -2234,6 +3177,11 @@ export const UpdateInstalledPackageResponse = {
       installedPackageRef: isSet(object.installedPackageRef)
         ? InstalledPackageReference.fromJSON(object.installedPackageRef)
         : undefined,
+      manifest: isSet(object.manifest) ? String(object.manifest) : "",
+      resourceDiffs: Array.isArray(object?.resourceDiffs)
+        ? object.resourceDiffs.map((e: any) => ResourceDiff.fromJSON(e))
+        : [],
+      operation: isSet(object.operation) ? Operation.fromJSON(object.operation) : undefined,
     };
   },
 
@@ -2243,6 +3191,14 @@ export const UpdateInstalledPackageResponse = {
       (obj.installedPackageRef = message.installedPackageRef
         ? InstalledPackageReference.toJSON(message.installedPackageRef)
         : undefined);
+    message.manifest !== undefined && (obj.manifest = message.manifest);
+    if (message.resourceDiffs) {
+      obj.resourceDiffs = message.resourceDiffs.map(e => (e ? ResourceDiff.toJSON(e) : undefined));
+    } else {
+      obj.resourceDiffs = [];
+    }
+    message.operation !== undefined &&
+      (obj.operation = message.operation ? Operation.toJSON(message.operation) : undefined);
     return obj;
   },
 
@@ -2254,16 +3210,28 @@ export const UpdateInstalledPackageResponse = {
       object.installedPackageRef !== undefined && object.installedPackageRef !== null
         ? InstalledPackageReference.fromPartial(object.installedPackageRef)
         : undefined;
+    message.manifest = object.manifest ?? "";
+    message.resourceDiffs = object.resourceDiffs?.map(e => ResourceDiff.fromPartial(e)) || [];
+    message.operation =
+      object.operation !== undefined && object.operation !== null
+        ? Operation.fromPartial(object.operation)
+        : undefined;
     return message;
   },
 };
 
 function createBaseDeleteInstalledPackageResponse(): DeleteInstalledPackageResponse {
-  return {};
+  return { operation: undefined };
 }
 
 export const DeleteInstalledPackageResponse = {
-  encode(_: DeleteInstalledPackageResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+  encode(
+    message: DeleteInstalledPackageResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.operation !== undefined) {
+      Operation.encode(message.operation, writer.uint32(10).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2274,6 +3242,9 @@ export const DeleteInstalledPackageResponse = {
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1:
+          message.operation = Operation.decode(reader, reader.uint32());
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -2282,19 +3253,27 @@ export const DeleteInstalledPackageResponse = {
     return message;
   },
 
-  fromJSON(_: any): DeleteInstalledPackageResponse {
-    return {};
+  fromJSON(object: any): DeleteInstalledPackageResponse {
+    return {
+      operation: isSet(object.operation) ? Operation.fromJSON(object.operation) : undefined,
+    };
   },
 
-  toJSON(_: DeleteInstalledPackageResponse): unknown {
+  toJSON(message: DeleteInstalledPackageResponse): unknown {
     const obj: any = {};
+    message.operation !== undefined &&
+      (obj.operation = message.operation ? Operation.toJSON(message.operation) : undefined);
     return obj;
   },
 
   fromPartial<I extends Exact<DeepPartial<DeleteInstalledPackageResponse>, I>>(
-    _: I,
+    object: I,
   ): DeleteInstalledPackageResponse {
     const message = createBaseDeleteInstalledPackageResponse();
+    message.operation =
+      object.operation !== undefined && object.operation !== null
+        ? Operation.fromPartial(object.operation)
+        : undefined;
     return message;
   },
 };
@@ -2372,12 +3351,335 @@ export const GetInstalledPackageResourceRefsResponse = {
   },
 };
 
-function createBaseAvailablePackageSummary(): AvailablePackageSummary {
+function createBaseWatchInstalledPackageSummariesResponse(): WatchInstalledPackageSummariesResponse {
   return {
-    availablePackageRef: undefined,
-    name: "",
-    latestVersion: undefined,
-    iconUrl: "",
+    eventType: 0,
+    installedPackageSummary: undefined,
+    pluginError: undefined,
+  };
+}
+
+export const WatchInstalledPackageSummariesResponse = {
+  encode(
+    message: WatchInstalledPackageSummariesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.eventType !== 0) {
+      writer.uint32(8).int32(message.eventType);
+    }
+    if (message.installedPackageSummary !== undefined) {
+      InstalledPackageSummary.encode(
+        message.installedPackageSummary,
+        writer.uint32(18).fork(),
+      ).ldelim();
+    }
+    if (message.pluginError !== undefined) {
+      PluginError.encode(message.pluginError, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WatchInstalledPackageSummariesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWatchInstalledPackageSummariesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.eventType = reader.int32() as any;
+          break;
+        case 2:
+          message.installedPackageSummary = InstalledPackageSummary.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.pluginError = PluginError.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WatchInstalledPackageSummariesResponse {
+    return {
+      eventType: isSet(object.eventType)
+        ? watchInstalledPackageSummariesResponse_EventTypeFromJSON(object.eventType)
+        : 0,
+      installedPackageSummary: isSet(object.installedPackageSummary)
+        ? InstalledPackageSummary.fromJSON(object.installedPackageSummary)
+        : undefined,
+      pluginError: isSet(object.pluginError) ? PluginError.fromJSON(object.pluginError) : undefined,
+    };
+  },
+
+  toJSON(message: WatchInstalledPackageSummariesResponse): unknown {
+    const obj: any = {};
+    message.eventType !== undefined &&
+      (obj.eventType = watchInstalledPackageSummariesResponse_EventTypeToJSON(message.eventType));
+    message.installedPackageSummary !== undefined &&
+      (obj.installedPackageSummary = message.installedPackageSummary
+        ? InstalledPackageSummary.toJSON(message.installedPackageSummary)
+        : undefined);
+    message.pluginError !== undefined &&
+      (obj.pluginError = message.pluginError ? PluginError.toJSON(message.pluginError) : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<WatchInstalledPackageSummariesResponse>, I>>(
+    object: I,
+  ): WatchInstalledPackageSummariesResponse {
+    const message = createBaseWatchInstalledPackageSummariesResponse();
+    message.eventType = object.eventType ?? 0;
+    message.installedPackageSummary =
+      object.installedPackageSummary !== undefined && object.installedPackageSummary !== null
+        ? InstalledPackageSummary.fromPartial(object.installedPackageSummary)
+        : undefined;
+    message.pluginError =
+      object.pluginError !== undefined && object.pluginError !== null
+        ? PluginError.fromPartial(object.pluginError)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseGetInstalledPackageRevisionsResponse(): GetInstalledPackageRevisionsResponse {
+  return { revisions: [] };
+}
+
+export const GetInstalledPackageRevisionsResponse = {
+  encode(
+    message: GetInstalledPackageRevisionsResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.revisions) {
+      InstalledPackageRevision.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageRevisionsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetInstalledPackageRevisionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.revisions.push(InstalledPackageRevision.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageRevisionsResponse {
+    return {
+      revisions: Array.isArray(object?.revisions)
+        ? object.revisions.map((e: any) => InstalledPackageRevision.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: GetInstalledPackageRevisionsResponse): unknown {
+    const obj: any = {};
+    if (message.revisions) {
+      obj.revisions = message.revisions.map(e =>
+        e ? InstalledPackageRevision.toJSON(e) : undefined,
+      );
+    } else {
+      obj.revisions = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageRevisionsResponse>, I>>(
+    object: I,
+  ): GetInstalledPackageRevisionsResponse {
+    const message = createBaseGetInstalledPackageRevisionsResponse();
+    message.revisions = object.revisions?.map(e => InstalledPackageRevision.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRollbackInstalledPackageResponse(): RollbackInstalledPackageResponse {
+  return { installedPackageRef: undefined };
+}
+
+export const RollbackInstalledPackageResponse = {
+  encode(
+    message: RollbackInstalledPackageResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RollbackInstalledPackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRollbackInstalledPackageResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RollbackInstalledPackageResponse {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: RollbackInstalledPackageResponse): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<RollbackInstalledPackageResponse>, I>>(
+    object: I,
+  ): RollbackInstalledPackageResponse {
+    const message = createBaseRollbackInstalledPackageResponse();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseInstalledPackageRevision(): InstalledPackageRevision {
+  return {
+    releaseRevision: 0,
+    version: undefined,
+    valuesApplied: "",
+    status: undefined,
+    updated: undefined,
+  };
+}
+
+export const InstalledPackageRevision = {
+  encode(message: InstalledPackageRevision, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.releaseRevision !== 0) {
+      writer.uint32(8).int32(message.releaseRevision);
+    }
+    if (message.version !== undefined) {
+      PackageAppVersion.encode(message.version, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.valuesApplied !== "") {
+      writer.uint32(26).string(message.valuesApplied);
+    }
+    if (message.status !== undefined) {
+      InstalledPackageStatus.encode(message.status, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.updated !== undefined) {
+      Timestamp.encode(toTimestamp(message.updated), writer.uint32(42).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageRevision {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseInstalledPackageRevision();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.releaseRevision = reader.int32();
+          break;
+        case 2:
+          message.version = PackageAppVersion.decode(reader, reader.uint32());
+          break;
+        case 3:
+          message.valuesApplied = reader.string();
+          break;
+        case 4:
+          message.status = InstalledPackageStatus.decode(reader, reader.uint32());
+          break;
+        case 5:
+          message.updated = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): InstalledPackageRevision {
+    return {
+      releaseRevision: isSet(object.releaseRevision) ? Number(object.releaseRevision) : 0,
+      version: isSet(object.version) ? PackageAppVersion.fromJSON(object.version) : undefined,
+      valuesApplied: isSet(object.valuesApplied) ? String(object.valuesApplied) : "",
+      status: isSet(object.status) ? InstalledPackageStatus.fromJSON(object.status) : undefined,
+      updated: isSet(object.updated) ? fromJsonTimestamp(object.updated) : undefined,
+    };
+  },
+
+  toJSON(message: InstalledPackageRevision): unknown {
+    const obj: any = {};
+    message.releaseRevision !== undefined &&
+      (obj.releaseRevision = Math.round(message.releaseRevision));
+    message.version !== undefined &&
+      (obj.version = message.version ? PackageAppVersion.toJSON(message.version) : undefined);
+    message.valuesApplied !== undefined && (obj.valuesApplied = message.valuesApplied);
+    message.status !== undefined &&
+      (obj.status = message.status ? InstalledPackageStatus.toJSON(message.status) : undefined);
+    message.updated !== undefined && (obj.updated = message.updated.toISOString());
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<InstalledPackageRevision>, I>>(
+    object: I,
+  ): InstalledPackageRevision {
+    const message = createBaseInstalledPackageRevision();
+    message.releaseRevision = object.releaseRevision ?? 0;
+    message.version =
+      object.version !== undefined && object.version !== null
+        ? PackageAppVersion.fromPartial(object.version)
+        : undefined;
+    message.valuesApplied = object.valuesApplied ?? "";
+    message.status =
+      object.status !== undefined && object.status !== null
+        ? InstalledPackageStatus.fromPartial(object.status)
+        : undefined;
+    message.updated = object.updated ?? undefined;
+    return message;
+  },
+};
+
+function createBaseAvailablePackageSummary(): AvailablePackageSummary {
+  return {
+    availablePackageRef: undefined,
+    name: "",
+    latestVersion: undefined,
+    iconUrl: "",
     displayName: "",
     shortDescription: "",
     categories: [],
@@ -3475,39 +4777,162 @@ export const FilterOptions = {
   },
 };
 
-function createBasePaginationOptions(): PaginationOptions {
-  return { pageToken: "", pageSize: 0 };
+function createBaseInstalledPackageFilterOptions(): InstalledPackageFilterOptions {
+  return {
+    query: "",
+    statusReasons: [],
+    plugins: [],
+    pkgNames: [],
+    upgradeAvailable: false,
+  };
 }
 
-export const PaginationOptions = {
-  encode(message: PaginationOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.pageToken !== "") {
-      writer.uint32(10).string(message.pageToken);
+export const InstalledPackageFilterOptions = {
+  encode(
+    message: InstalledPackageFilterOptions,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.query !== "") {
+      writer.uint32(10).string(message.query);
     }
-    if (message.pageSize !== 0) {
-      writer.uint32(16).int32(message.pageSize);
+    writer.uint32(18).fork();
+    for (const v of message.statusReasons) {
+      writer.enum(v);
+    }
+    writer.ldelim();
+    for (const v of message.plugins) {
+      Plugin.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    for (const v of message.pkgNames) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.upgradeAvailable === true) {
+      writer.uint32(40).bool(message.upgradeAvailable);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PaginationOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageFilterOptions {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePaginationOptions();
+    const message = createBaseInstalledPackageFilterOptions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.pageToken = reader.string();
+          message.query = reader.string();
           break;
         case 2:
-          message.pageSize = reader.int32();
+          if ((tag & 7) === 2) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.statusReasons.push(reader.int32() as any);
+            }
+          } else {
+            message.statusReasons.push(reader.int32() as any);
+          }
           break;
-        default:
-          reader.skipType(tag & 7);
+        case 3:
+          message.plugins.push(Plugin.decode(reader, reader.uint32()));
           break;
-      }
-    }
+        case 4:
+          message.pkgNames.push(reader.string());
+          break;
+        case 5:
+          message.upgradeAvailable = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): InstalledPackageFilterOptions {
+    return {
+      query: isSet(object.query) ? String(object.query) : "",
+      statusReasons: Array.isArray(object?.statusReasons)
+        ? object.statusReasons.map((e: any) => installedPackageStatus_StatusReasonFromJSON(e))
+        : [],
+      plugins: Array.isArray(object?.plugins)
+        ? object.plugins.map((e: any) => Plugin.fromJSON(e))
+        : [],
+      pkgNames: Array.isArray(object?.pkgNames) ? object.pkgNames.map((e: any) => String(e)) : [],
+      upgradeAvailable: isSet(object.upgradeAvailable) ? Boolean(object.upgradeAvailable) : false,
+    };
+  },
+
+  toJSON(message: InstalledPackageFilterOptions): unknown {
+    const obj: any = {};
+    message.query !== undefined && (obj.query = message.query);
+    if (message.statusReasons) {
+      obj.statusReasons = message.statusReasons.map(e =>
+        installedPackageStatus_StatusReasonToJSON(e),
+      );
+    } else {
+      obj.statusReasons = [];
+    }
+    if (message.plugins) {
+      obj.plugins = message.plugins.map(e => (e ? Plugin.toJSON(e) : undefined));
+    } else {
+      obj.plugins = [];
+    }
+    if (message.pkgNames) {
+      obj.pkgNames = message.pkgNames.map(e => e);
+    } else {
+      obj.pkgNames = [];
+    }
+    message.upgradeAvailable !== undefined && (obj.upgradeAvailable = message.upgradeAvailable);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<InstalledPackageFilterOptions>, I>>(
+    object: I,
+  ): InstalledPackageFilterOptions {
+    const message = createBaseInstalledPackageFilterOptions();
+    message.query = object.query ?? "";
+    message.statusReasons = object.statusReasons?.map(e => e) || [];
+    message.plugins = object.plugins?.map(e => Plugin.fromPartial(e)) || [];
+    message.pkgNames = object.pkgNames?.map(e => e) || [];
+    message.upgradeAvailable = object.upgradeAvailable ?? false;
+    return message;
+  },
+};
+
+function createBasePaginationOptions(): PaginationOptions {
+  return { pageToken: "", pageSize: 0 };
+}
+
+export const PaginationOptions = {
+  encode(message: PaginationOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pageToken !== "") {
+      writer.uint32(10).string(message.pageToken);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PaginationOptions {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePaginationOptions();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.pageToken = reader.string();
+          break;
+        case 2:
+          message.pageSize = reader.int32();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
     return message;
   },
 
@@ -3729,39 +5154,371 @@ export const InstalledPackageStatus = {
   },
 };
 
-function createBaseReconciliationOptions(): ReconciliationOptions {
-  return { interval: "", suspend: false, serviceAccountName: "" };
+function createBaseReconciliationOptions(): ReconciliationOptions {
+  return { interval: "", suspend: false, serviceAccountName: "" };
+}
+
+export const ReconciliationOptions = {
+  encode(message: ReconciliationOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.interval !== "") {
+      writer.uint32(10).string(message.interval);
+    }
+    if (message.suspend === true) {
+      writer.uint32(16).bool(message.suspend);
+    }
+    if (message.serviceAccountName !== "") {
+      writer.uint32(26).string(message.serviceAccountName);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ReconciliationOptions {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseReconciliationOptions();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.interval = reader.string();
+          break;
+        case 2:
+          message.suspend = reader.bool();
+          break;
+        case 3:
+          message.serviceAccountName = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ReconciliationOptions {
+    return {
+      interval: isSet(object.interval) ? String(object.interval) : "",
+      suspend: isSet(object.suspend) ? Boolean(object.suspend) : false,
+      serviceAccountName: isSet(object.serviceAccountName) ? String(object.serviceAccountName) : "",
+    };
+  },
+
+  toJSON(message: ReconciliationOptions): unknown {
+    const obj: any = {};
+    message.interval !== undefined && (obj.interval = message.interval);
+    message.suspend !== undefined && (obj.suspend = message.suspend);
+    message.serviceAccountName !== undefined &&
+      (obj.serviceAccountName = message.serviceAccountName);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ReconciliationOptions>, I>>(
+    object: I,
+  ): ReconciliationOptions {
+    const message = createBaseReconciliationOptions();
+    message.interval = object.interval ?? "";
+    message.suspend = object.suspend ?? false;
+    message.serviceAccountName = object.serviceAccountName ?? "";
+    return message;
+  },
+};
+
+function createBasePackageAppVersion(): PackageAppVersion {
+  return { pkgVersion: "", appVersion: "" };
+}
+
+export const PackageAppVersion = {
+  encode(message: PackageAppVersion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pkgVersion !== "") {
+      writer.uint32(10).string(message.pkgVersion);
+    }
+    if (message.appVersion !== "") {
+      writer.uint32(18).string(message.appVersion);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PackageAppVersion {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePackageAppVersion();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.pkgVersion = reader.string();
+          break;
+        case 2:
+          message.appVersion = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PackageAppVersion {
+    return {
+      pkgVersion: isSet(object.pkgVersion) ? String(object.pkgVersion) : "",
+      appVersion: isSet(object.appVersion) ? String(object.appVersion) : "",
+    };
+  },
+
+  toJSON(message: PackageAppVersion): unknown {
+    const obj: any = {};
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PackageAppVersion>, I>>(object: I): PackageAppVersion {
+    const message = createBasePackageAppVersion();
+    message.pkgVersion = object.pkgVersion ?? "";
+    message.appVersion = object.appVersion ?? "";
+    return message;
+  },
+};
+
+function createBaseResourceRef(): ResourceRef {
+  return { apiVersion: "", kind: "", name: "", namespace: "" };
+}
+
+export const ResourceRef = {
+  encode(message: ResourceRef, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.apiVersion !== "") {
+      writer.uint32(10).string(message.apiVersion);
+    }
+    if (message.kind !== "") {
+      writer.uint32(18).string(message.kind);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.namespace !== "") {
+      writer.uint32(34).string(message.namespace);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceRef {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResourceRef();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.apiVersion = reader.string();
+          break;
+        case 2:
+          message.kind = reader.string();
+          break;
+        case 3:
+          message.name = reader.string();
+          break;
+        case 4:
+          message.namespace = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResourceRef {
+    return {
+      apiVersion: isSet(object.apiVersion) ? String(object.apiVersion) : "",
+      kind: isSet(object.kind) ? String(object.kind) : "",
+      name: isSet(object.name) ? String(object.name) : "",
+      namespace: isSet(object.namespace) ? String(object.namespace) : "",
+    };
+  },
+
+  toJSON(message: ResourceRef): unknown {
+    const obj: any = {};
+    message.apiVersion !== undefined && (obj.apiVersion = message.apiVersion);
+    message.kind !== undefined && (obj.kind = message.kind);
+    message.name !== undefined && (obj.name = message.name);
+    message.namespace !== undefined && (obj.namespace = message.namespace);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ResourceRef>, I>>(object: I): ResourceRef {
+    const message = createBaseResourceRef();
+    message.apiVersion = object.apiVersion ?? "";
+    message.kind = object.kind ?? "";
+    message.name = object.name ?? "";
+    message.namespace = object.namespace ?? "";
+    return message;
+  },
+};
+
+function createBaseResourceDiff(): ResourceDiff {
+  return { resourceRef: undefined, changeType: 0, diff: "" };
+}
+
+export const ResourceDiff = {
+  encode(message: ResourceDiff, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.resourceRef !== undefined) {
+      ResourceRef.encode(message.resourceRef, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.changeType !== 0) {
+      writer.uint32(16).int32(message.changeType);
+    }
+    if (message.diff !== "") {
+      writer.uint32(26).string(message.diff);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceDiff {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResourceDiff();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.resourceRef = ResourceRef.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.changeType = reader.int32() as any;
+          break;
+        case 3:
+          message.diff = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResourceDiff {
+    return {
+      resourceRef: isSet(object.resourceRef) ? ResourceRef.fromJSON(object.resourceRef) : undefined,
+      changeType: isSet(object.changeType) ? resourceDiff_ChangeTypeFromJSON(object.changeType) : 0,
+      diff: isSet(object.diff) ? String(object.diff) : "",
+    };
+  },
+
+  toJSON(message: ResourceDiff): unknown {
+    const obj: any = {};
+    message.resourceRef !== undefined &&
+      (obj.resourceRef = message.resourceRef ? ResourceRef.toJSON(message.resourceRef) : undefined);
+    message.changeType !== undefined &&
+      (obj.changeType = resourceDiff_ChangeTypeToJSON(message.changeType));
+    message.diff !== undefined && (obj.diff = message.diff);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ResourceDiff>, I>>(object: I): ResourceDiff {
+    const message = createBaseResourceDiff();
+    message.resourceRef =
+      object.resourceRef !== undefined && object.resourceRef !== null
+        ? ResourceRef.fromPartial(object.resourceRef)
+        : undefined;
+    message.changeType = object.changeType ?? 0;
+    message.diff = object.diff ?? "";
+    return message;
+  },
+};
+
+function createBaseOperation(): Operation {
+  return {
+    id: "",
+    type: 0,
+    state: 0,
+    installedPackageRef: undefined,
+    events: [],
+    errorCode: 0,
+    errorMessage: "",
+    created: undefined,
+    updated: undefined,
+    context: undefined,
+  };
 }
 
-export const ReconciliationOptions = {
-  encode(message: ReconciliationOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.interval !== "") {
-      writer.uint32(10).string(message.interval);
+export const Operation = {
+  encode(message: Operation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
     }
-    if (message.suspend === true) {
-      writer.uint32(16).bool(message.suspend);
+    if (message.type !== 0) {
+      writer.uint32(16).int32(message.type);
     }
-    if (message.serviceAccountName !== "") {
-      writer.uint32(26).string(message.serviceAccountName);
+    if (message.state !== 0) {
+      writer.uint32(24).int32(message.state);
+    }
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(34).fork(),
+      ).ldelim();
+    }
+    for (const v of message.events) {
+      OperationEvent.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.errorCode !== 0) {
+      writer.uint32(48).int32(message.errorCode);
+    }
+    if (message.errorMessage !== "") {
+      writer.uint32(58).string(message.errorMessage);
+    }
+    if (message.created !== undefined) {
+      Timestamp.encode(toTimestamp(message.created), writer.uint32(66).fork()).ldelim();
+    }
+    if (message.updated !== undefined) {
+      Timestamp.encode(toTimestamp(message.updated), writer.uint32(74).fork()).ldelim();
+    }
+    if (message.context !== undefined) {
+      Context.encode(message.context, writer.uint32(82).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ReconciliationOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): Operation {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseReconciliationOptions();
+    const message = createBaseOperation();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.interval = reader.string();
+          message.id = reader.string();
           break;
         case 2:
-          message.suspend = reader.bool();
+          message.type = reader.int32() as any;
           break;
         case 3:
-          message.serviceAccountName = reader.string();
+          message.state = reader.int32() as any;
+          break;
+        case 4:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 5:
+          message.events.push(OperationEvent.decode(reader, reader.uint32()));
+          break;
+        case 6:
+          message.errorCode = reader.int32();
+          break;
+        case 7:
+          message.errorMessage = reader.string();
+          break;
+        case 8:
+          message.created = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+        case 9:
+          message.updated = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+        case 10:
+          message.context = Context.decode(reader, reader.uint32());
           break;
         default:
           reader.skipType(tag & 7);
@@ -3771,61 +5528,97 @@ export const ReconciliationOptions = {
     return message;
   },
 
-  fromJSON(object: any): ReconciliationOptions {
+  fromJSON(object: any): Operation {
     return {
-      interval: isSet(object.interval) ? String(object.interval) : "",
-      suspend: isSet(object.suspend) ? Boolean(object.suspend) : false,
-      serviceAccountName: isSet(object.serviceAccountName) ? String(object.serviceAccountName) : "",
+      id: isSet(object.id) ? String(object.id) : "",
+      type: isSet(object.type) ? operation_OperationTypeFromJSON(object.type) : 0,
+      state: isSet(object.state) ? operation_OperationStateFromJSON(object.state) : 0,
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      events: Array.isArray(object?.events)
+        ? object.events.map((e: any) => OperationEvent.fromJSON(e))
+        : [],
+      errorCode: isSet(object.errorCode) ? Number(object.errorCode) : 0,
+      errorMessage: isSet(object.errorMessage) ? String(object.errorMessage) : "",
+      created: isSet(object.created) ? fromJsonTimestamp(object.created) : undefined,
+      updated: isSet(object.updated) ? fromJsonTimestamp(object.updated) : undefined,
+      context: isSet(object.context) ? Context.fromJSON(object.context) : undefined,
     };
   },
 
-  toJSON(message: ReconciliationOptions): unknown {
+  toJSON(message: Operation): unknown {
     const obj: any = {};
-    message.interval !== undefined && (obj.interval = message.interval);
-    message.suspend !== undefined && (obj.suspend = message.suspend);
-    message.serviceAccountName !== undefined &&
-      (obj.serviceAccountName = message.serviceAccountName);
+    message.id !== undefined && (obj.id = message.id);
+    message.type !== undefined && (obj.type = operation_OperationTypeToJSON(message.type));
+    message.state !== undefined && (obj.state = operation_OperationStateToJSON(message.state));
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    if (message.events) {
+      obj.events = message.events.map(e => (e ? OperationEvent.toJSON(e) : undefined));
+    } else {
+      obj.events = [];
+    }
+    message.errorCode !== undefined && (obj.errorCode = Math.round(message.errorCode));
+    message.errorMessage !== undefined && (obj.errorMessage = message.errorMessage);
+    message.created !== undefined && (obj.created = message.created.toISOString());
+    message.updated !== undefined && (obj.updated = message.updated.toISOString());
+    message.context !== undefined &&
+      (obj.context = message.context ? Context.toJSON(message.context) : undefined);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<ReconciliationOptions>, I>>(
-    object: I,
-  ): ReconciliationOptions {
-    const message = createBaseReconciliationOptions();
-    message.interval = object.interval ?? "";
-    message.suspend = object.suspend ?? false;
-    message.serviceAccountName = object.serviceAccountName ?? "";
+  fromPartial<I extends Exact<DeepPartial<Operation>, I>>(object: I): Operation {
+    const message = createBaseOperation();
+    message.id = object.id ?? "";
+    message.type = object.type ?? 0;
+    message.state = object.state ?? 0;
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.events = object.events?.map(e => OperationEvent.fromPartial(e)) || [];
+    message.errorCode = object.errorCode ?? 0;
+    message.errorMessage = object.errorMessage ?? "";
+    message.created = object.created ?? undefined;
+    message.updated = object.updated ?? undefined;
+    message.context =
+      object.context !== undefined && object.context !== null
+        ? Context.fromPartial(object.context)
+        : undefined;
     return message;
   },
 };
 
-function createBasePackageAppVersion(): PackageAppVersion {
-  return { pkgVersion: "", appVersion: "" };
+function createBaseOperationEvent(): OperationEvent {
+  return { time: undefined, message: "" };
 }
 
-export const PackageAppVersion = {
-  encode(message: PackageAppVersion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.pkgVersion !== "") {
-      writer.uint32(10).string(message.pkgVersion);
+export const OperationEvent = {
+  encode(message: OperationEvent, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(10).fork()).ldelim();
     }
-    if (message.appVersion !== "") {
-      writer.uint32(18).string(message.appVersion);
+    if (message.message !== "") {
+      writer.uint32(18).string(message.message);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PackageAppVersion {
+  decode(input: _m0.Reader | Uint8Array, length?: number): OperationEvent {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePackageAppVersion();
+    const message = createBaseOperationEvent();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.pkgVersion = reader.string();
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           break;
         case 2:
-          message.appVersion = reader.string();
+          message.message = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -3835,67 +5628,61 @@ export const PackageAppVersion = {
     return message;
   },
 
-  fromJSON(object: any): PackageAppVersion {
+  fromJSON(object: any): OperationEvent {
     return {
-      pkgVersion: isSet(object.pkgVersion) ? String(object.pkgVersion) : "",
-      appVersion: isSet(object.appVersion) ? String(object.appVersion) : "",
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+      message: isSet(object.message) ? String(object.message) : "",
     };
   },
 
-  toJSON(message: PackageAppVersion): unknown {
+  toJSON(message: OperationEvent): unknown {
     const obj: any = {};
-    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
-    message.appVersion !== undefined && (obj.appVersion = message.appVersion);
+    message.time !== undefined && (obj.time = message.time.toISOString());
+    message.message !== undefined && (obj.message = message.message);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<PackageAppVersion>, I>>(object: I): PackageAppVersion {
-    const message = createBasePackageAppVersion();
-    message.pkgVersion = object.pkgVersion ?? "";
-    message.appVersion = object.appVersion ?? "";
+  fromPartial<I extends Exact<DeepPartial<OperationEvent>, I>>(object: I): OperationEvent {
+    const message = createBaseOperationEvent();
+    message.time = object.time ?? undefined;
+    message.message = object.message ?? "";
     return message;
   },
 };
 
-function createBaseResourceRef(): ResourceRef {
-  return { apiVersion: "", kind: "", name: "", namespace: "" };
+function createBasePluginError(): PluginError {
+  return { plugin: undefined, code: 0, message: "" };
 }
 
-export const ResourceRef = {
-  encode(message: ResourceRef, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.apiVersion !== "") {
-      writer.uint32(10).string(message.apiVersion);
-    }
-    if (message.kind !== "") {
-      writer.uint32(18).string(message.kind);
+export const PluginError = {
+  encode(message: PluginError, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.plugin !== undefined) {
+      Plugin.encode(message.plugin, writer.uint32(10).fork()).ldelim();
     }
-    if (message.name !== "") {
-      writer.uint32(26).string(message.name);
+    if (message.code !== 0) {
+      writer.uint32(16).int32(message.code);
     }
-    if (message.namespace !== "") {
-      writer.uint32(34).string(message.namespace);
+    if (message.message !== "") {
+      writer.uint32(26).string(message.message);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ResourceRef {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PluginError {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResourceRef();
+    const message = createBasePluginError();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.apiVersion = reader.string();
+          message.plugin = Plugin.decode(reader, reader.uint32());
           break;
         case 2:
-          message.kind = reader.string();
+          message.code = reader.int32();
           break;
         case 3:
-          message.name = reader.string();
-          break;
-        case 4:
-          message.namespace = reader.string();
+          message.message = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -3905,30 +5692,31 @@ export const ResourceRef = {
     return message;
   },
 
-  fromJSON(object: any): ResourceRef {
+  fromJSON(object: any): PluginError {
     return {
-      apiVersion: isSet(object.apiVersion) ? String(object.apiVersion) : "",
-      kind: isSet(object.kind) ? String(object.kind) : "",
-      name: isSet(object.name) ? String(object.name) : "",
-      namespace: isSet(object.namespace) ? String(object.namespace) : "",
+      plugin: isSet(object.plugin) ? Plugin.fromJSON(object.plugin) : undefined,
+      code: isSet(object.code) ? Number(object.code) : 0,
+      message: isSet(object.message) ? String(object.message) : "",
     };
   },
 
-  toJSON(message: ResourceRef): unknown {
+  toJSON(message: PluginError): unknown {
     const obj: any = {};
-    message.apiVersion !== undefined && (obj.apiVersion = message.apiVersion);
-    message.kind !== undefined && (obj.kind = message.kind);
-    message.name !== undefined && (obj.name = message.name);
-    message.namespace !== undefined && (obj.namespace = message.namespace);
+    message.plugin !== undefined &&
+      (obj.plugin = message.plugin ? Plugin.toJSON(message.plugin) : undefined);
+    message.code !== undefined && (obj.code = Math.round(message.code));
+    message.message !== undefined && (obj.message = message.message);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<ResourceRef>, I>>(object: I): ResourceRef {
-    const message = createBaseResourceRef();
-    message.apiVersion = object.apiVersion ?? "";
-    message.kind = object.kind ?? "";
-    message.name = object.name ?? "";
-    message.namespace = object.namespace ?? "";
+  fromPartial<I extends Exact<DeepPartial<PluginError>, I>>(object: I): PluginError {
+    const message = createBasePluginError();
+    message.plugin =
+      object.plugin !== undefined && object.plugin !== null
+        ? Plugin.fromPartial(object.plugin)
+        : undefined;
+    message.code = object.code ?? 0;
+    message.message = object.message ?? "";
     return message;
   },
 };
@@ -3971,6 +5759,18 @@ export interface PackagesService {
     request: DeepPartial<GetInstalledPackageResourceRefsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageResourceRefsResponse>;
+  WatchInstalledPackageSummaries(
+    request: DeepPartial<WatchInstalledPackageSummariesRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<WatchInstalledPackageSummariesResponse>;
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse>;
+  RollbackInstalledPackage(
+    request: DeepPartial<RollbackInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RollbackInstalledPackageResponse>;
 }
 
 export class PackagesServiceClientImpl implements PackagesService {
@@ -3987,6 +5787,9 @@ export class PackagesServiceClientImpl implements PackagesService {
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
     this.GetInstalledPackageResourceRefs = this.GetInstalledPackageResourceRefs.bind(this);
+    this.WatchInstalledPackageSummaries = this.WatchInstalledPackageSummaries.bind(this);
+    this.GetInstalledPackageRevisions = this.GetInstalledPackageRevisions.bind(this);
+    this.RollbackInstalledPackage = this.RollbackInstalledPackage.bind(this);
   }
 
   GetAvailablePackageSummaries(
@@ -4087,6 +5890,39 @@ export class PackagesServiceClientImpl implements PackagesService {
       metadata,
     );
   }
+
+  WatchInstalledPackageSummaries(
+    request: DeepPartial<WatchInstalledPackageSummariesRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<WatchInstalledPackageSummariesResponse> {
+    return this.rpc.invoke(
+      PackagesServiceWatchInstalledPackageSummariesDesc,
+      WatchInstalledPackageSummariesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse> {
+    return this.rpc.unary(
+      PackagesServiceGetInstalledPackageRevisionsDesc,
+      GetInstalledPackageRevisionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  RollbackInstalledPackage(
+    request: DeepPartial<RollbackInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RollbackInstalledPackageResponse> {
+    return this.rpc.unary(
+      PackagesServiceRollbackInstalledPackageDesc,
+      RollbackInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const PackagesServiceDesc = {
@@ -4291,6 +6127,72 @@ export const PackagesServiceGetInstalledPackageResourceRefsDesc: UnaryMethodDefi
   } as any,
 };
 
+export const PackagesServiceWatchInstalledPackageSummariesDesc: UnaryMethodDefinitionish = {
+  methodName: "WatchInstalledPackageSummaries",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: true,
+  requestType: {
+    serializeBinary() {
+      return WatchInstalledPackageSummariesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...WatchInstalledPackageSummariesResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceGetInstalledPackageRevisionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageRevisions",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageRevisionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageRevisionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PackagesServiceRollbackInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "RollbackInstalledPackage",
+  service: PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return RollbackInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...RollbackInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
@@ -4304,13 +6206,18 @@ interface Rpc {
     request: any,
     metadata: grpc.Metadata | undefined,
   ): Promise<any>;
+  invoke<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Observable<any>;
 }
 
 export class GrpcWebImpl {
   private host: string;
   private options: {
     transport?: grpc.TransportFactory;
-
+    streamingTransport?: grpc.TransportFactory;
     debug?: boolean;
     metadata?: grpc.Metadata;
   };
@@ -4319,7 +6226,7 @@ export class GrpcWebImpl {
     host: string,
     options: {
       transport?: grpc.TransportFactory;
-
+      streamingTransport?: grpc.TransportFactory;
       debug?: boolean;
       metadata?: grpc.Metadata;
     },
@@ -4361,6 +6268,47 @@ export class GrpcWebImpl {
       });
     });
   }
+
+  invoke<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    _request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Observable<any> {
+    // Status Response Codes (https://developers.google.com/maps-booking/reference/grpc-api/status_codes)
+    const upStreamCodes = [2, 4, 8, 9, 10, 13, 14, 15];
+    const DEFAULT_TIMEOUT_TIME: number = 3_000;
+    const request = { ..._request, ...methodDesc.requestType };
+    const maybeCombinedMetadata =
+      metadata && this.options.metadata
+        ? new BrowserHeaders({
+            ...this.options?.metadata.headersMap,
+            ...metadata?.headersMap,
+          })
+        : metadata || this.options.metadata;
+    return new Observable(observer => {
+      const upStream = () => {
+        const client = grpc.invoke(methodDesc, {
+          host: this.host,
+          request,
+          transport: this.options.streamingTransport || this.options.transport,
+          metadata: maybeCombinedMetadata,
+          debug: this.options.debug,
+          onMessage: next => observer.next(next),
+          onEnd: (code: grpc.Code, message: string) => {
+            if (code === 0) {
+              observer.complete();
+            } else if (upStreamCodes.includes(code)) {
+              setTimeout(upStream, DEFAULT_TIMEOUT_TIME);
+            } else {
+              observer.error(new Error(`Error ${code} ${message}`));
+            }
+          },
+        });
+        observer.add(() => client.close());
+      };
+      upStream();
+    }).pipe(share());
+  }
 }
 
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
@@ -4380,6 +6328,28 @@ export type Exact<P, I extends P> = P extends Builtin
   ? P
   : P & { [K in keyof P]: Exact<P[K], I[K]> } & Record<Exclude<keyof I, KeysOfUnion<P>>, never>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = t.seconds * 1_000;
+  millis += t.nanos / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
@@ -32,8 +32,8 @@ export interface AddPackageRepositoryRequest {
   /**
    * Package storage type
    * In general, each plug-in will define an acceptable set of valid types
-   * - for direct helm plug-in valid values are: helm, oci
-   * - for flux plug-in currently only supported value is helm. In the
+   * - for direct helm plug-in valid values are: “helm” and “oci”
+   * - for flux plug-in valid values are: “helm” and “oci”. In the
    *   future, we may add support for git and/or AWS s3-style buckets
    */
   type: string;
@@ -364,6 +364,29 @@ export interface DeletePackageRepositoryRequest {
   packageRepoRef?: PackageRepositoryReference;
 }
 
+/**
+ * RefreshPackageRepositoryRequest
+ *
+ * Request for RefreshPackageRepository
+ */
+export interface RefreshPackageRepositoryRequest {
+  /** A reference uniquely identifying the package repository to be refreshed. */
+  packageRepoRef?: PackageRepositoryReference;
+}
+
+/**
+ * ValidatePackageRepositoryRequest
+ *
+ * Request for ValidatePackageRepository
+ */
+export interface ValidatePackageRepositoryRequest {
+  /**
+   * The package repository to be validated, with the same details as when
+   * adding it. The repository is only validated, it is not created.
+   */
+  packageRepository?: AddPackageRepositoryRequest;
+}
+
 /**
  * PackageRepositoryReference
  *
@@ -580,6 +603,32 @@ export interface UpdatePackageRepositoryResponse {
  */
 export interface DeletePackageRepositoryResponse {}
 
+/**
+ * RefreshPackageRepositoryResponse
+ *
+ * Response for RefreshPackageRepository
+ */
+export interface RefreshPackageRepositoryResponse {
+  packageRepoRef?: PackageRepositoryReference;
+}
+
+/**
+ * ValidatePackageRepositoryResponse
+ *
+ * Response for ValidatePackageRepository
+ */
+export interface ValidatePackageRepositoryResponse {
+  /** Whether the package repository could be reached with the given details. */
+  valid: boolean;
+  /**
+   * UserReason
+   *
+   * Optional text explaining why the package repository is not valid, which
+   * may be plugin specific.
+   */
+  userReason: string;
+}
+
 function createBaseAddPackageRepositoryRequest(): AddPackageRepositoryRequest {
   return {
     context: undefined,
@@ -1788,6 +1837,133 @@ export const DeletePackageRepositoryRequest = {
   },
 };
 
+function createBaseRefreshPackageRepositoryRequest(): RefreshPackageRepositoryRequest {
+  return { packageRepoRef: undefined };
+}
+
+export const RefreshPackageRepositoryRequest = {
+  encode(
+    message: RefreshPackageRepositoryRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.packageRepoRef !== undefined) {
+      PackageRepositoryReference.encode(message.packageRepoRef, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RefreshPackageRepositoryRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRefreshPackageRepositoryRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.packageRepoRef = PackageRepositoryReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RefreshPackageRepositoryRequest {
+    return {
+      packageRepoRef: isSet(object.packageRepoRef)
+        ? PackageRepositoryReference.fromJSON(object.packageRepoRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: RefreshPackageRepositoryRequest): unknown {
+    const obj: any = {};
+    message.packageRepoRef !== undefined &&
+      (obj.packageRepoRef = message.packageRepoRef
+        ? PackageRepositoryReference.toJSON(message.packageRepoRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<RefreshPackageRepositoryRequest>, I>>(
+    object: I,
+  ): RefreshPackageRepositoryRequest {
+    const message = createBaseRefreshPackageRepositoryRequest();
+    message.packageRepoRef =
+      object.packageRepoRef !== undefined && object.packageRepoRef !== null
+        ? PackageRepositoryReference.fromPartial(object.packageRepoRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseValidatePackageRepositoryRequest(): ValidatePackageRepositoryRequest {
+  return { packageRepository: undefined };
+}
+
+export const ValidatePackageRepositoryRequest = {
+  encode(
+    message: ValidatePackageRepositoryRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.packageRepository !== undefined) {
+      AddPackageRepositoryRequest.encode(
+        message.packageRepository,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ValidatePackageRepositoryRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseValidatePackageRepositoryRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.packageRepository = AddPackageRepositoryRequest.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ValidatePackageRepositoryRequest {
+    return {
+      packageRepository: isSet(object.packageRepository)
+        ? AddPackageRepositoryRequest.fromJSON(object.packageRepository)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ValidatePackageRepositoryRequest): unknown {
+    const obj: any = {};
+    message.packageRepository !== undefined &&
+      (obj.packageRepository = message.packageRepository
+        ? AddPackageRepositoryRequest.toJSON(message.packageRepository)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ValidatePackageRepositoryRequest>, I>>(
+    object: I,
+  ): ValidatePackageRepositoryRequest {
+    const message = createBaseValidatePackageRepositoryRequest();
+    message.packageRepository =
+      object.packageRepository !== undefined && object.packageRepository !== null
+        ? AddPackageRepositoryRequest.fromPartial(object.packageRepository)
+        : undefined;
+    return message;
+  },
+};
+
 function createBasePackageRepositoryReference(): PackageRepositoryReference {
   return { context: undefined, identifier: "", plugin: undefined };
 }
@@ -2534,6 +2710,131 @@ export const DeletePackageRepositoryResponse = {
   },
 };
 
+function createBaseRefreshPackageRepositoryResponse(): RefreshPackageRepositoryResponse {
+  return { packageRepoRef: undefined };
+}
+
+export const RefreshPackageRepositoryResponse = {
+  encode(
+    message: RefreshPackageRepositoryResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.packageRepoRef !== undefined) {
+      PackageRepositoryReference.encode(message.packageRepoRef, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RefreshPackageRepositoryResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRefreshPackageRepositoryResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.packageRepoRef = PackageRepositoryReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RefreshPackageRepositoryResponse {
+    return {
+      packageRepoRef: isSet(object.packageRepoRef)
+        ? PackageRepositoryReference.fromJSON(object.packageRepoRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: RefreshPackageRepositoryResponse): unknown {
+    const obj: any = {};
+    message.packageRepoRef !== undefined &&
+      (obj.packageRepoRef = message.packageRepoRef
+        ? PackageRepositoryReference.toJSON(message.packageRepoRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<RefreshPackageRepositoryResponse>, I>>(
+    object: I,
+  ): RefreshPackageRepositoryResponse {
+    const message = createBaseRefreshPackageRepositoryResponse();
+    message.packageRepoRef =
+      object.packageRepoRef !== undefined && object.packageRepoRef !== null
+        ? PackageRepositoryReference.fromPartial(object.packageRepoRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseValidatePackageRepositoryResponse(): ValidatePackageRepositoryResponse {
+  return { valid: false, userReason: "" };
+}
+
+export const ValidatePackageRepositoryResponse = {
+  encode(
+    message: ValidatePackageRepositoryResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.valid === true) {
+      writer.uint32(8).bool(message.valid);
+    }
+    if (message.userReason !== "") {
+      writer.uint32(18).string(message.userReason);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ValidatePackageRepositoryResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseValidatePackageRepositoryResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.valid = reader.bool();
+          break;
+        case 2:
+          message.userReason = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ValidatePackageRepositoryResponse {
+    return {
+      valid: isSet(object.valid) ? Boolean(object.valid) : false,
+      userReason: isSet(object.userReason) ? String(object.userReason) : "",
+    };
+  },
+
+  toJSON(message: ValidatePackageRepositoryResponse): unknown {
+    const obj: any = {};
+    message.valid !== undefined && (obj.valid = message.valid);
+    message.userReason !== undefined && (obj.userReason = message.userReason);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ValidatePackageRepositoryResponse>, I>>(
+    object: I,
+  ): ValidatePackageRepositoryResponse {
+    const message = createBaseValidatePackageRepositoryResponse();
+    message.valid = object.valid ?? false;
+    message.userReason = object.userReason ?? "";
+    return message;
+  },
+};
+
 /** Each repositories v1alpha1 plugin must implement at least the following rpcs: */
 export interface RepositoriesService {
   AddPackageRepository(
@@ -2556,6 +2857,14 @@ export interface RepositoriesService {
     request: DeepPartial<DeletePackageRepositoryRequest>,
     metadata?: grpc.Metadata,
   ): Promise<DeletePackageRepositoryResponse>;
+  RefreshPackageRepository(
+    request: DeepPartial<RefreshPackageRepositoryRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RefreshPackageRepositoryResponse>;
+  ValidatePackageRepository(
+    request: DeepPartial<ValidatePackageRepositoryRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ValidatePackageRepositoryResponse>;
 }
 
 export class RepositoriesServiceClientImpl implements RepositoriesService {
@@ -2568,6 +2877,8 @@ export class RepositoriesServiceClientImpl implements RepositoriesService {
     this.GetPackageRepositorySummaries = this.GetPackageRepositorySummaries.bind(this);
     this.UpdatePackageRepository = this.UpdatePackageRepository.bind(this);
     this.DeletePackageRepository = this.DeletePackageRepository.bind(this);
+    this.RefreshPackageRepository = this.RefreshPackageRepository.bind(this);
+    this.ValidatePackageRepository = this.ValidatePackageRepository.bind(this);
   }
 
   AddPackageRepository(
@@ -2624,6 +2935,28 @@ export class RepositoriesServiceClientImpl implements RepositoriesService {
       metadata,
     );
   }
+
+  RefreshPackageRepository(
+    request: DeepPartial<RefreshPackageRepositoryRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RefreshPackageRepositoryResponse> {
+    return this.rpc.unary(
+      RepositoriesServiceRefreshPackageRepositoryDesc,
+      RefreshPackageRepositoryRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ValidatePackageRepository(
+    request: DeepPartial<ValidatePackageRepositoryRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ValidatePackageRepositoryResponse> {
+    return this.rpc.unary(
+      RepositoriesServiceValidatePackageRepositoryDesc,
+      ValidatePackageRepositoryRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const RepositoriesServiceDesc = {
@@ -2740,6 +3073,50 @@ export const RepositoriesServiceDeletePackageRepositoryDesc: UnaryMethodDefiniti
   } as any,
 };
 
+export const RepositoriesServiceRefreshPackageRepositoryDesc: UnaryMethodDefinitionish = {
+  methodName: "RefreshPackageRepository",
+  service: RepositoriesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return RefreshPackageRepositoryRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...RefreshPackageRepositoryResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const RepositoriesServiceValidatePackageRepositoryDesc: UnaryMethodDefinitionish = {
+  methodName: "ValidatePackageRepository",
+  service: RepositoriesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ValidatePackageRepositoryRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ValidatePackageRepositoryResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
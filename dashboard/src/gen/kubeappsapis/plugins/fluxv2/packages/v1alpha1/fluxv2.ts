@@ -3,6 +3,10 @@ import Long from "long";
 import { grpc } from "@improbable-eng/grpc-web";
 import * as _m0 from "protobufjs/minimal";
 import {
+  InstalledPackageReference,
+  InstalledPackageStatus,
+  Context,
+  ReconciliationOptions,
   GetAvailablePackageSummariesRequest,
   GetAvailablePackageDetailRequest,
   GetAvailablePackageVersionsRequest,
@@ -12,6 +16,8 @@ import {
   UpdateInstalledPackageRequest,
   DeleteInstalledPackageRequest,
   GetInstalledPackageResourceRefsRequest,
+  GetInstalledPackageRevisionsRequest,
+  RollbackInstalledPackageRequest,
   GetAvailablePackageSummariesResponse,
   GetAvailablePackageDetailResponse,
   GetAvailablePackageVersionsResponse,
@@ -21,8 +27,12 @@ import {
   UpdateInstalledPackageResponse,
   DeleteInstalledPackageResponse,
   GetInstalledPackageResourceRefsResponse,
+  GetInstalledPackageRevisionsResponse,
+  RollbackInstalledPackageResponse,
 } from "../../../../../kubeappsapis/core/packages/v1alpha1/packages";
 import {
+  PackageRepositoryReference,
+  PackageRepositoryStatus,
   AddPackageRepositoryRequest,
   GetPackageRepositoryDetailRequest,
   GetPackageRepositorySummariesRequest,
@@ -46,6 +56,264 @@ export interface SetUserManagedSecretsResponse {
   value: boolean;
 }
 
+/**
+ * SuspendInstalledPackageRequest
+ *
+ * Request for SuspendInstalledPackage
+ */
+export interface SuspendInstalledPackageRequest {
+  /** The reference of the installed package to be suspended */
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * SuspendInstalledPackageResponse
+ *
+ * Response for SuspendInstalledPackage
+ */
+export interface SuspendInstalledPackageResponse {
+  /** The status of the installed package once the request has been applied */
+  status?: InstalledPackageStatus;
+  /** Whether the reconciliation of the installed package is suspended */
+  suspended: boolean;
+}
+
+/**
+ * ResumeInstalledPackageRequest
+ *
+ * Request for ResumeInstalledPackage
+ */
+export interface ResumeInstalledPackageRequest {
+  /** The reference of the installed package to be resumed */
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * ResumeInstalledPackageResponse
+ *
+ * Response for ResumeInstalledPackage
+ */
+export interface ResumeInstalledPackageResponse {
+  /** The status of the installed package once the request has been applied */
+  status?: InstalledPackageStatus;
+  /** Whether the reconciliation of the installed package is suspended */
+  suspended: boolean;
+}
+
+/**
+ * ReconcileInstalledPackageRequest
+ *
+ * Request for ReconcileInstalledPackage
+ */
+export interface ReconcileInstalledPackageRequest {
+  /** The reference of the installed package to be reconciled */
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * ReconcileInstalledPackageResponse
+ *
+ * Response for ReconcileInstalledPackage
+ */
+export interface ReconcileInstalledPackageResponse {
+  /** The status of the installed package once the request has been applied */
+  status?: InstalledPackageStatus;
+  /** Whether the reconciliation of the installed package is suspended */
+  suspended: boolean;
+}
+
+/**
+ * SuspendPackageRepositoryRequest
+ *
+ * Request for SuspendPackageRepository
+ */
+export interface SuspendPackageRepositoryRequest {
+  /** The reference of the package repository to be suspended */
+  packageRepoRef?: PackageRepositoryReference;
+}
+
+/**
+ * SuspendPackageRepositoryResponse
+ *
+ * Response for SuspendPackageRepository
+ */
+export interface SuspendPackageRepositoryResponse {
+  /** The status of the package repository once the request has been applied */
+  status?: PackageRepositoryStatus;
+  /** Whether the reconciliation of the package repository is suspended */
+  suspended: boolean;
+}
+
+/**
+ * ResumePackageRepositoryRequest
+ *
+ * Request for ResumePackageRepository
+ */
+export interface ResumePackageRepositoryRequest {
+  /** The reference of the package repository to be resumed */
+  packageRepoRef?: PackageRepositoryReference;
+}
+
+/**
+ * ResumePackageRepositoryResponse
+ *
+ * Response for ResumePackageRepository
+ */
+export interface ResumePackageRepositoryResponse {
+  /** The status of the package repository once the request has been applied */
+  status?: PackageRepositoryStatus;
+  /** Whether the reconciliation of the package repository is suspended */
+  suspended: boolean;
+}
+
+/**
+ * ReconcilePackageRepositoryRequest
+ *
+ * Request for ReconcilePackageRepository
+ */
+export interface ReconcilePackageRepositoryRequest {
+  /** The reference of the package repository to be reconciled */
+  packageRepoRef?: PackageRepositoryReference;
+}
+
+/**
+ * ReconcilePackageRepositoryResponse
+ *
+ * Response for ReconcilePackageRepository
+ */
+export interface ReconcilePackageRepositoryResponse {
+  /** The status of the package repository once the request has been applied */
+  status?: PackageRepositoryStatus;
+  /** Whether the reconciliation of the package repository is suspended */
+  suspended: boolean;
+}
+
+/**
+ * CreateKustomizationRequest
+ *
+ * Request for CreateKustomization
+ */
+export interface CreateKustomizationRequest {
+  /** The context in which the Kustomization is created. The namespace is required. */
+  targetContext?: Context;
+  /** The name of the Kustomization */
+  name: string;
+  /** The source the manifests are taken from */
+  sourceRef?: KustomizationSourceReference;
+  /**
+   * The path of the directory with the manifests in the source, relative to
+   * its root. Defaults to the root of the source
+   */
+  path: string;
+  /**
+   * The namespace the resources are created in, overriding the namespaces of
+   * the manifests. Optional
+   */
+  targetNamespace: string;
+  /**
+   * Whether the resources that are no longer in the source are deleted, as well
+   * as all the resources once the installed package is deleted
+   */
+  prune: boolean;
+  /** The reconciliation options of the Kustomization */
+  reconciliationOptions?: ReconciliationOptions;
+}
+
+/**
+ * CreateKustomizationResponse
+ *
+ * Response for CreateKustomization
+ */
+export interface CreateKustomizationResponse {
+  /** The reference of the installed package for the created Kustomization */
+  installedPackageRef?: InstalledPackageReference;
+}
+
+/**
+ * KustomizationSourceReference
+ *
+ * A reference to the source of the manifests of a Kustomization
+ */
+export interface KustomizationSourceReference {
+  /** The kind of the source, either "GitRepository" or "OCIRepository" */
+  kind: string;
+  /** The name of the source */
+  name: string;
+  /** The namespace of the source. Defaults to the namespace of the Kustomization */
+  namespace: string;
+}
+
+/**
+ * FluxKustomizationCustomDetail
+ *
+ * The flux-specific detail of an installed package for a Kustomization, returned
+ * as the custom_detail of its InstalledPackageDetail.
+ */
+export interface FluxKustomizationCustomDetail {
+  /** The source the manifests are taken from */
+  sourceRef?: KustomizationSourceReference;
+  /** The path of the directory with the manifests in the source */
+  path: string;
+  /** The namespace the resources are created in, if overridden */
+  targetNamespace: string;
+  /** Whether the resources that are no longer in the source are deleted */
+  prune: boolean;
+  /** The revision of the source that was last applied successfully */
+  lastAppliedRevision: string;
+  /** The revision of the source that was last attempted to be applied */
+  lastAttemptedRevision: string;
+}
+
+/**
+ * FluxPackageInstallCustomDetail
+ *
+ * The flux-specific detail of an installed package, i.e. a HelmRelease. It is
+ * returned as the custom_detail of InstalledPackageDetail and may be set as the
+ * custom_detail of CreateInstalledPackageRequest and UpdateInstalledPackageRequest.
+ */
+export interface FluxPackageInstallCustomDetail {
+  /**
+   * The references to the Secrets and ConfigMaps in the namespace of the installed
+   * package that values are taken from, in the order they are merged. The inline
+   * values of the installed package are merged last, so they take precedence.
+   * On update, these references replace the existing ones. If no custom detail is
+   * set on update, the existing references are kept.
+   */
+  valuesFrom: ValuesReference[];
+}
+
+/**
+ * ValuesReference
+ *
+ * A reference to a Secret or ConfigMap containing values for an installed package.
+ * See https://fluxcd.io/docs/components/helm/helmreleases/#values-overrides
+ */
+export interface ValuesReference {
+  /** The kind of the resource, either "Secret" or "ConfigMap" */
+  kind: string;
+  /** The name of the resource, in the namespace of the installed package */
+  name: string;
+  /**
+   * The key in the data of the resource the values are stored under.
+   * Defaults to "values.yaml"
+   */
+  valuesKey: string;
+  /**
+   * The YAML dot notation path the values are merged at, e.g. "image.tag".
+   * Defaults to the root of the values
+   */
+  targetPath: string;
+  /** Whether the resource and key may be missing, in which case they are ignored */
+  optional: boolean;
+  /**
+   * The values currently stored in the resource, in YAML or JSON format.
+   * Only set in the custom detail of InstalledPackageDetail, and empty when the
+   * resource or key does not exist or cannot be read. The values of a Secret
+   * are redacted, i.e. each of them is replaced by "REDACTED"
+   */
+  values: string;
+}
+
 function createBaseSetUserManagedSecretsRequest(): SetUserManagedSecretsRequest {
   return { value: false };
 }
@@ -115,15 +383,1346 @@ export const SetUserManagedSecretsResponse = {
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SetUserManagedSecretsResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SetUserManagedSecretsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSetUserManagedSecretsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.value = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SetUserManagedSecretsResponse {
+    return {
+      value: isSet(object.value) ? Boolean(object.value) : false,
+    };
+  },
+
+  toJSON(message: SetUserManagedSecretsResponse): unknown {
+    const obj: any = {};
+    message.value !== undefined && (obj.value = message.value);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<SetUserManagedSecretsResponse>, I>>(
+    object: I,
+  ): SetUserManagedSecretsResponse {
+    const message = createBaseSetUserManagedSecretsResponse();
+    message.value = object.value ?? false;
+    return message;
+  },
+};
+
+function createBaseSuspendInstalledPackageRequest(): SuspendInstalledPackageRequest {
+  return { installedPackageRef: undefined };
+}
+
+export const SuspendInstalledPackageRequest = {
+  encode(
+    message: SuspendInstalledPackageRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SuspendInstalledPackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSuspendInstalledPackageRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SuspendInstalledPackageRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: SuspendInstalledPackageRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<SuspendInstalledPackageRequest>, I>>(
+    object: I,
+  ): SuspendInstalledPackageRequest {
+    const message = createBaseSuspendInstalledPackageRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseSuspendInstalledPackageResponse(): SuspendInstalledPackageResponse {
+  return { status: undefined, suspended: false };
+}
+
+export const SuspendInstalledPackageResponse = {
+  encode(
+    message: SuspendInstalledPackageResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.status !== undefined) {
+      InstalledPackageStatus.encode(message.status, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.suspended === true) {
+      writer.uint32(16).bool(message.suspended);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SuspendInstalledPackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSuspendInstalledPackageResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.status = InstalledPackageStatus.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.suspended = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SuspendInstalledPackageResponse {
+    return {
+      status: isSet(object.status) ? InstalledPackageStatus.fromJSON(object.status) : undefined,
+      suspended: isSet(object.suspended) ? Boolean(object.suspended) : false,
+    };
+  },
+
+  toJSON(message: SuspendInstalledPackageResponse): unknown {
+    const obj: any = {};
+    message.status !== undefined &&
+      (obj.status = message.status ? InstalledPackageStatus.toJSON(message.status) : undefined);
+    message.suspended !== undefined && (obj.suspended = message.suspended);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<SuspendInstalledPackageResponse>, I>>(
+    object: I,
+  ): SuspendInstalledPackageResponse {
+    const message = createBaseSuspendInstalledPackageResponse();
+    message.status =
+      object.status !== undefined && object.status !== null
+        ? InstalledPackageStatus.fromPartial(object.status)
+        : undefined;
+    message.suspended = object.suspended ?? false;
+    return message;
+  },
+};
+
+function createBaseResumeInstalledPackageRequest(): ResumeInstalledPackageRequest {
+  return { installedPackageRef: undefined };
+}
+
+export const ResumeInstalledPackageRequest = {
+  encode(
+    message: ResumeInstalledPackageRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResumeInstalledPackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResumeInstalledPackageRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResumeInstalledPackageRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ResumeInstalledPackageRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ResumeInstalledPackageRequest>, I>>(
+    object: I,
+  ): ResumeInstalledPackageRequest {
+    const message = createBaseResumeInstalledPackageRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseResumeInstalledPackageResponse(): ResumeInstalledPackageResponse {
+  return { status: undefined, suspended: false };
+}
+
+export const ResumeInstalledPackageResponse = {
+  encode(
+    message: ResumeInstalledPackageResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.status !== undefined) {
+      InstalledPackageStatus.encode(message.status, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.suspended === true) {
+      writer.uint32(16).bool(message.suspended);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResumeInstalledPackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResumeInstalledPackageResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.status = InstalledPackageStatus.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.suspended = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResumeInstalledPackageResponse {
+    return {
+      status: isSet(object.status) ? InstalledPackageStatus.fromJSON(object.status) : undefined,
+      suspended: isSet(object.suspended) ? Boolean(object.suspended) : false,
+    };
+  },
+
+  toJSON(message: ResumeInstalledPackageResponse): unknown {
+    const obj: any = {};
+    message.status !== undefined &&
+      (obj.status = message.status ? InstalledPackageStatus.toJSON(message.status) : undefined);
+    message.suspended !== undefined && (obj.suspended = message.suspended);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ResumeInstalledPackageResponse>, I>>(
+    object: I,
+  ): ResumeInstalledPackageResponse {
+    const message = createBaseResumeInstalledPackageResponse();
+    message.status =
+      object.status !== undefined && object.status !== null
+        ? InstalledPackageStatus.fromPartial(object.status)
+        : undefined;
+    message.suspended = object.suspended ?? false;
+    return message;
+  },
+};
+
+function createBaseReconcileInstalledPackageRequest(): ReconcileInstalledPackageRequest {
+  return { installedPackageRef: undefined };
+}
+
+export const ReconcileInstalledPackageRequest = {
+  encode(
+    message: ReconcileInstalledPackageRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ReconcileInstalledPackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseReconcileInstalledPackageRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ReconcileInstalledPackageRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ReconcileInstalledPackageRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ReconcileInstalledPackageRequest>, I>>(
+    object: I,
+  ): ReconcileInstalledPackageRequest {
+    const message = createBaseReconcileInstalledPackageRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseReconcileInstalledPackageResponse(): ReconcileInstalledPackageResponse {
+  return { status: undefined, suspended: false };
+}
+
+export const ReconcileInstalledPackageResponse = {
+  encode(
+    message: ReconcileInstalledPackageResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.status !== undefined) {
+      InstalledPackageStatus.encode(message.status, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.suspended === true) {
+      writer.uint32(16).bool(message.suspended);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ReconcileInstalledPackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseReconcileInstalledPackageResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.status = InstalledPackageStatus.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.suspended = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ReconcileInstalledPackageResponse {
+    return {
+      status: isSet(object.status) ? InstalledPackageStatus.fromJSON(object.status) : undefined,
+      suspended: isSet(object.suspended) ? Boolean(object.suspended) : false,
+    };
+  },
+
+  toJSON(message: ReconcileInstalledPackageResponse): unknown {
+    const obj: any = {};
+    message.status !== undefined &&
+      (obj.status = message.status ? InstalledPackageStatus.toJSON(message.status) : undefined);
+    message.suspended !== undefined && (obj.suspended = message.suspended);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ReconcileInstalledPackageResponse>, I>>(
+    object: I,
+  ): ReconcileInstalledPackageResponse {
+    const message = createBaseReconcileInstalledPackageResponse();
+    message.status =
+      object.status !== undefined && object.status !== null
+        ? InstalledPackageStatus.fromPartial(object.status)
+        : undefined;
+    message.suspended = object.suspended ?? false;
+    return message;
+  },
+};
+
+function createBaseSuspendPackageRepositoryRequest(): SuspendPackageRepositoryRequest {
+  return { packageRepoRef: undefined };
+}
+
+export const SuspendPackageRepositoryRequest = {
+  encode(
+    message: SuspendPackageRepositoryRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.packageRepoRef !== undefined) {
+      PackageRepositoryReference.encode(message.packageRepoRef, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SuspendPackageRepositoryRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSuspendPackageRepositoryRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.packageRepoRef = PackageRepositoryReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SuspendPackageRepositoryRequest {
+    return {
+      packageRepoRef: isSet(object.packageRepoRef)
+        ? PackageRepositoryReference.fromJSON(object.packageRepoRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: SuspendPackageRepositoryRequest): unknown {
+    const obj: any = {};
+    message.packageRepoRef !== undefined &&
+      (obj.packageRepoRef = message.packageRepoRef
+        ? PackageRepositoryReference.toJSON(message.packageRepoRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<SuspendPackageRepositoryRequest>, I>>(
+    object: I,
+  ): SuspendPackageRepositoryRequest {
+    const message = createBaseSuspendPackageRepositoryRequest();
+    message.packageRepoRef =
+      object.packageRepoRef !== undefined && object.packageRepoRef !== null
+        ? PackageRepositoryReference.fromPartial(object.packageRepoRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseSuspendPackageRepositoryResponse(): SuspendPackageRepositoryResponse {
+  return { status: undefined, suspended: false };
+}
+
+export const SuspendPackageRepositoryResponse = {
+  encode(
+    message: SuspendPackageRepositoryResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.status !== undefined) {
+      PackageRepositoryStatus.encode(message.status, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.suspended === true) {
+      writer.uint32(16).bool(message.suspended);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SuspendPackageRepositoryResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSuspendPackageRepositoryResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.status = PackageRepositoryStatus.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.suspended = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SuspendPackageRepositoryResponse {
+    return {
+      status: isSet(object.status) ? PackageRepositoryStatus.fromJSON(object.status) : undefined,
+      suspended: isSet(object.suspended) ? Boolean(object.suspended) : false,
+    };
+  },
+
+  toJSON(message: SuspendPackageRepositoryResponse): unknown {
+    const obj: any = {};
+    message.status !== undefined &&
+      (obj.status = message.status ? PackageRepositoryStatus.toJSON(message.status) : undefined);
+    message.suspended !== undefined && (obj.suspended = message.suspended);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<SuspendPackageRepositoryResponse>, I>>(
+    object: I,
+  ): SuspendPackageRepositoryResponse {
+    const message = createBaseSuspendPackageRepositoryResponse();
+    message.status =
+      object.status !== undefined && object.status !== null
+        ? PackageRepositoryStatus.fromPartial(object.status)
+        : undefined;
+    message.suspended = object.suspended ?? false;
+    return message;
+  },
+};
+
+function createBaseResumePackageRepositoryRequest(): ResumePackageRepositoryRequest {
+  return { packageRepoRef: undefined };
+}
+
+export const ResumePackageRepositoryRequest = {
+  encode(
+    message: ResumePackageRepositoryRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.packageRepoRef !== undefined) {
+      PackageRepositoryReference.encode(message.packageRepoRef, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResumePackageRepositoryRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResumePackageRepositoryRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.packageRepoRef = PackageRepositoryReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResumePackageRepositoryRequest {
+    return {
+      packageRepoRef: isSet(object.packageRepoRef)
+        ? PackageRepositoryReference.fromJSON(object.packageRepoRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ResumePackageRepositoryRequest): unknown {
+    const obj: any = {};
+    message.packageRepoRef !== undefined &&
+      (obj.packageRepoRef = message.packageRepoRef
+        ? PackageRepositoryReference.toJSON(message.packageRepoRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ResumePackageRepositoryRequest>, I>>(
+    object: I,
+  ): ResumePackageRepositoryRequest {
+    const message = createBaseResumePackageRepositoryRequest();
+    message.packageRepoRef =
+      object.packageRepoRef !== undefined && object.packageRepoRef !== null
+        ? PackageRepositoryReference.fromPartial(object.packageRepoRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseResumePackageRepositoryResponse(): ResumePackageRepositoryResponse {
+  return { status: undefined, suspended: false };
+}
+
+export const ResumePackageRepositoryResponse = {
+  encode(
+    message: ResumePackageRepositoryResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.status !== undefined) {
+      PackageRepositoryStatus.encode(message.status, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.suspended === true) {
+      writer.uint32(16).bool(message.suspended);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResumePackageRepositoryResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResumePackageRepositoryResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.status = PackageRepositoryStatus.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.suspended = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResumePackageRepositoryResponse {
+    return {
+      status: isSet(object.status) ? PackageRepositoryStatus.fromJSON(object.status) : undefined,
+      suspended: isSet(object.suspended) ? Boolean(object.suspended) : false,
+    };
+  },
+
+  toJSON(message: ResumePackageRepositoryResponse): unknown {
+    const obj: any = {};
+    message.status !== undefined &&
+      (obj.status = message.status ? PackageRepositoryStatus.toJSON(message.status) : undefined);
+    message.suspended !== undefined && (obj.suspended = message.suspended);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ResumePackageRepositoryResponse>, I>>(
+    object: I,
+  ): ResumePackageRepositoryResponse {
+    const message = createBaseResumePackageRepositoryResponse();
+    message.status =
+      object.status !== undefined && object.status !== null
+        ? PackageRepositoryStatus.fromPartial(object.status)
+        : undefined;
+    message.suspended = object.suspended ?? false;
+    return message;
+  },
+};
+
+function createBaseReconcilePackageRepositoryRequest(): ReconcilePackageRepositoryRequest {
+  return { packageRepoRef: undefined };
+}
+
+export const ReconcilePackageRepositoryRequest = {
+  encode(
+    message: ReconcilePackageRepositoryRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.packageRepoRef !== undefined) {
+      PackageRepositoryReference.encode(message.packageRepoRef, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ReconcilePackageRepositoryRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseReconcilePackageRepositoryRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.packageRepoRef = PackageRepositoryReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ReconcilePackageRepositoryRequest {
+    return {
+      packageRepoRef: isSet(object.packageRepoRef)
+        ? PackageRepositoryReference.fromJSON(object.packageRepoRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ReconcilePackageRepositoryRequest): unknown {
+    const obj: any = {};
+    message.packageRepoRef !== undefined &&
+      (obj.packageRepoRef = message.packageRepoRef
+        ? PackageRepositoryReference.toJSON(message.packageRepoRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ReconcilePackageRepositoryRequest>, I>>(
+    object: I,
+  ): ReconcilePackageRepositoryRequest {
+    const message = createBaseReconcilePackageRepositoryRequest();
+    message.packageRepoRef =
+      object.packageRepoRef !== undefined && object.packageRepoRef !== null
+        ? PackageRepositoryReference.fromPartial(object.packageRepoRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseReconcilePackageRepositoryResponse(): ReconcilePackageRepositoryResponse {
+  return { status: undefined, suspended: false };
+}
+
+export const ReconcilePackageRepositoryResponse = {
+  encode(
+    message: ReconcilePackageRepositoryResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.status !== undefined) {
+      PackageRepositoryStatus.encode(message.status, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.suspended === true) {
+      writer.uint32(16).bool(message.suspended);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ReconcilePackageRepositoryResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseReconcilePackageRepositoryResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.status = PackageRepositoryStatus.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.suspended = reader.bool();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ReconcilePackageRepositoryResponse {
+    return {
+      status: isSet(object.status) ? PackageRepositoryStatus.fromJSON(object.status) : undefined,
+      suspended: isSet(object.suspended) ? Boolean(object.suspended) : false,
+    };
+  },
+
+  toJSON(message: ReconcilePackageRepositoryResponse): unknown {
+    const obj: any = {};
+    message.status !== undefined &&
+      (obj.status = message.status ? PackageRepositoryStatus.toJSON(message.status) : undefined);
+    message.suspended !== undefined && (obj.suspended = message.suspended);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ReconcilePackageRepositoryResponse>, I>>(
+    object: I,
+  ): ReconcilePackageRepositoryResponse {
+    const message = createBaseReconcilePackageRepositoryResponse();
+    message.status =
+      object.status !== undefined && object.status !== null
+        ? PackageRepositoryStatus.fromPartial(object.status)
+        : undefined;
+    message.suspended = object.suspended ?? false;
+    return message;
+  },
+};
+
+function createBaseCreateKustomizationRequest(): CreateKustomizationRequest {
+  return {
+    targetContext: undefined,
+    name: "",
+    sourceRef: undefined,
+    path: "",
+    targetNamespace: "",
+    prune: false,
+    reconciliationOptions: undefined,
+  };
+}
+
+export const CreateKustomizationRequest = {
+  encode(
+    message: CreateKustomizationRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.targetContext !== undefined) {
+      Context.encode(message.targetContext, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.sourceRef !== undefined) {
+      KustomizationSourceReference.encode(message.sourceRef, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.path !== "") {
+      writer.uint32(34).string(message.path);
+    }
+    if (message.targetNamespace !== "") {
+      writer.uint32(42).string(message.targetNamespace);
+    }
+    if (message.prune === true) {
+      writer.uint32(48).bool(message.prune);
+    }
+    if (message.reconciliationOptions !== undefined) {
+      ReconciliationOptions.encode(
+        message.reconciliationOptions,
+        writer.uint32(58).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateKustomizationRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateKustomizationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.targetContext = Context.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.name = reader.string();
+          break;
+        case 3:
+          message.sourceRef = KustomizationSourceReference.decode(reader, reader.uint32());
+          break;
+        case 4:
+          message.path = reader.string();
+          break;
+        case 5:
+          message.targetNamespace = reader.string();
+          break;
+        case 6:
+          message.prune = reader.bool();
+          break;
+        case 7:
+          message.reconciliationOptions = ReconciliationOptions.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateKustomizationRequest {
+    return {
+      targetContext: isSet(object.targetContext)
+        ? Context.fromJSON(object.targetContext)
+        : undefined,
+      name: isSet(object.name) ? String(object.name) : "",
+      sourceRef: isSet(object.sourceRef)
+        ? KustomizationSourceReference.fromJSON(object.sourceRef)
+        : undefined,
+      path: isSet(object.path) ? String(object.path) : "",
+      targetNamespace: isSet(object.targetNamespace) ? String(object.targetNamespace) : "",
+      prune: isSet(object.prune) ? Boolean(object.prune) : false,
+      reconciliationOptions: isSet(object.reconciliationOptions)
+        ? ReconciliationOptions.fromJSON(object.reconciliationOptions)
+        : undefined,
+    };
+  },
+
+  toJSON(message: CreateKustomizationRequest): unknown {
+    const obj: any = {};
+    message.targetContext !== undefined &&
+      (obj.targetContext = message.targetContext
+        ? Context.toJSON(message.targetContext)
+        : undefined);
+    message.name !== undefined && (obj.name = message.name);
+    message.sourceRef !== undefined &&
+      (obj.sourceRef = message.sourceRef
+        ? KustomizationSourceReference.toJSON(message.sourceRef)
+        : undefined);
+    message.path !== undefined && (obj.path = message.path);
+    message.targetNamespace !== undefined && (obj.targetNamespace = message.targetNamespace);
+    message.prune !== undefined && (obj.prune = message.prune);
+    message.reconciliationOptions !== undefined &&
+      (obj.reconciliationOptions = message.reconciliationOptions
+        ? ReconciliationOptions.toJSON(message.reconciliationOptions)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CreateKustomizationRequest>, I>>(
+    object: I,
+  ): CreateKustomizationRequest {
+    const message = createBaseCreateKustomizationRequest();
+    message.targetContext =
+      object.targetContext !== undefined && object.targetContext !== null
+        ? Context.fromPartial(object.targetContext)
+        : undefined;
+    message.name = object.name ?? "";
+    message.sourceRef =
+      object.sourceRef !== undefined && object.sourceRef !== null
+        ? KustomizationSourceReference.fromPartial(object.sourceRef)
+        : undefined;
+    message.path = object.path ?? "";
+    message.targetNamespace = object.targetNamespace ?? "";
+    message.prune = object.prune ?? false;
+    message.reconciliationOptions =
+      object.reconciliationOptions !== undefined && object.reconciliationOptions !== null
+        ? ReconciliationOptions.fromPartial(object.reconciliationOptions)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseCreateKustomizationResponse(): CreateKustomizationResponse {
+  return { installedPackageRef: undefined };
+}
+
+export const CreateKustomizationResponse = {
+  encode(
+    message: CreateKustomizationResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateKustomizationResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateKustomizationResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateKustomizationResponse {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: CreateKustomizationResponse): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CreateKustomizationResponse>, I>>(
+    object: I,
+  ): CreateKustomizationResponse {
+    const message = createBaseCreateKustomizationResponse();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseKustomizationSourceReference(): KustomizationSourceReference {
+  return { kind: "", name: "", namespace: "" };
+}
+
+export const KustomizationSourceReference = {
+  encode(
+    message: KustomizationSourceReference,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.kind !== "") {
+      writer.uint32(10).string(message.kind);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.namespace !== "") {
+      writer.uint32(26).string(message.namespace);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): KustomizationSourceReference {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseKustomizationSourceReference();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.kind = reader.string();
+          break;
+        case 2:
+          message.name = reader.string();
+          break;
+        case 3:
+          message.namespace = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): KustomizationSourceReference {
+    return {
+      kind: isSet(object.kind) ? String(object.kind) : "",
+      name: isSet(object.name) ? String(object.name) : "",
+      namespace: isSet(object.namespace) ? String(object.namespace) : "",
+    };
+  },
+
+  toJSON(message: KustomizationSourceReference): unknown {
+    const obj: any = {};
+    message.kind !== undefined && (obj.kind = message.kind);
+    message.name !== undefined && (obj.name = message.name);
+    message.namespace !== undefined && (obj.namespace = message.namespace);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<KustomizationSourceReference>, I>>(
+    object: I,
+  ): KustomizationSourceReference {
+    const message = createBaseKustomizationSourceReference();
+    message.kind = object.kind ?? "";
+    message.name = object.name ?? "";
+    message.namespace = object.namespace ?? "";
+    return message;
+  },
+};
+
+function createBaseFluxKustomizationCustomDetail(): FluxKustomizationCustomDetail {
+  return {
+    sourceRef: undefined,
+    path: "",
+    targetNamespace: "",
+    prune: false,
+    lastAppliedRevision: "",
+    lastAttemptedRevision: "",
+  };
+}
+
+export const FluxKustomizationCustomDetail = {
+  encode(
+    message: FluxKustomizationCustomDetail,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.sourceRef !== undefined) {
+      KustomizationSourceReference.encode(message.sourceRef, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.path !== "") {
+      writer.uint32(18).string(message.path);
+    }
+    if (message.targetNamespace !== "") {
+      writer.uint32(26).string(message.targetNamespace);
+    }
+    if (message.prune === true) {
+      writer.uint32(32).bool(message.prune);
+    }
+    if (message.lastAppliedRevision !== "") {
+      writer.uint32(42).string(message.lastAppliedRevision);
+    }
+    if (message.lastAttemptedRevision !== "") {
+      writer.uint32(50).string(message.lastAttemptedRevision);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FluxKustomizationCustomDetail {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFluxKustomizationCustomDetail();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.sourceRef = KustomizationSourceReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.path = reader.string();
+          break;
+        case 3:
+          message.targetNamespace = reader.string();
+          break;
+        case 4:
+          message.prune = reader.bool();
+          break;
+        case 5:
+          message.lastAppliedRevision = reader.string();
+          break;
+        case 6:
+          message.lastAttemptedRevision = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FluxKustomizationCustomDetail {
+    return {
+      sourceRef: isSet(object.sourceRef)
+        ? KustomizationSourceReference.fromJSON(object.sourceRef)
+        : undefined,
+      path: isSet(object.path) ? String(object.path) : "",
+      targetNamespace: isSet(object.targetNamespace) ? String(object.targetNamespace) : "",
+      prune: isSet(object.prune) ? Boolean(object.prune) : false,
+      lastAppliedRevision: isSet(object.lastAppliedRevision)
+        ? String(object.lastAppliedRevision)
+        : "",
+      lastAttemptedRevision: isSet(object.lastAttemptedRevision)
+        ? String(object.lastAttemptedRevision)
+        : "",
+    };
+  },
+
+  toJSON(message: FluxKustomizationCustomDetail): unknown {
+    const obj: any = {};
+    message.sourceRef !== undefined &&
+      (obj.sourceRef = message.sourceRef
+        ? KustomizationSourceReference.toJSON(message.sourceRef)
+        : undefined);
+    message.path !== undefined && (obj.path = message.path);
+    message.targetNamespace !== undefined && (obj.targetNamespace = message.targetNamespace);
+    message.prune !== undefined && (obj.prune = message.prune);
+    message.lastAppliedRevision !== undefined &&
+      (obj.lastAppliedRevision = message.lastAppliedRevision);
+    message.lastAttemptedRevision !== undefined &&
+      (obj.lastAttemptedRevision = message.lastAttemptedRevision);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<FluxKustomizationCustomDetail>, I>>(
+    object: I,
+  ): FluxKustomizationCustomDetail {
+    const message = createBaseFluxKustomizationCustomDetail();
+    message.sourceRef =
+      object.sourceRef !== undefined && object.sourceRef !== null
+        ? KustomizationSourceReference.fromPartial(object.sourceRef)
+        : undefined;
+    message.path = object.path ?? "";
+    message.targetNamespace = object.targetNamespace ?? "";
+    message.prune = object.prune ?? false;
+    message.lastAppliedRevision = object.lastAppliedRevision ?? "";
+    message.lastAttemptedRevision = object.lastAttemptedRevision ?? "";
+    return message;
+  },
+};
+
+function createBaseFluxPackageInstallCustomDetail(): FluxPackageInstallCustomDetail {
+  return { valuesFrom: [] };
+}
+
+export const FluxPackageInstallCustomDetail = {
+  encode(
+    message: FluxPackageInstallCustomDetail,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.valuesFrom) {
+      ValuesReference.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): FluxPackageInstallCustomDetail {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFluxPackageInstallCustomDetail();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.valuesFrom.push(ValuesReference.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FluxPackageInstallCustomDetail {
+    return {
+      valuesFrom: Array.isArray(object?.valuesFrom)
+        ? object.valuesFrom.map((e: any) => ValuesReference.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: FluxPackageInstallCustomDetail): unknown {
+    const obj: any = {};
+    if (message.valuesFrom) {
+      obj.valuesFrom = message.valuesFrom.map(e => (e ? ValuesReference.toJSON(e) : undefined));
+    } else {
+      obj.valuesFrom = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<FluxPackageInstallCustomDetail>, I>>(
+    object: I,
+  ): FluxPackageInstallCustomDetail {
+    const message = createBaseFluxPackageInstallCustomDetail();
+    message.valuesFrom = object.valuesFrom?.map(e => ValuesReference.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseValuesReference(): ValuesReference {
+  return {
+    kind: "",
+    name: "",
+    valuesKey: "",
+    targetPath: "",
+    optional: false,
+    values: "",
+  };
+}
+
+export const ValuesReference = {
+  encode(message: ValuesReference, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.kind !== "") {
+      writer.uint32(10).string(message.kind);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.valuesKey !== "") {
+      writer.uint32(26).string(message.valuesKey);
+    }
+    if (message.targetPath !== "") {
+      writer.uint32(34).string(message.targetPath);
+    }
+    if (message.optional === true) {
+      writer.uint32(40).bool(message.optional);
+    }
+    if (message.values !== "") {
+      writer.uint32(50).string(message.values);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ValuesReference {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSetUserManagedSecretsResponse();
+    const message = createBaseValuesReference();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.value = reader.bool();
+          message.kind = reader.string();
+          break;
+        case 2:
+          message.name = reader.string();
+          break;
+        case 3:
+          message.valuesKey = reader.string();
+          break;
+        case 4:
+          message.targetPath = reader.string();
+          break;
+        case 5:
+          message.optional = reader.bool();
+          break;
+        case 6:
+          message.values = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -133,23 +1732,36 @@ export const SetUserManagedSecretsResponse = {
     return message;
   },
 
-  fromJSON(object: any): SetUserManagedSecretsResponse {
+  fromJSON(object: any): ValuesReference {
     return {
-      value: isSet(object.value) ? Boolean(object.value) : false,
+      kind: isSet(object.kind) ? String(object.kind) : "",
+      name: isSet(object.name) ? String(object.name) : "",
+      valuesKey: isSet(object.valuesKey) ? String(object.valuesKey) : "",
+      targetPath: isSet(object.targetPath) ? String(object.targetPath) : "",
+      optional: isSet(object.optional) ? Boolean(object.optional) : false,
+      values: isSet(object.values) ? String(object.values) : "",
     };
   },
 
-  toJSON(message: SetUserManagedSecretsResponse): unknown {
+  toJSON(message: ValuesReference): unknown {
     const obj: any = {};
-    message.value !== undefined && (obj.value = message.value);
+    message.kind !== undefined && (obj.kind = message.kind);
+    message.name !== undefined && (obj.name = message.name);
+    message.valuesKey !== undefined && (obj.valuesKey = message.valuesKey);
+    message.targetPath !== undefined && (obj.targetPath = message.targetPath);
+    message.optional !== undefined && (obj.optional = message.optional);
+    message.values !== undefined && (obj.values = message.values);
     return obj;
   },
 
-  fromPartial<I extends Exact<DeepPartial<SetUserManagedSecretsResponse>, I>>(
-    object: I,
-  ): SetUserManagedSecretsResponse {
-    const message = createBaseSetUserManagedSecretsResponse();
-    message.value = object.value ?? false;
+  fromPartial<I extends Exact<DeepPartial<ValuesReference>, I>>(object: I): ValuesReference {
+    const message = createBaseValuesReference();
+    message.kind = object.kind ?? "";
+    message.name = object.name ?? "";
+    message.valuesKey = object.valuesKey ?? "";
+    message.targetPath = object.targetPath ?? "";
+    message.optional = object.optional ?? false;
+    message.values = object.values ?? "";
     return message;
   },
 };
@@ -203,6 +1815,45 @@ export interface FluxV2PackagesService {
     request: DeepPartial<GetInstalledPackageResourceRefsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageResourceRefsResponse>;
+  /** GetInstalledPackageRevisions returns the release history of an installed package. */
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse>;
+  /** RollbackInstalledPackage rolls back an installed package to a previous revision. */
+  RollbackInstalledPackage(
+    request: DeepPartial<RollbackInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RollbackInstalledPackageResponse>;
+  /**
+   * SuspendInstalledPackage suspends the reconciliation of an installed package,
+   * i.e. a HelmRelease, until it is resumed.
+   */
+  SuspendInstalledPackage(
+    request: DeepPartial<SuspendInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<SuspendInstalledPackageResponse>;
+  /** ResumeInstalledPackage resumes the reconciliation of a suspended installed package. */
+  ResumeInstalledPackage(
+    request: DeepPartial<ResumeInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ResumeInstalledPackageResponse>;
+  /**
+   * ReconcileInstalledPackage requests the reconciliation of an installed package
+   * now, rather than at the next reconciliation interval.
+   */
+  ReconcileInstalledPackage(
+    request: DeepPartial<ReconcileInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ReconcileInstalledPackageResponse>;
+  /**
+   * CreateKustomization creates an installed package for the manifests at a path
+   * of a GitRepository or OCIRepository, i.e. a flux Kustomization.
+   */
+  CreateKustomization(
+    request: DeepPartial<CreateKustomizationRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CreateKustomizationResponse>;
 }
 
 export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
@@ -219,6 +1870,12 @@ export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
     this.GetInstalledPackageResourceRefs = this.GetInstalledPackageResourceRefs.bind(this);
+    this.GetInstalledPackageRevisions = this.GetInstalledPackageRevisions.bind(this);
+    this.RollbackInstalledPackage = this.RollbackInstalledPackage.bind(this);
+    this.SuspendInstalledPackage = this.SuspendInstalledPackage.bind(this);
+    this.ResumeInstalledPackage = this.ResumeInstalledPackage.bind(this);
+    this.ReconcileInstalledPackage = this.ReconcileInstalledPackage.bind(this);
+    this.CreateKustomization = this.CreateKustomization.bind(this);
   }
 
   GetAvailablePackageSummaries(
@@ -319,6 +1976,72 @@ export class FluxV2PackagesServiceClientImpl implements FluxV2PackagesService {
       metadata,
     );
   }
+
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceGetInstalledPackageRevisionsDesc,
+      GetInstalledPackageRevisionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  RollbackInstalledPackage(
+    request: DeepPartial<RollbackInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<RollbackInstalledPackageResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceRollbackInstalledPackageDesc,
+      RollbackInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  SuspendInstalledPackage(
+    request: DeepPartial<SuspendInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<SuspendInstalledPackageResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceSuspendInstalledPackageDesc,
+      SuspendInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ResumeInstalledPackage(
+    request: DeepPartial<ResumeInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ResumeInstalledPackageResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceResumeInstalledPackageDesc,
+      ResumeInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ReconcileInstalledPackage(
+    request: DeepPartial<ReconcileInstalledPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ReconcileInstalledPackageResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceReconcileInstalledPackageDesc,
+      ReconcileInstalledPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  CreateKustomization(
+    request: DeepPartial<CreateKustomizationRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CreateKustomizationResponse> {
+    return this.rpc.unary(
+      FluxV2PackagesServiceCreateKustomizationDesc,
+      CreateKustomizationRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const FluxV2PackagesServiceDesc = {
@@ -523,6 +2246,138 @@ export const FluxV2PackagesServiceGetInstalledPackageResourceRefsDesc: UnaryMeth
   } as any,
 };
 
+export const FluxV2PackagesServiceGetInstalledPackageRevisionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageRevisions",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageRevisionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageRevisionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceRollbackInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "RollbackInstalledPackage",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return RollbackInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...RollbackInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceSuspendInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "SuspendInstalledPackage",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return SuspendInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...SuspendInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceResumeInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "ResumeInstalledPackage",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ResumeInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ResumeInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceReconcileInstalledPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "ReconcileInstalledPackage",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ReconcileInstalledPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ReconcileInstalledPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2PackagesServiceCreateKustomizationDesc: UnaryMethodDefinitionish = {
+  methodName: "CreateKustomization",
+  service: FluxV2PackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return CreateKustomizationRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...CreateKustomizationResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export interface FluxV2RepositoriesService {
   /**
    * AddPackageRepository add an existing package repository to the set of ones already managed by the
@@ -548,6 +2403,27 @@ export interface FluxV2RepositoriesService {
     request: DeepPartial<DeletePackageRepositoryRequest>,
     metadata?: grpc.Metadata,
   ): Promise<DeletePackageRepositoryResponse>;
+  /**
+   * SuspendPackageRepository suspends the reconciliation of a package repository,
+   * i.e. a HelmRepository, until it is resumed.
+   */
+  SuspendPackageRepository(
+    request: DeepPartial<SuspendPackageRepositoryRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<SuspendPackageRepositoryResponse>;
+  /** ResumePackageRepository resumes the reconciliation of a suspended package repository. */
+  ResumePackageRepository(
+    request: DeepPartial<ResumePackageRepositoryRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ResumePackageRepositoryResponse>;
+  /**
+   * ReconcilePackageRepository requests the reconciliation of a package repository
+   * now, rather than at the next reconciliation interval.
+   */
+  ReconcilePackageRepository(
+    request: DeepPartial<ReconcilePackageRepositoryRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ReconcilePackageRepositoryResponse>;
   /** this endpoint only exists for the purpose of integration tests */
   SetUserManagedSecrets(
     request: DeepPartial<SetUserManagedSecretsRequest>,
@@ -565,6 +2441,9 @@ export class FluxV2RepositoriesServiceClientImpl implements FluxV2RepositoriesSe
     this.GetPackageRepositorySummaries = this.GetPackageRepositorySummaries.bind(this);
     this.UpdatePackageRepository = this.UpdatePackageRepository.bind(this);
     this.DeletePackageRepository = this.DeletePackageRepository.bind(this);
+    this.SuspendPackageRepository = this.SuspendPackageRepository.bind(this);
+    this.ResumePackageRepository = this.ResumePackageRepository.bind(this);
+    this.ReconcilePackageRepository = this.ReconcilePackageRepository.bind(this);
     this.SetUserManagedSecrets = this.SetUserManagedSecrets.bind(this);
   }
 
@@ -623,6 +2502,39 @@ export class FluxV2RepositoriesServiceClientImpl implements FluxV2RepositoriesSe
     );
   }
 
+  SuspendPackageRepository(
+    request: DeepPartial<SuspendPackageRepositoryRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<SuspendPackageRepositoryResponse> {
+    return this.rpc.unary(
+      FluxV2RepositoriesServiceSuspendPackageRepositoryDesc,
+      SuspendPackageRepositoryRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ResumePackageRepository(
+    request: DeepPartial<ResumePackageRepositoryRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ResumePackageRepositoryResponse> {
+    return this.rpc.unary(
+      FluxV2RepositoriesServiceResumePackageRepositoryDesc,
+      ResumePackageRepositoryRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ReconcilePackageRepository(
+    request: DeepPartial<ReconcilePackageRepositoryRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ReconcilePackageRepositoryResponse> {
+    return this.rpc.unary(
+      FluxV2RepositoriesServiceReconcilePackageRepositoryDesc,
+      ReconcilePackageRepositoryRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   SetUserManagedSecrets(
     request: DeepPartial<SetUserManagedSecretsRequest>,
     metadata?: grpc.Metadata,
@@ -750,6 +2662,72 @@ export const FluxV2RepositoriesServiceDeletePackageRepositoryDesc: UnaryMethodDe
   } as any,
 };
 
+export const FluxV2RepositoriesServiceSuspendPackageRepositoryDesc: UnaryMethodDefinitionish = {
+  methodName: "SuspendPackageRepository",
+  service: FluxV2RepositoriesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return SuspendPackageRepositoryRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...SuspendPackageRepositoryResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2RepositoriesServiceResumePackageRepositoryDesc: UnaryMethodDefinitionish = {
+  methodName: "ResumePackageRepository",
+  service: FluxV2RepositoriesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ResumePackageRepositoryRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ResumePackageRepositoryResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
+export const FluxV2RepositoriesServiceReconcilePackageRepositoryDesc: UnaryMethodDefinitionish = {
+  methodName: "ReconcilePackageRepository",
+  service: FluxV2RepositoriesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ReconcilePackageRepositoryRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ReconcilePackageRepositoryResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const FluxV2RepositoriesServiceSetUserManagedSecretsDesc: UnaryMethodDefinitionish = {
   methodName: "SetUserManagedSecrets",
   service: FluxV2RepositoriesServiceDesc,
@@ -12,6 +12,8 @@ import {
   CreateInstalledPackageRequest,
   UpdateInstalledPackageRequest,
   DeleteInstalledPackageRequest,
+  GetInstalledPackageRevisionsRequest,
+  RollbackInstalledPackageRequest as RollbackInstalledPackageRequest1,
   GetInstalledPackageResourceRefsRequest,
   GetAvailablePackageSummariesResponse,
   GetAvailablePackageDetailResponse,
@@ -21,6 +23,8 @@ import {
   CreateInstalledPackageResponse,
   UpdateInstalledPackageResponse,
   DeleteInstalledPackageResponse,
+  GetInstalledPackageRevisionsResponse,
+  RollbackInstalledPackageResponse as RollbackInstalledPackageResponse1,
   GetInstalledPackageResourceRefsResponse,
 } from "../../../../../kubeappsapis/core/packages/v1alpha1/packages";
 import {
@@ -54,6 +58,14 @@ export interface InstalledPackageDetailCustomDataHelm {
   releaseRevision: number;
 }
 
+/**
+ * RollbackInstalledPackageRequest
+ *
+ * Deprecated: use kubeappsapis.core.packages.v1alpha1.RollbackInstalledPackageRequest,
+ * which HelmPackagesService.RollbackInstalledPackage now accepts.
+ *
+ * @deprecated
+ */
 export interface RollbackInstalledPackageRequest {
   /**
    * Installed package reference
@@ -72,7 +84,10 @@ export interface RollbackInstalledPackageRequest {
 /**
  * RollbackInstalledPackageResponse
  *
- * Response for RollbackInstalledPackage
+ * Deprecated: use kubeappsapis.core.packages.v1alpha1.RollbackInstalledPackageResponse,
+ * which HelmPackagesService.RollbackInstalledPackage now returns.
+ *
+ * @deprecated
  */
 export interface RollbackInstalledPackageResponse {
   /**
@@ -718,11 +733,16 @@ export interface HelmPackagesService {
     request: DeepPartial<DeleteInstalledPackageRequest>,
     metadata?: grpc.Metadata,
   ): Promise<DeleteInstalledPackageResponse>;
+  /** GetInstalledPackageRevisions returns the release history of an installed package. */
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse>;
   /** RollbackInstalledPackage updates an installed package based on the request. */
   RollbackInstalledPackage(
-    request: DeepPartial<RollbackInstalledPackageRequest>,
+    request: DeepPartial<RollbackInstalledPackageRequest1>,
     metadata?: grpc.Metadata,
-  ): Promise<RollbackInstalledPackageResponse>;
+  ): Promise<RollbackInstalledPackageResponse1>;
   /**
    * GetInstalledPackageResourceRefs returns the references for the Kubernetes resources created by
    * an installed package.
@@ -746,6 +766,7 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
     this.CreateInstalledPackage = this.CreateInstalledPackage.bind(this);
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
+    this.GetInstalledPackageRevisions = this.GetInstalledPackageRevisions.bind(this);
     this.RollbackInstalledPackage = this.RollbackInstalledPackage.bind(this);
     this.GetInstalledPackageResourceRefs = this.GetInstalledPackageResourceRefs.bind(this);
   }
@@ -838,13 +859,24 @@ export class HelmPackagesServiceClientImpl implements HelmPackagesService {
     );
   }
 
+  GetInstalledPackageRevisions(
+    request: DeepPartial<GetInstalledPackageRevisionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<GetInstalledPackageRevisionsResponse> {
+    return this.rpc.unary(
+      HelmPackagesServiceGetInstalledPackageRevisionsDesc,
+      GetInstalledPackageRevisionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   RollbackInstalledPackage(
-    request: DeepPartial<RollbackInstalledPackageRequest>,
+    request: DeepPartial<RollbackInstalledPackageRequest1>,
     metadata?: grpc.Metadata,
-  ): Promise<RollbackInstalledPackageResponse> {
+  ): Promise<RollbackInstalledPackageResponse1> {
     return this.rpc.unary(
       HelmPackagesServiceRollbackInstalledPackageDesc,
-      RollbackInstalledPackageRequest.fromPartial(request),
+      RollbackInstalledPackageRequest1.fromPartial(request),
       metadata,
     );
   }
@@ -1041,6 +1073,28 @@ export const HelmPackagesServiceDeleteInstalledPackageDesc: UnaryMethodDefinitio
   } as any,
 };
 
+export const HelmPackagesServiceGetInstalledPackageRevisionsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageRevisions",
+  service: HelmPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageRevisionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageRevisionsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const HelmPackagesServiceRollbackInstalledPackageDesc: UnaryMethodDefinitionish = {
   methodName: "RollbackInstalledPackage",
   service: HelmPackagesServiceDesc,
@@ -1048,13 +1102,13 @@ export const HelmPackagesServiceRollbackInstalledPackageDesc: UnaryMethodDefinit
   responseStream: false,
   requestType: {
     serializeBinary() {
-      return RollbackInstalledPackageRequest.encode(this).finish();
+      return RollbackInstalledPackageRequest1.encode(this).finish();
     },
   } as any,
   responseType: {
     deserializeBinary(data: Uint8Array) {
       return {
-        ...RollbackInstalledPackageResponse.decode(data),
+        ...RollbackInstalledPackageResponse1.decode(data),
         toObject() {
           return this;
         },
@@ -3,6 +3,8 @@ import Long from "long";
 import { grpc } from "@improbable-eng/grpc-web";
 import * as _m0 from "protobufjs/minimal";
 import {
+  AvailablePackageReference,
+  Context,
   GetAvailablePackageSummariesRequest,
   GetAvailablePackageDetailRequest,
   GetAvailablePackageVersionsRequest,
@@ -109,6 +111,60 @@ export interface VersionSelectionSemverPrereleases {
   identifiers: string[];
 }
 
+/**
+ * custom fields to support the PackageInstall features not covered by the core
+ * InstalledPackageDetail, such as the additional values and the ytt overlays.
+ */
+export interface InstalledPackageCustomDetail {
+  /**
+   * Ordered list of additional secrets providing values to the package. They are
+   * applied after the values managed by Kubeapps, so they take precedence.
+   */
+  valuesSecrets: PackageInstallValuesSecretRef[];
+  /**
+   * Ordered list of the names of the secrets containing ytt overlays applied to
+   * the package templates (ext.packaging.carvel.dev/ytt-paths-from-secret-name).
+   */
+  overlaySecrets: string[];
+}
+
+export interface PackageInstallValuesSecretRef {
+  name: string;
+  key: string;
+}
+
+/**
+ * ConvertHelmPackageRequest
+ *
+ * Request for ConvertHelmPackage
+ */
+export interface ConvertHelmPackageRequest {
+  /** The reference of the Helm available package to be converted */
+  availablePackageRef?: AvailablePackageReference;
+  /** Optional specific version of the Helm chart, using the latest one if empty */
+  pkgVersion: string;
+  /**
+   * Optional context in which the PackageMetadata and Package are created.
+   * If no namespace is provided, the resources are just returned as YAML.
+   */
+  targetContext?: Context;
+}
+
+/**
+ * ConvertHelmPackageResponse
+ *
+ * Response for ConvertHelmPackage
+ */
+export interface ConvertHelmPackageResponse {
+  /** YAML manifest with the generated PackageMetadata and Package */
+  manifest: string;
+  /**
+   * The reference of the resulting kapp_controller available package,
+   * only set when the resources have been created in the target context
+   */
+  availablePackageRef?: AvailablePackageReference;
+}
+
 function createBasePackageRepositoryCustomDetail(): PackageRepositoryCustomDetail {
   return { fetch: undefined };
 }
@@ -1049,6 +1105,313 @@ export const VersionSelectionSemverPrereleases = {
   },
 };
 
+function createBaseInstalledPackageCustomDetail(): InstalledPackageCustomDetail {
+  return { valuesSecrets: [], overlaySecrets: [] };
+}
+
+export const InstalledPackageCustomDetail = {
+  encode(
+    message: InstalledPackageCustomDetail,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.valuesSecrets) {
+      PackageInstallValuesSecretRef.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.overlaySecrets) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): InstalledPackageCustomDetail {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseInstalledPackageCustomDetail();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.valuesSecrets.push(PackageInstallValuesSecretRef.decode(reader, reader.uint32()));
+          break;
+        case 2:
+          message.overlaySecrets.push(reader.string());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): InstalledPackageCustomDetail {
+    return {
+      valuesSecrets: Array.isArray(object?.valuesSecrets)
+        ? object.valuesSecrets.map((e: any) => PackageInstallValuesSecretRef.fromJSON(e))
+        : [],
+      overlaySecrets: Array.isArray(object?.overlaySecrets)
+        ? object.overlaySecrets.map((e: any) => String(e))
+        : [],
+    };
+  },
+
+  toJSON(message: InstalledPackageCustomDetail): unknown {
+    const obj: any = {};
+    if (message.valuesSecrets) {
+      obj.valuesSecrets = message.valuesSecrets.map(e =>
+        e ? PackageInstallValuesSecretRef.toJSON(e) : undefined,
+      );
+    } else {
+      obj.valuesSecrets = [];
+    }
+    if (message.overlaySecrets) {
+      obj.overlaySecrets = message.overlaySecrets.map(e => e);
+    } else {
+      obj.overlaySecrets = [];
+    }
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<InstalledPackageCustomDetail>, I>>(
+    object: I,
+  ): InstalledPackageCustomDetail {
+    const message = createBaseInstalledPackageCustomDetail();
+    message.valuesSecrets =
+      object.valuesSecrets?.map(e => PackageInstallValuesSecretRef.fromPartial(e)) || [];
+    message.overlaySecrets = object.overlaySecrets?.map(e => e) || [];
+    return message;
+  },
+};
+
+function createBasePackageInstallValuesSecretRef(): PackageInstallValuesSecretRef {
+  return { name: "", key: "" };
+}
+
+export const PackageInstallValuesSecretRef = {
+  encode(
+    message: PackageInstallValuesSecretRef,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.key !== "") {
+      writer.uint32(18).string(message.key);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PackageInstallValuesSecretRef {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePackageInstallValuesSecretRef();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.name = reader.string();
+          break;
+        case 2:
+          message.key = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PackageInstallValuesSecretRef {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      key: isSet(object.key) ? String(object.key) : "",
+    };
+  },
+
+  toJSON(message: PackageInstallValuesSecretRef): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.key !== undefined && (obj.key = message.key);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PackageInstallValuesSecretRef>, I>>(
+    object: I,
+  ): PackageInstallValuesSecretRef {
+    const message = createBasePackageInstallValuesSecretRef();
+    message.name = object.name ?? "";
+    message.key = object.key ?? "";
+    return message;
+  },
+};
+
+function createBaseConvertHelmPackageRequest(): ConvertHelmPackageRequest {
+  return {
+    availablePackageRef: undefined,
+    pkgVersion: "",
+    targetContext: undefined,
+  };
+}
+
+export const ConvertHelmPackageRequest = {
+  encode(message: ConvertHelmPackageRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.pkgVersion !== "") {
+      writer.uint32(18).string(message.pkgVersion);
+    }
+    if (message.targetContext !== undefined) {
+      Context.encode(message.targetContext, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ConvertHelmPackageRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConvertHelmPackageRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.pkgVersion = reader.string();
+          break;
+        case 3:
+          message.targetContext = Context.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConvertHelmPackageRequest {
+    return {
+      availablePackageRef: isSet(object.availablePackageRef)
+        ? AvailablePackageReference.fromJSON(object.availablePackageRef)
+        : undefined,
+      pkgVersion: isSet(object.pkgVersion) ? String(object.pkgVersion) : "",
+      targetContext: isSet(object.targetContext)
+        ? Context.fromJSON(object.targetContext)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ConvertHelmPackageRequest): unknown {
+    const obj: any = {};
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    message.pkgVersion !== undefined && (obj.pkgVersion = message.pkgVersion);
+    message.targetContext !== undefined &&
+      (obj.targetContext = message.targetContext
+        ? Context.toJSON(message.targetContext)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ConvertHelmPackageRequest>, I>>(
+    object: I,
+  ): ConvertHelmPackageRequest {
+    const message = createBaseConvertHelmPackageRequest();
+    message.availablePackageRef =
+      object.availablePackageRef !== undefined && object.availablePackageRef !== null
+        ? AvailablePackageReference.fromPartial(object.availablePackageRef)
+        : undefined;
+    message.pkgVersion = object.pkgVersion ?? "";
+    message.targetContext =
+      object.targetContext !== undefined && object.targetContext !== null
+        ? Context.fromPartial(object.targetContext)
+        : undefined;
+    return message;
+  },
+};
+
+function createBaseConvertHelmPackageResponse(): ConvertHelmPackageResponse {
+  return { manifest: "", availablePackageRef: undefined };
+}
+
+export const ConvertHelmPackageResponse = {
+  encode(
+    message: ConvertHelmPackageResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.manifest !== "") {
+      writer.uint32(10).string(message.manifest);
+    }
+    if (message.availablePackageRef !== undefined) {
+      AvailablePackageReference.encode(
+        message.availablePackageRef,
+        writer.uint32(18).fork(),
+      ).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ConvertHelmPackageResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConvertHelmPackageResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.manifest = reader.string();
+          break;
+        case 2:
+          message.availablePackageRef = AvailablePackageReference.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConvertHelmPackageResponse {
+    return {
+      manifest: isSet(object.manifest) ? String(object.manifest) : "",
+      availablePackageRef: isSet(object.availablePackageRef)
+        ? AvailablePackageReference.fromJSON(object.availablePackageRef)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ConvertHelmPackageResponse): unknown {
+    const obj: any = {};
+    message.manifest !== undefined && (obj.manifest = message.manifest);
+    message.availablePackageRef !== undefined &&
+      (obj.availablePackageRef = message.availablePackageRef
+        ? AvailablePackageReference.toJSON(message.availablePackageRef)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ConvertHelmPackageResponse>, I>>(
+    object: I,
+  ): ConvertHelmPackageResponse {
+    const message = createBaseConvertHelmPackageResponse();
+    message.manifest = object.manifest ?? "";
+    message.availablePackageRef =
+      object.availablePackageRef !== undefined && object.availablePackageRef !== null
+        ? AvailablePackageReference.fromPartial(object.availablePackageRef)
+        : undefined;
+    return message;
+  },
+};
+
 export interface KappControllerPackagesService {
   /** GetAvailablePackageSummaries returns the available packages managed by the 'kapp_controller' plugin */
   GetAvailablePackageSummaries(
@@ -1098,6 +1461,14 @@ export interface KappControllerPackagesService {
     request: DeepPartial<GetInstalledPackageResourceRefsRequest>,
     metadata?: grpc.Metadata,
   ): Promise<GetInstalledPackageResourceRefsResponse>;
+  /**
+   * ConvertHelmPackage generates the Carvel PackageMetadata and Package for a Helm chart,
+   * optionally creating them in the requested target context.
+   */
+  ConvertHelmPackage(
+    request: DeepPartial<ConvertHelmPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ConvertHelmPackageResponse>;
 }
 
 export class KappControllerPackagesServiceClientImpl implements KappControllerPackagesService {
@@ -1114,6 +1485,7 @@ export class KappControllerPackagesServiceClientImpl implements KappControllerPa
     this.UpdateInstalledPackage = this.UpdateInstalledPackage.bind(this);
     this.DeleteInstalledPackage = this.DeleteInstalledPackage.bind(this);
     this.GetInstalledPackageResourceRefs = this.GetInstalledPackageResourceRefs.bind(this);
+    this.ConvertHelmPackage = this.ConvertHelmPackage.bind(this);
   }
 
   GetAvailablePackageSummaries(
@@ -1214,6 +1586,17 @@ export class KappControllerPackagesServiceClientImpl implements KappControllerPa
       metadata,
     );
   }
+
+  ConvertHelmPackage(
+    request: DeepPartial<ConvertHelmPackageRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ConvertHelmPackageResponse> {
+    return this.rpc.unary(
+      KappControllerPackagesServiceConvertHelmPackageDesc,
+      ConvertHelmPackageRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const KappControllerPackagesServiceDesc = {
@@ -1425,6 +1808,28 @@ export const KappControllerPackagesServiceGetInstalledPackageResourceRefsDesc: U
     } as any,
   };
 
+export const KappControllerPackagesServiceConvertHelmPackageDesc: UnaryMethodDefinitionish = {
+  methodName: "ConvertHelmPackage",
+  service: KappControllerPackagesServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ConvertHelmPackageRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...ConvertHelmPackageResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export interface KappControllerRepositoriesService {
   /** AddPackageRepository add an existing package repository to the set of ones already managed by the 'kapp_controller' plugin */
   AddPackageRepository(
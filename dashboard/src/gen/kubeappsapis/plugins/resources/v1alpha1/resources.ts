@@ -7,6 +7,7 @@ import {
   ResourceRef,
   Context,
 } from "../../../../kubeappsapis/core/packages/v1alpha1/packages";
+import { Timestamp } from "../../../../google/protobuf/timestamp";
 import { Observable } from "rxjs";
 import { BrowserHeaders } from "browser-headers";
 import { share } from "rxjs/operators";
@@ -140,6 +141,76 @@ export interface GetResourcesResponse {
   manifest: string;
 }
 
+/**
+ * GetInstalledPackageLogsRequest
+ *
+ * Request for GetInstalledPackageLogs that specifies the installed package and
+ * the options for the container logs being streamed.
+ */
+export interface GetInstalledPackageLogsRequest {
+  /**
+   * InstalledPackageRef
+   *
+   * The installed package reference for which the logs of the pods are being
+   * streamed.
+   */
+  installedPackageRef?: InstalledPackageReference;
+  /**
+   * Container
+   *
+   * Optional name of the container for which the logs are streamed. If empty,
+   * the logs of every container of the pods are streamed.
+   */
+  container: string;
+  /**
+   * Follow
+   *
+   * When true, this will cause the stream to remain open with new log lines
+   * being sent as they are written by the containers.
+   */
+  follow: boolean;
+  /**
+   * SinceTime
+   *
+   * Optional time from which the logs are streamed. If not set, all the
+   * available logs are streamed.
+   */
+  sinceTime?: Date;
+  /**
+   * TailLines
+   *
+   * Optional number of lines from the end of the logs of each container to
+   * stream. If zero, all the available logs are streamed.
+   */
+  tailLines: number;
+}
+
+/**
+ * GetInstalledPackageLogsResponse
+ *
+ * A single log line of a container, along with the pod and container it belongs to.
+ */
+export interface GetInstalledPackageLogsResponse {
+  /**
+   * PodRef
+   *
+   * The reference to the pod which wrote the log line.
+   */
+  podRef?: ResourceRef;
+  /**
+   * Container
+   *
+   * The name of the container which wrote the log line.
+   */
+  container: string;
+  /**
+   * Line
+   *
+   * The log line, without the trailing new line.
+   */
+  line: string;
+}
+
 /**
  * GetServiceAccountNamesRequest
  *
@@ -471,6 +542,189 @@ export const GetResourcesResponse = {
   },
 };
 
+function createBaseGetInstalledPackageLogsRequest(): GetInstalledPackageLogsRequest {
+  return {
+    installedPackageRef: undefined,
+    container: "",
+    follow: false,
+    sinceTime: undefined,
+    tailLines: 0,
+  };
+}
+
+export const GetInstalledPackageLogsRequest = {
+  encode(
+    message: GetInstalledPackageLogsRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.installedPackageRef !== undefined) {
+      InstalledPackageReference.encode(
+        message.installedPackageRef,
+        writer.uint32(10).fork(),
+      ).ldelim();
+    }
+    if (message.container !== "") {
+      writer.uint32(18).string(message.container);
+    }
+    if (message.follow === true) {
+      writer.uint32(24).bool(message.follow);
+    }
+    if (message.sinceTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.sinceTime), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.tailLines !== 0) {
+      writer.uint32(40).int64(message.tailLines);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageLogsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetInstalledPackageLogsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.installedPackageRef = InstalledPackageReference.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.container = reader.string();
+          break;
+        case 3:
+          message.follow = reader.bool();
+          break;
+        case 4:
+          message.sinceTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          break;
+        case 5:
+          message.tailLines = longToNumber(reader.int64() as Long);
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageLogsRequest {
+    return {
+      installedPackageRef: isSet(object.installedPackageRef)
+        ? InstalledPackageReference.fromJSON(object.installedPackageRef)
+        : undefined,
+      container: isSet(object.container) ? String(object.container) : "",
+      follow: isSet(object.follow) ? Boolean(object.follow) : false,
+      sinceTime: isSet(object.sinceTime) ? fromJsonTimestamp(object.sinceTime) : undefined,
+      tailLines: isSet(object.tailLines) ? Number(object.tailLines) : 0,
+    };
+  },
+
+  toJSON(message: GetInstalledPackageLogsRequest): unknown {
+    const obj: any = {};
+    message.installedPackageRef !== undefined &&
+      (obj.installedPackageRef = message.installedPackageRef
+        ? InstalledPackageReference.toJSON(message.installedPackageRef)
+        : undefined);
+    message.container !== undefined && (obj.container = message.container);
+    message.follow !== undefined && (obj.follow = message.follow);
+    message.sinceTime !== undefined && (obj.sinceTime = message.sinceTime.toISOString());
+    message.tailLines !== undefined && (obj.tailLines = Math.round(message.tailLines));
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageLogsRequest>, I>>(
+    object: I,
+  ): GetInstalledPackageLogsRequest {
+    const message = createBaseGetInstalledPackageLogsRequest();
+    message.installedPackageRef =
+      object.installedPackageRef !== undefined && object.installedPackageRef !== null
+        ? InstalledPackageReference.fromPartial(object.installedPackageRef)
+        : undefined;
+    message.container = object.container ?? "";
+    message.follow = object.follow ?? false;
+    message.sinceTime = object.sinceTime ?? undefined;
+    message.tailLines = object.tailLines ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetInstalledPackageLogsResponse(): GetInstalledPackageLogsResponse {
+  return { podRef: undefined, container: "", line: "" };
+}
+
+export const GetInstalledPackageLogsResponse = {
+  encode(
+    message: GetInstalledPackageLogsResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.podRef !== undefined) {
+      ResourceRef.encode(message.podRef, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.container !== "") {
+      writer.uint32(18).string(message.container);
+    }
+    if (message.line !== "") {
+      writer.uint32(26).string(message.line);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetInstalledPackageLogsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetInstalledPackageLogsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.podRef = ResourceRef.decode(reader, reader.uint32());
+          break;
+        case 2:
+          message.container = reader.string();
+          break;
+        case 3:
+          message.line = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetInstalledPackageLogsResponse {
+    return {
+      podRef: isSet(object.podRef) ? ResourceRef.fromJSON(object.podRef) : undefined,
+      container: isSet(object.container) ? String(object.container) : "",
+      line: isSet(object.line) ? String(object.line) : "",
+    };
+  },
+
+  toJSON(message: GetInstalledPackageLogsResponse): unknown {
+    const obj: any = {};
+    message.podRef !== undefined &&
+      (obj.podRef = message.podRef ? ResourceRef.toJSON(message.podRef) : undefined);
+    message.container !== undefined && (obj.container = message.container);
+    message.line !== undefined && (obj.line = message.line);
+    return obj;
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GetInstalledPackageLogsResponse>, I>>(
+    object: I,
+  ): GetInstalledPackageLogsResponse {
+    const message = createBaseGetInstalledPackageLogsResponse();
+    message.podRef =
+      object.podRef !== undefined && object.podRef !== null
+        ? ResourceRef.fromPartial(object.podRef)
+        : undefined;
+    message.container = object.container ?? "";
+    message.line = object.line ?? "";
+    return message;
+  },
+};
+
 function createBaseGetServiceAccountNamesRequest(): GetServiceAccountNamesRequest {
   return { context: undefined };
 }
@@ -1320,6 +1574,10 @@ export interface ResourcesService {
     request: DeepPartial<GetResourcesRequest>,
     metadata?: grpc.Metadata,
   ): Observable<GetResourcesResponse>;
+  GetInstalledPackageLogs(
+    request: DeepPartial<GetInstalledPackageLogsRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<GetInstalledPackageLogsResponse>;
   GetServiceAccountNames(
     request: DeepPartial<GetServiceAccountNamesRequest>,
     metadata?: grpc.Metadata,
@@ -1352,6 +1610,7 @@ export class ResourcesServiceClientImpl implements ResourcesService {
   constructor(rpc: Rpc) {
     this.rpc = rpc;
     this.GetResources = this.GetResources.bind(this);
+    this.GetInstalledPackageLogs = this.GetInstalledPackageLogs.bind(this);
     this.GetServiceAccountNames = this.GetServiceAccountNames.bind(this);
     this.GetNamespaceNames = this.GetNamespaceNames.bind(this);
     this.CreateNamespace = this.CreateNamespace.bind(this);
@@ -1371,6 +1630,17 @@ export class ResourcesServiceClientImpl implements ResourcesService {
     );
   }
 
+  GetInstalledPackageLogs(
+    request: DeepPartial<GetInstalledPackageLogsRequest>,
+    metadata?: grpc.Metadata,
+  ): Observable<GetInstalledPackageLogsResponse> {
+    return this.rpc.invoke(
+      ResourcesServiceGetInstalledPackageLogsDesc,
+      GetInstalledPackageLogsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   GetServiceAccountNames(
     request: DeepPartial<GetServiceAccountNamesRequest>,
     metadata?: grpc.Metadata,
@@ -1464,6 +1734,28 @@ export const ResourcesServiceGetResourcesDesc: UnaryMethodDefinitionish = {
   } as any,
 };
 
+export const ResourcesServiceGetInstalledPackageLogsDesc: UnaryMethodDefinitionish = {
+  methodName: "GetInstalledPackageLogs",
+  service: ResourcesServiceDesc,
+  requestStream: false,
+  responseStream: true,
+  requestType: {
+    serializeBinary() {
+      return GetInstalledPackageLogsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      return {
+        ...GetInstalledPackageLogsResponse.decode(data),
+        toObject() {
+          return this;
+        },
+      };
+    },
+  } as any,
+};
+
 export const ResourcesServiceGetServiceAccountNamesDesc: UnaryMethodDefinitionish = {
   methodName: "GetServiceAccountNames",
   service: ResourcesServiceDesc,
@@ -1714,6 +2006,17 @@ export class GrpcWebImpl {
   }
 }
 
+declare var self: any | undefined;
+declare var window: any | undefined;
+declare var global: any | undefined;
+var globalThis: any = (() => {
+  if (typeof globalThis !== "undefined") return globalThis;
+  if (typeof self !== "undefined") return self;
+  if (typeof window !== "undefined") return window;
+  if (typeof global !== "undefined") return global;
+  throw "Unable to locate global object";
+})();
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin
@@ -1731,6 +2034,35 @@ export type Exact<P, I extends P> = P extends Builtin
   ? P
   : P & { [K in keyof P]: Exact<P[K], I[K]> } & Record<Exclude<keyof I, KeysOfUnion<P>>, never>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = t.seconds * 1_000;
+  millis += t.nanos / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function longToNumber(long: Long): number {
+  if (long.gt(Number.MAX_SAFE_INTEGER)) {
+    throw new globalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  return long.toNumber();
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
@@ -8,12 +8,12 @@ import {
   DeleteInstalledPackageResponse,
   GetInstalledPackageResourceRefsResponse,
   InstalledPackageReference,
+  RollbackInstalledPackageResponse,
   UpdateInstalledPackageResponse,
   VersionReference,
 } from "gen/kubeappsapis/core/packages/v1alpha1/packages";
 import { KubeappsGrpcClient } from "./KubeappsGrpcClient";
 import { Plugin } from "gen/kubeappsapis/core/plugins/v1alpha1/plugins";
-import { RollbackInstalledPackageResponse } from "gen/kubeappsapis/plugins/helm/packages/v1alpha1/helm";
 import { InstalledPackage } from "./InstalledPackage";
 import { PluginNames } from "./utils";
 
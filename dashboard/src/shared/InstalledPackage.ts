@@ -8,13 +8,11 @@ import {
   DeleteInstalledPackageRequest,
   InstalledPackageReference,
   ReconciliationOptions,
+  RollbackInstalledPackageRequest,
+  RollbackInstalledPackageResponse,
   UpdateInstalledPackageRequest,
   VersionReference,
 } from "gen/kubeappsapis/core/packages/v1alpha1/packages";
-import {
-  RollbackInstalledPackageRequest,
-  RollbackInstalledPackageResponse,
-} from "gen/kubeappsapis/plugins/helm/packages/v1alpha1/helm";
 import { KubeappsGrpcClient } from "./KubeappsGrpcClient";
 import { getPluginsSupportingRollback } from "./utils";
 
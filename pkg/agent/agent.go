@@ -5,6 +5,7 @@ package agent
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -124,6 +125,31 @@ func newInstallCommand(actionConfig *action.Configuration, name string, namespac
 	return cmd, nil
 }
 
+// DryRunCreateRelease renders a release without installing it, returning
+// the release which would be created.
+func DryRunCreateRelease(actionConfig *action.Configuration, name, namespace, valueString string,
+	ch *chart.Chart, registrySecrets map[string]string) (*release.Release, error) {
+	// Check if the release already exists
+	_, err := GetRelease(actionConfig, name)
+	if err == nil {
+		return nil, fmt.Errorf("release %s already exists", name)
+	}
+	cmd, err := newInstallCommand(actionConfig, name, namespace, registrySecrets, 0)
+	if err != nil {
+		return nil, err
+	}
+	cmd.DryRun = true
+	values, err := getValues([]byte(valueString))
+	if err != nil {
+		return nil, err
+	}
+	release, err := cmd.Run(ch, values)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to render the release %q: %v", name, err)
+	}
+	return release, nil
+}
+
 // UpgradeRelease upgrades a release.
 func UpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string,
 	ch *chart.Chart, registrySecrets map[string]string, timeoutSeconds int32) (*release.Release, error) {
@@ -133,13 +159,7 @@ func UpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string,
 		return nil, err
 	}
 	log.InfoS("Upgrading release", "release-name", name)
-	cmd := action.NewUpgrade(actionConfig)
-	if timeoutSeconds > 0 {
-		// Given that `cmd.Wait` is not used, this timeout will only affect pre/post hooks
-		cmd.Timeout = time.Duration(timeoutSeconds) * time.Second
-	}
-
-	cmd.PostRenderer, err = NewDockerSecretsPostRenderer(registrySecrets)
+	cmd, err := newUpgradeCommand(actionConfig, registrySecrets, timeoutSeconds)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +174,44 @@ func UpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string,
 	return res, nil
 }
 
+// DryRunUpgradeRelease renders an upgrade of a release without applying it,
+// returning both the current release and the release which would be deployed.
+func DryRunUpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string,
+	ch *chart.Chart, registrySecrets map[string]string) (*release.Release, *release.Release, error) {
+	current, err := GetRelease(actionConfig, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	cmd, err := newUpgradeCommand(actionConfig, registrySecrets, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	cmd.DryRun = true
+	values, err := chartutil.ReadValues([]byte(valuesYaml))
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unable to render the upgraded release because values could not be parsed: %v", err)
+	}
+	upgraded, err := cmd.Run(name, ch, values)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unable to render the upgraded release: %v", err)
+	}
+	return current, upgraded, nil
+}
+
+func newUpgradeCommand(actionConfig *action.Configuration, registrySecrets map[string]string, timeoutSeconds int32) (*action.Upgrade, error) {
+	cmd := action.NewUpgrade(actionConfig)
+	if timeoutSeconds > 0 {
+		// Given that `cmd.Wait` is not used, this timeout will only affect pre/post hooks
+		cmd.Timeout = time.Duration(timeoutSeconds) * time.Second
+	}
+	var err error
+	cmd.PostRenderer, err = NewDockerSecretsPostRenderer(registrySecrets)
+	if err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
 // RollbackRelease rolls back a release to the specified revision.
 func RollbackRelease(actionConfig *action.Configuration, releaseName string, revision int, timeoutSeconds int32) (*release.Release, error) {
 	log.Infof("Rolling back %s to revision %d.", releaseName, revision)
@@ -184,6 +242,21 @@ func GetRelease(actionConfig *action.Configuration, name string) (*release.Relea
 	return release, nil
 }
 
+// GetReleaseHistory returns the revisions of a release, ordered from the
+// most recent to the oldest.
+func GetReleaseHistory(actionConfig *action.Configuration, name string) ([]*release.Release, error) {
+	// Namespace is already known by the RESTClientGetter.
+	cmd := action.NewHistory(actionConfig)
+	releases, err := cmd.Run(name)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Version > releases[j].Version
+	})
+	return releases, nil
+}
+
 // DeleteRelease deletes a release.
 func DeleteRelease(actionConfig *action.Configuration, name string, keepHistory bool, timeoutSeconds int32) error {
 	// Namespace is already known by the RESTClientGetter.
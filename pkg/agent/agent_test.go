@@ -624,6 +624,112 @@ func TestRollbackRelease(t *testing.T) {
 	}
 }
 
+func TestDryRunCreateRelease(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		chartName        string
+		namespace        string
+		existingReleases []releaseStub
+		shouldFail       bool
+	}{
+		{
+			desc:      "renders a new release without installing it",
+			chartName: "mychart",
+			namespace: "default",
+			existingReleases: []releaseStub{
+				{"otherchart", "default", 1, "1.0.0", release.StatusDeployed},
+			},
+		},
+		{
+			desc:      "errors with an existing name",
+			chartName: "mychart",
+			namespace: "default",
+			existingReleases: []releaseStub{
+				{"mychart", "default", 1, "1.0.0", release.StatusDeployed},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t)
+			makeReleases(t, actionConfig, tc.existingReleases)
+			fakechart := chartFake.ChartClient{}
+			ch, _ := fakechart.GetChart(&kubechart.Details{
+				ChartName: tc.chartName,
+			}, "")
+
+			rls, err := DryRunCreateRelease(actionConfig, tc.chartName, tc.namespace, "", ch, nil)
+			if got, want := err != nil, tc.shouldFail; got != want {
+				t.Fatalf("got: %v, want: %v, err: %+v", got, want, err)
+			}
+			if !tc.shouldFail && rls.Name != tc.chartName {
+				t.Errorf("got: %q, want: %q", rls.Name, tc.chartName)
+			}
+
+			// No release is stored by a dry-run.
+			rlss, err := actionConfig.Releases.ListReleases()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := len(rlss), len(tc.existingReleases); got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestGetReleaseHistory(t *testing.T) {
+	testCases := []struct {
+		name             string
+		releases         []releaseStub
+		release          string
+		expectedVersions []int
+		err              error
+	}{
+		{
+			name: "returns the revisions of a release from the most recent",
+			releases: []releaseStub{
+				{"airwatch", "default", 1, "1.0.0", release.StatusSuperseded},
+				{"otherrelease", "default", 1, "1.0.0", release.StatusDeployed},
+				{"airwatch", "default", 3, "1.2.0", release.StatusDeployed},
+				{"airwatch", "default", 2, "1.1.0", release.StatusSuperseded},
+			},
+			release:          "airwatch",
+			expectedVersions: []int{3, 2, 1},
+		},
+		{
+			name: "errors when the release does not exist",
+			releases: []releaseStub{
+				{"otherrelease", "default", 1, "1.0.0", release.StatusDeployed},
+			},
+			release: "airwatch",
+			err:     driver.ErrReleaseNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newActionConfigFixture(t)
+			makeReleases(t, cfg, tc.releases)
+
+			releases, err := GetReleaseHistory(cfg, tc.release)
+			if got, want := err, tc.err; got != want {
+				t.Fatalf("got: %v, want: %v", got, want)
+			}
+
+			versions := []int{}
+			for _, r := range releases {
+				versions = append(versions, r.Version)
+			}
+			if tc.err == nil && !cmp.Equal(versions, tc.expectedVersions) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tc.expectedVersions, versions))
+			}
+		})
+	}
+}
+
 func TestUpgradeRelease(t *testing.T) {
 	const revisionBeingUpdated = 1
 	testCases := []struct {
@@ -700,6 +806,72 @@ func TestUpgradeRelease(t *testing.T) {
 	}
 }
 
+func TestDryRunUpgradeRelease(t *testing.T) {
+	const revisionBeingUpdated = 1
+	testCases := []struct {
+		description string
+		releases    []releaseStub
+		release     string
+		valuesYaml  string
+		chartName   string
+		shouldFail  bool
+	}{
+		{
+			description: "renders the upgrade of a release without applying it",
+			releases: []releaseStub{
+				{"myrls", "default", revisionBeingUpdated, "mychart", release.StatusDeployed},
+			},
+			valuesYaml: "IsValidYaml: true",
+			release:    "myrls",
+			chartName:  "mynewchart",
+		},
+		{
+			description: "errors for a release which does not exist",
+			releases: []releaseStub{
+				{"otherrls", "default", revisionBeingUpdated, "mychart", release.StatusDeployed},
+			},
+			release:    "myrls",
+			chartName:  "mynewchart",
+			shouldFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			cfg := newActionConfigFixture(t)
+			makeReleases(t, cfg, tc.releases)
+			fakechart := chartFake.ChartClient{}
+			ch, _ := fakechart.GetChart(&kubechart.Details{
+				ChartName: tc.chartName,
+			}, "")
+			current, upgraded, err := DryRunUpgradeRelease(cfg, tc.release, tc.valuesYaml, ch, nil)
+			if got, want := err != nil, tc.shouldFail; got != want {
+				t.Fatalf("Failure: got: %v, want: %v", got, want)
+			}
+			if tc.shouldFail {
+				return
+			}
+			if got, want := current.Version, revisionBeingUpdated; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+			if got, want := upgraded.Version, revisionBeingUpdated+1; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+			// The upgraded revision is not stored and the current one is still deployed
+			if _, err := cfg.Releases.Get(tc.release, revisionBeingUpdated+1); err == nil {
+				t.Errorf("expected revision %d not to be stored", revisionBeingUpdated+1)
+			}
+			rel, err := cfg.Releases.Get(tc.release, revisionBeingUpdated)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := rel.Info.Status, release.StatusDeployed; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
 func TestNewConfigFlagsFromCluster(t *testing.T) {
 	testCases := []struct {
 		name   string